@@ -30,11 +30,18 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListBranch())
 	cmd.AddCommand(CmdListRepositoryBranch())
 	cmd.AddCommand(CmdShowRepositoryBranch())
+	cmd.AddCommand(CmdShowRepositoryBranchProtectionRules())
+	cmd.AddCommand(CmdListRepositoryCommitStatus())
+	cmd.AddCommand(CmdListPullRequestCommitStatus())
 
 	cmd.AddCommand(CmdListTag())
 	cmd.AddCommand(CmdListRepositoryTag())
 	cmd.AddCommand(CmdShowRepositoryTag())
 
+	cmd.AddCommand(CmdListRepositoryMilestone())
+	cmd.AddCommand(CmdShowRepositoryMilestone())
+	cmd.AddCommand(CmdListRepositoryAssigneeWeight())
+
 	cmd.AddCommand(CmdListMember())
 	cmd.AddCommand(CmdListDaoMember())
 	cmd.AddCommand(CmdShowDaoMember())
@@ -49,6 +56,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPullRequest())
 	cmd.AddCommand(CmdListRepositoryPullRequest())
 	cmd.AddCommand(CmdShowRepositoryPullRequest())
+	cmd.AddCommand(CmdShowPullRequestReviewSummary())
 
 	cmd.AddCommand(CmdListDao())
 	cmd.AddCommand(CmdShowDao())
@@ -58,6 +66,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListPullRequestComment())
 	cmd.AddCommand(CmdShowIssueComment())
 	cmd.AddCommand(CmdShowPullRequestComment())
+	cmd.AddCommand(CmdListIssueEditHistory())
+	cmd.AddCommand(CmdListPullRequestEditHistory())
 
 	cmd.AddCommand(CmdListIssue())
 	cmd.AddCommand(CmdListRepositoryIssue())
@@ -65,9 +75,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListRepository())
 	cmd.AddCommand(CmdShowRepository())
+	cmd.AddCommand(CmdListRepositoryStargazer())
 
 	cmd.AddCommand(CmdListUser())
 	cmd.AddCommand(CmdShowUser())
+	cmd.AddCommand(CmdListUserStarredRepository())
+	cmd.AddCommand(CmdListFollower())
+	cmd.AddCommand(CmdListFollowing())
 
 	cmd.AddCommand(CmdListWhois())
 	cmd.AddCommand(CmdShowWhois())
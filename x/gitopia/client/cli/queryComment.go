@@ -218,3 +218,103 @@ func CmdShowPullRequestComment() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListIssueEditHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-issue-edit-history [repository-id] [issue-iid] [comment-iid]",
+		Short: "list the edit history of a issue comment, comment-iid 0 lists the issue description history",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			parentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			commentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllIssueEditHistoryRequest{
+				RepositoryId: repositoryId,
+				IssueIid:     parentIid,
+				CommentIid:   commentIid,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.IssueEditHistoryAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPullRequestEditHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pullrequest-edit-history [repository-id] [pullrequest-iid] [comment-iid]",
+		Short: "list the edit history of a pullrequest comment, comment-iid 0 lists the pullrequest description history",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			repositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			parentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			commentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllPullRequestEditHistoryRequest{
+				RepositoryId:   repositoryId,
+				PullRequestIid: parentIid,
+				CommentIid:     commentIid,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.PullRequestEditHistoryAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
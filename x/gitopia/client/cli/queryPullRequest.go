@@ -131,3 +131,46 @@ func CmdShowRepositoryPullRequest() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowPullRequestReviewSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pullrequest-review-summary [id] [repository-name] [pullrequest-iid]",
+		Short: "shows the review summary of a repository pullrequest",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := cast.ToStringE(args[0])
+			if err != nil {
+				return err
+			}
+			repositoryName, err := cast.ToStringE(args[1])
+			if err != nil {
+				return err
+			}
+			pullRequestIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetPullRequestReviewSummaryRequest{
+				Id:             id,
+				RepositoryName: repositoryName,
+				PullIid:        pullRequestIid,
+			}
+
+			res, err := queryClient.PullRequestReviewSummary(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
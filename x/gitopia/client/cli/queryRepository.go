@@ -75,3 +75,39 @@ func CmdShowRepository() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListRepositoryStargazer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-repository-stargazer [id] [repository-name]",
+		Short: "list stargazers of a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryStargazerRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.RepositoryStargazerAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
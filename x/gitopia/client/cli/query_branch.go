@@ -114,3 +114,37 @@ func CmdShowRepositoryBranch() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowRepositoryBranchProtectionRules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-branch-protection-rules [id] [repository-name] [branch-name]",
+		Short: "shows the protection rules that apply to a branch",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId := args[0]
+			argRepositoryName := args[1]
+			argBranchName := args[2]
+
+			params := &types.QueryGetRepositoryBranchProtectionRulesRequest{
+				Id:             argId,
+				RepositoryName: argRepositoryName,
+				BranchName:     argBranchName,
+			}
+
+			res, err := queryClient.RepositoryBranchProtectionRules(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
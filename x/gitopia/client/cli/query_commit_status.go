@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-repository-commit-status [id] [repository-name] [sha]",
+		Short: "list the statuses of a repository commit",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRepositoryCommitStatusRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				Sha:            args[2],
+			}
+
+			res, err := queryClient.RepositoryCommitStatusAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPullRequestCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pullrequest-commit-status [id] [repository-name] [pullrequest-iid]",
+		Short: "list the statuses of the head commit of a repository pullrequest",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pullRequestIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAllPullRequestCommitStatusRequest{
+				Id:             args[0],
+				RepositoryName: args[1],
+				PullIid:        pullRequestIid,
+			}
+
+			res, err := queryClient.PullRequestCommitStatusAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
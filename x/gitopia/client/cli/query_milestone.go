@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdListRepositoryMilestone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-repository-milestone [id] [repository-name]",
+		Short: "list all repository milestones with their progress",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argState, err := cmd.Flags().GetString(flagState)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			params := &types.QueryAllRepositoryMilestoneRequest{
+				Id:             argId,
+				RepositoryName: argRepositoryName,
+				State:          argState,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.RepositoryMilestoneAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagState, "", "Only list milestones in this state, open or closed")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRepositoryMilestone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-repository-milestone [id] [repository-name] [iid]",
+		Short: "shows a milestone with its progress",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			argIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetRepositoryMilestoneRequest{
+				Id:             argId,
+				RepositoryName: argRepositoryName,
+				Iid:            argIid,
+			}
+
+			res, err := queryClient.RepositoryMilestone(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
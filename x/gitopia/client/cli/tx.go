@@ -16,8 +16,20 @@ var (
 )
 
 const (
-	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
-	flagExpiration             = "expiration"
+	flagPacketTimeoutTimestamp  = "packet-timeout-timestamp"
+	flagExpiration              = "expiration"
+	flagMilestone               = "milestone"
+	flagRequiredApprovals       = "required-approvals"
+	flagBlockOnChangesRequested = "block-on-changes-requested"
+	flagRequiredReviewers       = "required-reviewers"
+	flagRequiredChecks          = "required-checks"
+	flagReplyTo                 = "reply-to"
+	flagMergeStrategy           = "merge-strategy"
+	flagDefaultMergeStrategy    = "default-merge-strategy"
+	flagCommitTitle             = "commit-title"
+	flagCommitMessage           = "commit-message"
+	flagState                   = "state"
+	flagCloseReason             = "close-reason"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -53,10 +65,18 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddRepositoryBackupRef())
 
 	cmd.AddCommand(CmdCreateBounty())
+	cmd.AddCommand(CmdFundBounty())
+	cmd.AddCommand(CmdReleaseBountyMilestone())
+	cmd.AddCommand(CmdOpenBountyDispute())
+	cmd.AddCommand(CmdVoteBountyDispute())
+	cmd.AddCommand(CmdResolveBountyDispute())
 	cmd.AddCommand(CmdUpdateBountyExpiry())
 	cmd.AddCommand(CmdCloseBounty())
 	cmd.AddCommand(CmdDeleteBounty())
 	cmd.AddCommand(CmdToggleForcePush())
+	cmd.AddCommand(CmdSetBranchProtectionRule())
+	cmd.AddCommand(CmdDeleteBranchProtectionRule())
+	cmd.AddCommand(CmdSetCommitStatus())
 	cmd.AddCommand(CmdExercise())
 // this line is used by starport scaffolding # 1
 
@@ -75,9 +95,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRemovePullRequestAssignees())
 	cmd.AddCommand(CmdAddPullRequestReviewers())
 	cmd.AddCommand(CmdRemovePullRequestReviewers())
+	cmd.AddCommand(CmdSubmitPullRequestReview())
 	cmd.AddCommand(CmdAddPullRequestLabels())
 	cmd.AddCommand(CmdRemovePullRequestLabels())
 	cmd.AddCommand(CmdDeletePullRequest())
+	cmd.AddCommand(CmdSetPullRequestDraft())
+	cmd.AddCommand(CmdEnableAutoMerge())
+	cmd.AddCommand(CmdDisableAutoMerge())
+	cmd.AddCommand(CmdLockPullRequest())
+	cmd.AddCommand(CmdUnlockPullRequest())
 
 	cmd.AddCommand(CmdCreateDao())
 	cmd.AddCommand(CmdRenameDao())
@@ -90,6 +116,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateComment())
 	cmd.AddCommand(CmdUpdateComment())
 	cmd.AddCommand(CmdDeleteComment())
+	cmd.AddCommand(CmdResolveCommentThread())
+	cmd.AddCommand(CmdUnresolveCommentThread())
+	cmd.AddCommand(CmdToggleCommentReaction())
+	cmd.AddCommand(CmdHideComment())
+	cmd.AddCommand(CmdUnhideComment())
 
 	cmd.AddCommand(CmdCreateIssue())
 	cmd.AddCommand(CmdUpdateIssueTitle())
@@ -97,9 +128,18 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdToggleIssueState())
 	cmd.AddCommand(CmdAddIssueAssignees())
 	cmd.AddCommand(CmdRemoveIssueAssignees())
+	cmd.AddCommand(CmdSetIssueBountySplit())
 	cmd.AddCommand(CmdAddIssueLabels())
 	cmd.AddCommand(CmdRemoveIssueLabels())
 	cmd.AddCommand(CmdDeleteIssue())
+	cmd.AddCommand(CmdLockIssue())
+	cmd.AddCommand(CmdUnlockIssue())
+	cmd.AddCommand(CmdLinkIssue())
+	cmd.AddCommand(CmdUnlinkIssue())
+	cmd.AddCommand(CmdCloseIssueAsDuplicate())
+	cmd.AddCommand(CmdTransferIssue())
+	cmd.AddCommand(CmdSetIssueWeight())
+	cmd.AddCommand(CmdSetIssueDueDate())
 
 	cmd.AddCommand(CmdCreateRepository())
 	cmd.AddCommand(CmdInvokeForkRepository())
@@ -112,7 +152,18 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRepositoryLabel())
 	cmd.AddCommand(CmdUpdateRepositoryLabel())
 	cmd.AddCommand(CmdDeleteRepositoryLabel())
+	cmd.AddCommand(CmdCreateMilestone())
+	cmd.AddCommand(CmdUpdateMilestone())
+	cmd.AddCommand(CmdToggleMilestoneState())
+	cmd.AddCommand(CmdDeleteMilestone())
+	cmd.AddCommand(CmdSetIssueMilestone())
+	cmd.AddCommand(CmdSetPullRequestMilestone())
 	cmd.AddCommand(CmdToggleRepositoryForking())
+	cmd.AddCommand(CmdToggleRepositoryArchived())
+	cmd.AddCommand(CmdSetRepositoryMergeRequirements())
+	cmd.AddCommand(CmdSetRepositoryMergeStrategies())
+	cmd.AddCommand(CmdStarRepository())
+	cmd.AddCommand(CmdUnstarRepository())
 	cmd.AddCommand(CmdDeleteRepository())
 
 	cmd.AddCommand(CmdCreateUser())
@@ -121,6 +172,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateUserBio())
 	cmd.AddCommand(CmdUpdateUserAvatar())
 	cmd.AddCommand(CmdDeleteUser())
+	cmd.AddCommand(CmdFollow())
+	cmd.AddCommand(CmdUnfollow())
 	// cmd.AddCommand(CmdTransferUser())
 
 	return cmd
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"encoding/json"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -58,6 +60,10 @@ func CmdCreateComment() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			replyTo, err := cmd.Flags().GetUint64(flagReplyTo)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -72,7 +78,8 @@ func CmdCreateComment() *cobra.Command {
 				attachments,
 				string(argsDiffHunk),
 				string(argsPath),
-				argsPosition)
+				argsPosition,
+				replyTo)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -80,6 +87,7 @@ func CmdCreateComment() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(flagReplyTo, 0, "Iid of the comment to reply to")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -179,3 +187,208 @@ func CmdDeleteComment() *cobra.Command {
 
 	return cmd
 }
+
+func CmdResolveCommentThread() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-comment-thread [repository-id] [pullrequest-iid] [comment-iid]",
+		Short: "Resolve a pullrequest review thread",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsPullIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveCommentThread(clientCtx.GetFromAddress().String(), argsRepositoryId, argsPullIid, argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnresolveCommentThread() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unresolve-comment-thread [repository-id] [pullrequest-iid] [comment-iid]",
+		Short: "Unresolve a pullrequest review thread",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsPullIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnresolveCommentThread(clientCtx.GetFromAddress().String(), argsRepositoryId, argsPullIid, argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdToggleCommentReaction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggle-comment-reaction [repository-id] [parent-iid] [parent] [comment-iid] [emoji]",
+		Short: "Add or remove a reaction to a comment, comment-iid 0 reacts to the issue or pullrequest description",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsEmoji, ok := types.Emoji_value["EMOJI_"+strings.ToUpper(args[4])]
+			if !ok {
+				return fmt.Errorf("invalid emoji (%v)", args[4])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleCommentReaction(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid, types.Emoji(argsEmoji))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHideComment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hide-comment [repository-id] [parent-iid] [parent] [comment-iid] [reason]",
+		Short: "Hide a comment, reason is one of spam, abuse, off-topic, outdated or resolved",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsReason, ok := types.CommentHiddenReason_value["COMMENT_HIDDEN_REASON_"+strings.ToUpper(strings.ReplaceAll(args[4], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid reason (%v)", args[4])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgHideComment(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid, types.CommentHiddenReason(argsReason))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnhideComment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unhide-comment [repository-id] [parent-iid] [parent] [comment-iid]",
+		Short: "Unhide a comment",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParentIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsParent, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsCommentIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnhideComment(clientCtx.GetFromAddress().String(), argsRepositoryId, argsParentIid, types.CommentParent(argsParent), argsCommentIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -187,7 +188,16 @@ func CmdToggleIssueState() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgToggleIssueState(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			closeReason, err := cmd.Flags().GetString(flagCloseReason)
+			if err != nil {
+				return err
+			}
+			argCloseReason, err := parseCloseReason(closeReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleIssueState(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argCloseReason)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -195,6 +205,7 @@ func CmdToggleIssueState() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagCloseReason, "", "Reason for closing (completed, wont_fix, duplicate or stale), defaults to completed")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -274,6 +285,59 @@ func CmdRemoveIssueAssignees() *cobra.Command {
 	return cmd
 }
 
+func CmdSetIssueBountySplit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-issue-bounty-split [repository-id] [iid] [shares]",
+		Short: "Set how the issue bounty is split between assignees",
+		Long:  "Shares are given as comma separated address:percentage pairs. The bounty is split equally when no shares are given.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			split := types.BountySplit{Type: types.BountySplitTypeEqual}
+			if len(args) == 3 && args[2] != "" {
+				split.Type = types.BountySplitTypePercentage
+				for _, s := range strings.Split(args[2], ",") {
+					share := strings.Split(s, ":")
+					if len(share) != 2 {
+						return fmt.Errorf("invalid share (%v)", s)
+					}
+					percentage, err := strconv.ParseUint(share[1], 10, 64)
+					if err != nil {
+						return err
+					}
+					split.Shares = append(split.Shares, types.BountyShare{
+						Address:    share[0],
+						Percentage: percentage,
+					})
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetIssueBountySplit(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, split)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdAddIssueLabels() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-issue-labels [repository-id] [iid] [labels]",
@@ -382,3 +446,93 @@ func CmdDeleteIssue() *cobra.Command {
 
 	return cmd
 }
+
+func CmdLockIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-issue [repository-id] [iid] [reason]",
+		Short: "Lock the conversation of an issue, reason is one of none, off-topic, too-heated, resolved or spam",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsReason, err := parseLockReason(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockIssue(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnlockIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-issue [repository-id] [iid]",
+		Short: "Unlock the conversation of an issue",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlockIssue(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseLockReason parses a lock reason such as off-topic
+func parseLockReason(arg string) (types.LockReason, error) {
+	reason, ok := types.LockReason_value["LOCK_REASON_"+strings.ToUpper(strings.ReplaceAll(arg, "-", "_"))]
+	if !ok {
+		return types.LockReasonNone, fmt.Errorf("invalid lock reason (%v)", arg)
+	}
+	return types.LockReason(reason), nil
+}
+
+func parseCloseReason(arg string) (types.CloseReason, error) {
+	if arg == "" {
+		return types.CloseReasonUnspecified, nil
+	}
+	reason, ok := types.CloseReason_value["CLOSE_REASON_"+strings.ToUpper(arg)]
+	if !ok || types.ValidateCloseReason(types.CloseReason(reason)) != nil {
+		return types.CloseReasonUnspecified, fmt.Errorf("invalid close reason (%v)", arg)
+	}
+	return types.CloseReason(reason), nil
+}
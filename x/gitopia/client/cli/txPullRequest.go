@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -169,12 +170,29 @@ func CmdInvokeMergePullRequest() *cobra.Command {
 				return err
 			}
 
+			mergeStrategy, err := cmd.Flags().GetString(flagMergeStrategy)
+			if err != nil {
+				return err
+			}
+			argMergeStrategy, err := parseMergeStrategy(mergeStrategy)
+			if err != nil {
+				return err
+			}
+			commitTitle, err := cmd.Flags().GetString(flagCommitTitle)
+			if err != nil {
+				return err
+			}
+			commitMessage, err := cmd.Flags().GetString(flagCommitMessage)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgInvokeMergePullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, string(argsProvider))
+			msg := types.NewMsgInvokeMergePullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, string(argsProvider), argMergeStrategy, commitTitle, commitMessage)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -182,6 +200,9 @@ func CmdInvokeMergePullRequest() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagMergeStrategy, "", "Merge strategy (merge, squash or rebase), defaults to the branch or repository default")
+	cmd.Flags().String(flagCommitTitle, "", "Title of the merge or squash commit")
+	cmd.Flags().String(flagCommitMessage, "", "Message of the merge or squash commit")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -223,6 +244,15 @@ func CmdSetPullRequestState() *cobra.Command {
 				return err
 			}
 
+			closeReason, err := cmd.Flags().GetString(flagCloseReason)
+			if err != nil {
+				return err
+			}
+			argCloseReason, err := parseCloseReason(closeReason)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSetPullRequestState(clientCtx.GetFromAddress().String(),
 				argsRepositoryId,
 				argsIid,
@@ -230,6 +260,7 @@ func CmdSetPullRequestState() *cobra.Command {
 				string(argsMergeCommitSha),
 				string(argsCommentBody),
 				argsTaskId,
+				argCloseReason,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -238,6 +269,7 @@ func CmdSetPullRequestState() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagCloseReason, "", "Reason for closing (completed, wont_fix, duplicate or stale), defaults to wont_fix")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -280,6 +312,57 @@ func CmdAddPullRequestReviewers() *cobra.Command {
 	return cmd
 }
 
+func CmdSubmitPullRequestReview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-pullrequest-review [repository-id] [iid] [verdict] [body] [comments]",
+		Short: "Submit a pullRequest review",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsVerdict, err := strconv.ParseInt(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			argsBody, err := cast.ToStringE(args[3])
+			if err != nil {
+				return err
+			}
+			argsComments, err := cast.ToStringE(args[4])
+			if err != nil {
+				return err
+			}
+			comments := []types.MsgSubmitPullRequestReview_Comment{}
+			if argsComments != "" {
+				if err := json.Unmarshal([]byte(argsComments), &comments); err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitPullRequestReview(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, types.PullRequestReviewVerdict(argsVerdict), argsBody, comments)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdRemovePullRequestReviewers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove-pullrequest-reviewers [repository-id] [iid] [reviewers]",
@@ -572,3 +655,197 @@ func CmdDeletePullRequest() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSetPullRequestDraft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pullRequest-draft [repository-id] [iid] [draft]",
+		Short: "Convert a pullRequest to draft or mark it ready for review",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsDraft, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPullRequestDraft(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsDraft)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEnableAutoMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-auto-merge [repository-id] [iid] [provider]",
+		Short: "Merge a pullRequest as soon as its merge requirements are satisfied",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsProvider := args[2]
+
+			mergeStrategy, err := cmd.Flags().GetString(flagMergeStrategy)
+			if err != nil {
+				return err
+			}
+			argMergeStrategy, err := parseMergeStrategy(mergeStrategy)
+			if err != nil {
+				return err
+			}
+			commitTitle, err := cmd.Flags().GetString(flagCommitTitle)
+			if err != nil {
+				return err
+			}
+			commitMessage, err := cmd.Flags().GetString(flagCommitMessage)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableAutoMerge(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsProvider, argMergeStrategy, commitTitle, commitMessage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMergeStrategy, "", "Merge strategy (merge, squash or rebase), defaults to the branch or repository default")
+	cmd.Flags().String(flagCommitTitle, "", "Title of the merge or squash commit")
+	cmd.Flags().String(flagCommitMessage, "", "Message of the merge or squash commit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDisableAutoMerge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-auto-merge [repository-id] [iid]",
+		Short: "Disable auto-merge of a pullRequest",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableAutoMerge(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLockPullRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-pullRequest [repository-id] [iid] [reason]",
+		Short: "Lock the conversation of a pullRequest, reason is one of none, off-topic, too-heated, resolved or spam",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsReason, err := parseLockReason(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockPullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid, argsReason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnlockPullRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock-pullRequest [repository-id] [iid]",
+		Short: "Unlock the conversation of a pullRequest",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argsIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlockPullRequest(clientCtx.GetFromAddress().String(), argsRepositoryId, argsIid)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
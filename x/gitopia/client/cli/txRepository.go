@@ -2,6 +2,7 @@ package cli
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -438,6 +439,176 @@ func CmdToggleRepositoryForking() *cobra.Command {
 	return cmd
 }
 
+func CmdToggleRepositoryArchived() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggle-repository-archived [id] [repository-name]",
+		Short: "Toggle repository archived state",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgToggleRepositoryArchived(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetRepositoryMergeRequirements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-repository-merge-requirements [id] [repository-name]",
+		Short: "Set the requirements for merging pull requests, clears them when no flag is given",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			mergeRequirements, err := getMergeRequirementsFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRepositoryMergeRequirements(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				mergeRequirements,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMergeRequirementsFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetRepositoryMergeStrategies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-repository-merge-strategies [id] [repository-name] [strategies]",
+		Short: "Set the comma separated merge strategies (merge, squash, rebase) allowed in the repository, an empty list allows all",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			var argStrategies []types.MergeStrategy
+			for _, s := range strings.Split(args[2], ",") {
+				if s = strings.TrimSpace(s); s == "" {
+					continue
+				}
+				strategy, err := parseMergeStrategy(s)
+				if err != nil {
+					return err
+				}
+				argStrategies = append(argStrategies, strategy)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetRepositoryMergeStrategies(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+				argStrategies,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStarRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "star-repository [id] [repository-name]",
+		Short: "Star a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStarRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnstarRepository() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstar-repository [id] [repository-name]",
+		Short: "Unstar a repository",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argId := args[0]
+			argRepositoryName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnstarRepository(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argId, Name: argRepositoryName},
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdToggleArweaveBackup() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "toggle-arweave-backup [id] [repository-name]",
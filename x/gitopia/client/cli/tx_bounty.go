@@ -2,7 +2,9 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -45,7 +47,208 @@ func CmdCreateBounty() *cobra.Command {
 				return err
 			}
 
+			argMilestones, err := cmd.Flags().GetStringArray(flagMilestone)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgCreateBounty(clientCtx.GetFromAddress().String(), argAmount, argExpiry, argRepositoryId, argParentId, types.BountyParent(parent))
+			for _, m := range argMilestones {
+				i := strings.LastIndex(m, "=")
+				if i < 0 {
+					return fmt.Errorf("invalid milestone (%v)", m)
+				}
+				amount, err := cosmosTypes.ParseCoinsNormalized(m[i+1:])
+				if err != nil {
+					return err
+				}
+				msg.Milestones = append(msg.Milestones, types.BountyMilestone{
+					Title:  m[:i],
+					Amount: amount,
+				})
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray(flagMilestone, []string{}, "Bounty milestone in the form title=amount, can be repeated")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFundBounty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-bounty [id] [amount]",
+		Short: "Add funds to an existing Bounty",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argAmount, err := cosmosTypes.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundBounty(clientCtx.GetFromAddress().String(), id, argAmount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReleaseBountyMilestone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-bounty-milestone [id] [milestone] [recipient]",
+		Short: "Release a Bounty milestone to a recipient",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argMilestone, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseBountyMilestone(clientCtx.GetFromAddress().String(), id, argMilestone, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdOpenBountyDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-bounty-dispute [id] [contributor] [reason]",
+		Short: "Open a dispute over a Bounty and freeze its escrow",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOpenBountyDispute(clientCtx.GetFromAddress().String(), id, args[1], args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVoteBountyDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-bounty-dispute [id] [resolution] [payout-percentage]",
+		Short: "Vote on the resolution of a disputed Bounty",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			resolution, ok := types.BountyDisputeResolution_value[args[1]]
+			if !ok {
+				return errors.New("invalid bounty dispute resolution")
+			}
+
+			var argPayoutPercentage uint64
+			if len(args) == 3 {
+				argPayoutPercentage, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteBountyDispute(clientCtx.GetFromAddress().String(), id, types.BountyDisputeResolution(resolution), argPayoutPercentage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResolveBountyDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-bounty-dispute [id] [resolution] [payout-percentage]",
+		Short: "Concede a disputed Bounty as its creator or the disputed contributor",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			resolution, ok := types.BountyDisputeResolution_value[args[1]]
+			if !ok {
+				return errors.New("invalid bounty dispute resolution")
+			}
+
+			var argPayoutPercentage uint64
+			if len(args) == 3 {
+				argPayoutPercentage, err = strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResolveBountyDispute(clientCtx.GetFromAddress().String(), id, types.BountyDisputeResolution(resolution), argPayoutPercentage)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
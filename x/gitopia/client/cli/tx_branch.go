@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -137,3 +141,154 @@ func CmdToggleForcePush() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSetBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-branch-protection-rule [repository-id] [repository-name] [pattern] [min-push-permission] [require-pull-request] [allow-deletion]",
+		Short: "Set a branch protection rule for branches matching the glob pattern",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepoId := args[0]
+			argRepoName := args[1]
+			argPattern := args[2]
+
+			argMinPushPermission, ok := types.RepositoryCollaborator_Permission_value[strings.ToUpper(args[3])]
+			if !ok {
+				return fmt.Errorf("invalid permission (%v)", args[3])
+			}
+
+			argRequirePullRequest, err := strconv.ParseBool(args[4])
+			if err != nil {
+				return err
+			}
+
+			argAllowDeletion, err := strconv.ParseBool(args[5])
+			if err != nil {
+				return err
+			}
+
+			mergeRequirements, err := getMergeRequirementsFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			defaultMergeStrategy, err := cmd.Flags().GetString(flagDefaultMergeStrategy)
+			if err != nil {
+				return err
+			}
+			argDefaultMergeStrategy, err := parseMergeStrategy(defaultMergeStrategy)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetBranchProtectionRule(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argRepoId, Name: argRepoName},
+				argPattern,
+				types.RepositoryCollaborator_Permission(argMinPushPermission),
+				argRequirePullRequest,
+				argAllowDeletion,
+				mergeRequirements,
+				argDefaultMergeStrategy,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMergeRequirementsFlags(cmd)
+	cmd.Flags().String(flagDefaultMergeStrategy, "", "Strategy used to merge into matching branches when none is given (merge, squash or rebase)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteBranchProtectionRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-branch-protection-rule [repository-id] [repository-name] [pattern]",
+		Short: "Delete a branch protection rule",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepoId := args[0]
+			argRepoName := args[1]
+			argPattern := args[2]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteBranchProtectionRule(
+				clientCtx.GetFromAddress().String(),
+				types.RepositoryId{Id: argRepoId, Name: argRepoName},
+				argPattern,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addMergeRequirementsFlags adds the flags read by getMergeRequirementsFlags
+func addMergeRequirementsFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagRequiredApprovals, 0, "Minimum number of approvals required to merge")
+	cmd.Flags().Bool(flagBlockOnChangesRequested, false, "Block merging while changes are requested")
+	cmd.Flags().StringSlice(flagRequiredReviewers, []string{}, "Comma separated addresses whose approval is required to merge")
+	cmd.Flags().StringSlice(flagRequiredChecks, []string{}, "Comma separated commit status contexts that must succeed to merge")
+}
+
+// getMergeRequirementsFlags returns nil when no merge requirement is set
+func getMergeRequirementsFlags(cmd *cobra.Command) (*types.MergeRequirements, error) {
+	requiredApprovals, err := cmd.Flags().GetUint64(flagRequiredApprovals)
+	if err != nil {
+		return nil, err
+	}
+	blockOnChangesRequested, err := cmd.Flags().GetBool(flagBlockOnChangesRequested)
+	if err != nil {
+		return nil, err
+	}
+	requiredReviewers, err := cmd.Flags().GetStringSlice(flagRequiredReviewers)
+	if err != nil {
+		return nil, err
+	}
+	requiredChecks, err := cmd.Flags().GetStringSlice(flagRequiredChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	if requiredApprovals == 0 && !blockOnChangesRequested && len(requiredReviewers) == 0 && len(requiredChecks) == 0 {
+		return nil, nil
+	}
+
+	return &types.MergeRequirements{
+		RequiredApprovals:       requiredApprovals,
+		BlockOnChangesRequested: blockOnChangesRequested,
+		RequiredReviewers:       requiredReviewers,
+		RequiredChecks:          requiredChecks,
+	}, nil
+}
+
+// parseMergeStrategy parses merge, squash or rebase, an empty string is unspecified
+func parseMergeStrategy(arg string) (types.MergeStrategy, error) {
+	if arg == "" {
+		return types.MergeStrategyUnspecified, nil
+	}
+	strategy, ok := types.MergeStrategy_value["MERGE_STRATEGY_"+strings.ToUpper(arg)]
+	if !ok || types.MergeStrategy(strategy) == types.MergeStrategyUnspecified {
+		return types.MergeStrategyUnspecified, fmt.Errorf("invalid merge strategy (%v)", arg)
+	}
+	return types.MergeStrategy(strategy), nil
+}
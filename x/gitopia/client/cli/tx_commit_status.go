@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetCommitStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-commit-status [repository-id] [sha] [context] [state] [target-url] [description]",
+		Short: "Set the status of a commit for a context, state is one of pending, success, failure or error",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			argSha := args[1]
+			argContext := args[2]
+
+			argState, ok := types.CommitStatusState_value["COMMIT_STATUS_STATE_"+strings.ToUpper(args[3])]
+			if !ok {
+				return fmt.Errorf("invalid state (%v)", args[3])
+			}
+
+			argTargetUrl := args[4]
+			argDescription := args[5]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommitStatus(
+				clientCtx.GetFromAddress().String(),
+				argRepositoryId,
+				argSha,
+				argContext,
+				types.CommitStatusState(argState),
+				argTargetUrl,
+				argDescription,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
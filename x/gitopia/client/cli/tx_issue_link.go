@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdLinkIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link-issue [repository-id] [iid] [link-type] [target-repository-id] [target-iid]",
+		Short: "Link two issues, link-type is one of relates-to, blocks, blocked-by, duplicate-of or duplicated-by",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argLinkType, ok := types.IssueLinkType_value["ISSUE_LINK_TYPE_"+strings.ToUpper(strings.ReplaceAll(args[2], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid link type (%v)", args[2])
+			}
+
+			argTargetRepositoryId, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argTargetIid, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLinkIssue(
+				clientCtx.GetFromAddress().String(),
+				argRepositoryId,
+				argIid,
+				types.IssueLinkType(argLinkType),
+				argTargetRepositoryId,
+				argTargetIid,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnlinkIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlink-issue [repository-id] [iid] [target-repository-id] [target-iid]",
+		Short: "Remove the link between two issues",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argTargetRepositoryId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argTargetIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnlinkIssue(
+				clientCtx.GetFromAddress().String(),
+				argRepositoryId,
+				argIid,
+				argTargetRepositoryId,
+				argTargetIid,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCloseIssueAsDuplicate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-issue-as-duplicate [repository-id] [iid] [duplicate-of-repository-id] [duplicate-of-iid] [reason]",
+		Short: "Close an issue as a duplicate of another issue",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRepositoryId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argIid, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argDuplicateOfRepositoryId, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argDuplicateOfIid, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			argReason := args[4]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCloseIssueAsDuplicate(
+				clientCtx.GetFromAddress().String(),
+				argRepositoryId,
+				argIid,
+				argDuplicateOfRepositoryId,
+				argDuplicateOfIid,
+				argReason,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
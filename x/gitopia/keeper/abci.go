@@ -1,11 +1,15 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gitopia/gitopia/app/params"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 	"github.com/pkg/errors"
 )
 
@@ -73,3 +77,82 @@ func (k Keeper) TokenDistribution(ctx sdk.Context) {
 		panic(err)
 	}
 }
+
+// ExpireBounties refunds the bounties which have expired without being
+// rewarded. At most types.MaxExpiredBountiesPerBlock bounties are processed
+// per block; the rest are picked up in the following blocks. Just like
+// CloseBounty, bounties with pull requests in progress aren't refunded: their
+// expiry is postponed instead.
+func (k Keeper) ExpireBounties(ctx sdk.Context) {
+	var expiredBounties []types.Bounty
+	k.IterateExpiredBountyQueue(ctx, ctx.BlockTime().Unix(), func(bounty types.Bounty) bool {
+		expiredBounties = append(expiredBounties, bounty)
+		return len(expiredBounties) >= types.MaxExpiredBountiesPerBlock
+	})
+
+	for _, bounty := range expiredBounties {
+		if k.hasPullRequestInProgress(ctx, bounty) {
+			bounty.ExpireAt = ctx.BlockTime().Unix() + types.BountyExpiryPostponement
+			k.SetBounty(ctx, bounty)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.expireBounty(cacheCtx, bounty); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("error expiring bounty (%d): %v", bounty.Id, err))
+
+			// drop the bounty from the queue so that it doesn't consume the
+			// budget of every block. The creator can still close it manually.
+			k.RemoveFromBountyExpiryQueue(ctx, bounty)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// hasPullRequestInProgress reports whether the parent of a bounty has linked
+// pull requests, or is itself an open pull request
+func (k Keeper) hasPullRequestInProgress(ctx sdk.Context, bounty types.Bounty) bool {
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		return found && len(issue.PullRequests) > 0
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		return found && pullRequest.State == types.PullRequest_OPEN
+	}
+	return false
+}
+
+func (k Keeper) expireBounty(ctx sdk.Context, bounty types.Bounty) error {
+	if err := k.RefundBounty(ctx, bounty); err != nil {
+		return err
+	}
+
+	bounty.State = types.BountyStateREVERTEDBACK
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetBounty(ctx, bounty)
+	k.appendBountyComment(ctx, bounty, utils.ExpireBountyCommentBody(), types.CommentTypeClosedBounty)
+
+	bountyAmountJson, _ := json.Marshal(bounty.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ExpireBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, bounty.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyExpiry, strconv.FormatInt(bounty.ExpireAt, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/gitopia/gitopia/testutil/simapp"
 	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	gitopiatypes "github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -153,3 +154,112 @@ func TestTokenDistributionSucessWhenNoTokenMinted(t *testing.T) {
 	accAdrr := accountKeeper.GetModuleAddress(gitopiatypes.EcosystemIncentivesAccountName)
 	assert.Equal(t, sdk.NewCoin(params.BaseCoinUnit, sdk.NewInt(keeper.ECOSYSTEM_INCENTIVES_AMOUNT)), bankKeeper.GetBalance(ctx, accAdrr, params.BaseCoinUnit))
 }
+
+func TestExpireBounties(t *testing.T) {
+	a, ctx, srv, users, repositoryId := setupPreBountyApp(t)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+	repository, _ := k.GetAddressRepository(ctx, users[0], repositoryId.Name)
+	expiry := ctx.BlockTime().Unix() + 10
+
+	// issue with a linked pull request, and the open pull request itself
+	_, err := srv.CreateIssue(goCtx, &gitopiatypes.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "issue"})
+	require.NoError(t, err)
+	k.AppendPullRequest(ctx, gitopiatypes.PullRequest{
+		Creator: users[1],
+		Iid:     1,
+		State:   gitopiatypes.PullRequest_OPEN,
+		Base:    &gitopiatypes.PullRequestBase{RepositoryId: repository.Id},
+	})
+	issue, _ := k.GetRepositoryIssue(ctx, repository.Id, 2)
+	issue.PullRequests = []*gitopiatypes.PullRequestIid{{Iid: 1}}
+	k.SetIssue(ctx, issue)
+
+	for _, parent := range []struct {
+		iid    uint64
+		parent gitopiatypes.BountyParent
+	}{
+		{1, gitopiatypes.BountyParentIssue},
+		{2, gitopiatypes.BountyParentIssue},
+		{1, gitopiatypes.BountyParentPullRequest},
+	} {
+		_, err := srv.CreateBounty(goCtx, &gitopiatypes.MsgCreateBounty{Creator: users[0], Amount: bountyCoins(100), Expiry: expiry, RepositoryId: repository.Id, ParentIid: parent.iid, Parent: parent.parent})
+		require.NoError(t, err)
+	}
+
+	// bounty whose escrow can't be refunded
+	unfunded := gitopiatypes.Bounty{
+		Amount:       bountyCoins(100),
+		State:        gitopiatypes.BountyStateSRCDEBITTED,
+		RepositoryId: repository.Id,
+		ParentIid:    1,
+		Parent:       gitopiatypes.BountyParentIssue,
+		ExpireAt:     expiry,
+		Creator:      users[1],
+	}
+	unfunded.Id = k.AppendBounty(ctx, unfunded)
+	k.InsertBountyExpiryQueue(ctx, unfunded)
+
+	ctx = ctx.WithBlockTime(time.Unix(expiry, 0))
+	k.ExpireBounties(ctx)
+
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, gitopiatypes.BountyStateREVERTEDBACK, bounty.State)
+	require.Equal(t, time.Time{}.Unix(), bounty.ExpireAt)
+	issue, _ = k.GetRepositoryIssue(ctx, repository.Id, 1)
+	comment, _ := k.GetIssueComment(ctx, repository.Id, 1, issue.CommentsCount)
+	require.Equal(t, utils.ExpireBountyCommentBody(), comment.Body)
+	require.Equal(t, gitopiatypes.CommentTypeClosedBounty, comment.CommentType)
+	require.Equal(t, int64(800), getBalance(a, ctx, users[0]))
+
+	// pull requests are in progress, the expiry is postponed
+	for _, id := range []uint64{1, 2} {
+		bounty, _ = k.GetBounty(ctx, id)
+		require.Equal(t, gitopiatypes.BountyStateSRCDEBITTED, bounty.State)
+		require.Equal(t, expiry+gitopiatypes.BountyExpiryPostponement, bounty.ExpireAt)
+		require.Equal(t, int64(100), getBalance(a, ctx, keeper.GetBountyAddress(id).String()))
+	}
+
+	// the failed bounty is left as is but dropped from the queue
+	bounty, _ = k.GetBounty(ctx, unfunded.Id)
+	require.Equal(t, gitopiatypes.BountyStateSRCDEBITTED, bounty.State)
+	require.Equal(t, int64(1000), getBalance(a, ctx, users[1]))
+
+	var queued []uint64
+	k.IterateExpiredBountyQueue(ctx, expiry+gitopiatypes.BountyExpiryPostponement, func(bounty gitopiatypes.Bounty) bool {
+		queued = append(queued, bounty.Id)
+		return false
+	})
+	require.Equal(t, []uint64{1, 2}, queued)
+}
+
+func TestExpireBountiesLimit(t *testing.T) {
+	a, ctx, srv, users, repositoryId := setupPreBountyApp(t)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+	repository, _ := k.GetAddressRepository(ctx, users[0], repositoryId.Name)
+	expiry := ctx.BlockTime().Unix() + 10
+
+	for i := 0; i < gitopiatypes.MaxExpiredBountiesPerBlock+1; i++ {
+		_, err := srv.CreateBounty(goCtx, &gitopiatypes.MsgCreateBounty{Creator: users[0], Amount: bountyCoins(1), Expiry: expiry, RepositoryId: repository.Id, ParentIid: 1, Parent: gitopiatypes.BountyParentIssue})
+		require.NoError(t, err)
+	}
+
+	countExpired := func() (n int) {
+		for _, bounty := range k.GetAllBounty(ctx) {
+			if bounty.State == gitopiatypes.BountyStateREVERTEDBACK {
+				n++
+			}
+		}
+		return n
+	}
+
+	ctx = ctx.WithBlockTime(time.Unix(expiry, 0))
+	k.ExpireBounties(ctx)
+	require.Equal(t, gitopiatypes.MaxExpiredBountiesPerBlock, countExpired())
+
+	ctx = ctx.WithBlockTime(time.Unix(expiry+5, 0))
+	k.ExpireBounties(ctx)
+	require.Equal(t, gitopiatypes.MaxExpiredBountiesPerBlock+1, countExpired())
+	require.Equal(t, int64(1000), getBalance(a, ctx, users[0]))
+}
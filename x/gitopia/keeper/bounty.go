@@ -2,11 +2,18 @@ package keeper
 
 import (
 	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
 // GetBountyCount get the total number of bounty
@@ -48,17 +55,29 @@ func (k Keeper) AppendBounty(
 	appendedValue := k.cdc.MustMarshal(&bounty)
 	store.Set(GetBountyIDBytes(bounty.Id), appendedValue)
 
+	if bounty.State == types.BountyStateSRCDEBITTED {
+		k.InsertBountyExpiryQueue(ctx, bounty)
+	}
+
 	// Update bounty count
 	k.SetBountyCount(ctx, count+1)
 
 	return count
 }
 
-// SetBounty set a specific bounty in the store
+// SetBounty set a specific bounty in the store and keeps the expiry queue in sync
 func (k Keeper) SetBounty(ctx sdk.Context, bounty types.Bounty) {
+	if old, found := k.GetBounty(ctx, bounty.Id); found {
+		k.RemoveFromBountyExpiryQueue(ctx, old)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyKey))
 	b := k.cdc.MustMarshal(&bounty)
 	store.Set(GetBountyIDBytes(bounty.Id), b)
+
+	if bounty.State == types.BountyStateSRCDEBITTED {
+		k.InsertBountyExpiryQueue(ctx, bounty)
+	}
 }
 
 // GetBounty returns a bounty from its id
@@ -74,6 +93,10 @@ func (k Keeper) GetBounty(ctx sdk.Context, id uint64) (val types.Bounty, found b
 
 // RemoveBounty removes a bounty from the store
 func (k Keeper) RemoveBounty(ctx sdk.Context, id uint64) {
+	if bounty, found := k.GetBounty(ctx, id); found {
+		k.RemoveFromBountyExpiryQueue(ctx, bounty)
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyKey))
 	store.Delete(GetBountyIDBytes(id))
 }
@@ -94,6 +117,398 @@ func (k Keeper) GetAllBounty(ctx sdk.Context) (list []types.Bounty) {
 	return
 }
 
+// GetBountyContributions returns the contributions made to a bounty. Bounties
+// without recorded contributions are treated as funded entirely by their creator.
+func GetBountyContributions(bounty types.Bounty) []types.BountyContribution {
+	if len(bounty.Contributions) > 0 {
+		return bounty.Contributions
+	}
+	return []types.BountyContribution{{Address: bounty.Creator, Amount: bounty.Amount}}
+}
+
+// AddBountyContribution records the amount contributed by address to a bounty
+func AddBountyContribution(bounty *types.Bounty, address string, amount sdk.Coins) {
+	bounty.Contributions = GetBountyContributions(*bounty)
+	for i := range bounty.Contributions {
+		if bounty.Contributions[i].Address == address {
+			bounty.Contributions[i].Amount = bounty.Contributions[i].Amount.Add(amount...)
+			return
+		}
+	}
+	bounty.Contributions = append(bounty.Contributions, types.BountyContribution{
+		Address: address,
+		Amount:  amount,
+	})
+}
+
+// GetBountyRemainingAmount returns the amount of a bounty which hasn't been
+// released through milestones yet
+func GetBountyRemainingAmount(bounty types.Bounty) sdk.Coins {
+	return bounty.Amount.Sub(bounty.Released...)
+}
+
+// RefundBounty sends the unreleased amount of a bounty back to its
+// contributors pro-rata to their contributions. The rounding remainder goes to the first
+// contributor, i.e. the bounty creator.
+func (k Keeper) RefundBounty(ctx sdk.Context, bounty types.Bounty) error {
+	amount := GetBountyRemainingAmount(bounty)
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return err
+	}
+
+	contributions := GetBountyContributions(bounty)
+
+	var totalContributed sdk.Coins
+	for _, contribution := range contributions {
+		totalContributed = totalContributed.Add(contribution.Amount...)
+	}
+
+	shares := make([]sdk.Coins, len(contributions))
+	remaining := amount
+	for i, contribution := range contributions {
+		for _, coin := range amount {
+			contributed := contribution.Amount.AmountOf(coin.Denom)
+			if contributed.IsZero() {
+				continue
+			}
+			share := coin.Amount.Mul(contributed).Quo(totalContributed.AmountOf(coin.Denom))
+			shares[i] = shares[i].Add(sdk.NewCoin(coin.Denom, share))
+		}
+		remaining = remaining.Sub(shares[i]...)
+	}
+	shares[0] = shares[0].Add(remaining...)
+
+	var outputs []banktypes.Output
+	for i, contribution := range contributions {
+		if shares[i].IsZero() {
+			continue
+		}
+		accAddress, err := sdk.AccAddressFromBech32(contribution.Address)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(accAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", contribution.Address)
+		}
+		outputs = append(outputs, banktypes.NewOutput(accAddress, shares[i]))
+	}
+
+	return k.bankKeeper.InputOutputCoins(ctx,
+		[]banktypes.Input{banktypes.NewInput(GetBountyAddress(bounty.Id), amount)},
+		outputs,
+	)
+}
+
+// GetBountyRewards splits amount between the issue assignees according to
+// the bounty split of the issue. Whatever is left over after rounding goes to
+// remainderTo.
+func GetBountyRewards(amount sdk.Coins, assignees []string, split types.BountySplit, remainderTo string) ([]string, []sdk.Coins) {
+	var recipients []string
+	var rewards []sdk.Coins
+
+	switch split.Type {
+	case types.BountySplitTypePercentage:
+		for _, share := range split.Shares {
+			var reward sdk.Coins
+			for _, coin := range amount {
+				reward = reward.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(share.Percentage)).QuoRaw(100)))
+			}
+			recipients = append(recipients, share.Address)
+			rewards = append(rewards, reward)
+		}
+	default:
+		for _, assignee := range assignees {
+			var reward sdk.Coins
+			for _, coin := range amount {
+				reward = reward.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(len(assignees)))))
+			}
+			recipients = append(recipients, assignee)
+			rewards = append(rewards, reward)
+		}
+	}
+
+	remaining := amount
+	for _, reward := range rewards {
+		remaining = remaining.Sub(reward...)
+	}
+	if remaining.IsZero() {
+		return recipients, rewards
+	}
+
+	if i, exists := utils.AssigneeExists(recipients, remainderTo); exists {
+		rewards[i] = rewards[i].Add(remaining...)
+	} else {
+		recipients = append(recipients, remainderTo)
+		rewards = append(rewards, remaining)
+	}
+
+	return recipients, rewards
+}
+
+// RewardBounty transfers the unreleased bounty amount from the bounty account
+// to the recipients and marks the bounty as credited. Only the recipients with a
+// non-zero reward are recorded in RewardedTo.
+func (k Keeper) RewardBounty(ctx sdk.Context, bounty types.Bounty, recipients []string, rewards []sdk.Coins) error {
+	amount := GetBountyRemainingAmount(bounty)
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return err
+	}
+
+	var rewardedTo []string
+	var outputs []banktypes.Output
+	for i, recipient := range recipients {
+		if rewards[i].IsZero() {
+			continue
+		}
+		accAddress, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return err
+		}
+		if k.bankKeeper.BlockedAddr(accAddress) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+		}
+		outputs = append(outputs, banktypes.NewOutput(accAddress, rewards[i]))
+		rewardedTo = append(rewardedTo, recipient)
+	}
+
+	if len(outputs) > 0 {
+		if err := k.bankKeeper.InputOutputCoins(ctx,
+			[]banktypes.Input{banktypes.NewInput(GetBountyAddress(bounty.Id), amount)},
+			outputs,
+		); err != nil {
+			return err
+		}
+	}
+
+	bounty.State = types.BountyStateDESTCREDITED
+	for _, recipient := range rewardedTo {
+		if _, exists := utils.AssigneeExists(bounty.RewardedTo, recipient); !exists {
+			bounty.RewardedTo = append(bounty.RewardedTo, recipient)
+		}
+	}
+	bounty.Released = bounty.Released.Add(amount...)
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetBounty(ctx, bounty)
+
+	rewardedToJson, _ := json.Marshal(rewardedTo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.RewardBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedTo, string(rewardedToJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return nil
+}
+
+// IsBountyClaimant checks whether address is working on the parent of a
+// bounty, i.e. an assignee or the author of a linked pull request of the issue,
+// or the author of the pull request.
+func (k Keeper) IsBountyClaimant(ctx sdk.Context, bounty types.Bounty, address string) bool {
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return false
+		}
+		if _, exists := utils.AssigneeExists(issue.Assignees, address); exists {
+			return true
+		}
+		for _, pullRequestIid := range issue.PullRequests {
+			pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, pullRequestIid.Iid)
+			if found && pullRequest.Creator == address {
+				return true
+			}
+		}
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if found && pullRequest.Creator == address {
+			return true
+		}
+	}
+	return false
+}
+
+// SettleBountyDispute settles the escrow of a disputed bounty by paying it
+// out to the contributor, refunding it to the contributors of the bounty or
+// splitting it between the two.
+func (k Keeper) SettleBountyDispute(ctx sdk.Context, bounty types.Bounty, resolution types.BountyDisputeResolution, payoutPercentage uint64, resolvedBy string) error {
+	if bounty.Dispute == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty isn't disputed")
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	bounty.Dispute.Resolution = resolution
+	bounty.Dispute.PayoutPercentage = payoutPercentage
+	bounty.Dispute.ResolvedBy = resolvedBy
+	bounty.Dispute.ResolvedAt = blockTime
+
+	amount := GetBountyRemainingAmount(bounty)
+
+	switch resolution {
+	case types.BountyDisputeResolutionPayout:
+		return k.RewardBounty(ctx, bounty, []string{bounty.Dispute.Contributor}, []sdk.Coins{amount})
+	case types.BountyDisputeResolutionRefund:
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			return err
+		}
+		bounty.State = types.BountyStateREVERTEDBACK
+	case types.BountyDisputeResolutionSplit:
+		var payout sdk.Coins
+		for _, coin := range amount {
+			payout = payout.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(payoutPercentage)).QuoRaw(100)))
+		}
+
+		if !payout.IsZero() {
+			if err := k.bankKeeper.IsSendEnabledCoins(ctx, payout...); err != nil {
+				return err
+			}
+			contributorAccAddress, err := sdk.AccAddressFromBech32(bounty.Dispute.Contributor)
+			if err != nil {
+				return err
+			}
+			if k.bankKeeper.BlockedAddr(contributorAccAddress) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", bounty.Dispute.Contributor)
+			}
+			if err := k.bankKeeper.SendCoins(ctx, GetBountyAddress(bounty.Id), contributorAccAddress, payout); err != nil {
+				return err
+			}
+
+			bounty.Released = bounty.Released.Add(payout...)
+			if _, exists := utils.AssigneeExists(bounty.RewardedTo, bounty.Dispute.Contributor); !exists {
+				bounty.RewardedTo = append(bounty.RewardedTo, bounty.Dispute.Contributor)
+			}
+		}
+
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			return err
+		}
+		bounty.State = types.BountyStateDESTCREDITED
+	default:
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid resolution (%v)", resolution))
+	}
+
+	bounty.ExpireAt = time.Time{}.Unix()
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	return nil
+}
+
+// HasDisputedBounty reports whether any of the given bounties awaits the
+// resolution of a dispute
+func (k Keeper) HasDisputedBounty(ctx sdk.Context, bountyIds []uint64) bool {
+	for _, bountyId := range bountyIds {
+		if bounty, found := k.GetBounty(ctx, bountyId); found && bounty.State == types.BountyStateDISPUTED {
+			return true
+		}
+	}
+	return false
+}
+
+// appendBountyComment records a system comment on the parent of a bounty
+func (k Keeper) appendBountyComment(ctx sdk.Context, bounty types.Bounty, body string, commentType types.CommentType) {
+	blockTime := ctx.BlockTime().Unix()
+
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return
+		}
+
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+
+		k.AppendComment(ctx, types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentIssue,
+			CommentIid:   issue.CommentsCount,
+			Body:         body,
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  commentType,
+		})
+		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return
+		}
+
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+
+		k.AppendComment(ctx, types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: bounty.RepositoryId,
+			ParentIid:    bounty.ParentIid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         body,
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  commentType,
+		})
+		k.SetPullRequest(ctx, pullRequest)
+	}
+}
+
+// InsertBountyExpiryQueue inserts a bounty into the expiry queue at its expiry time
+func (k Keeper) InsertBountyExpiryQueue(ctx sdk.Context, bounty types.Bounty) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryQueueKey))
+	store.Set(GetBountyExpiryQueueKey(bounty.ExpireAt, bounty.Id), GetBountyIDBytes(bounty.Id))
+}
+
+// RemoveFromBountyExpiryQueue removes a bounty from the expiry queue
+func (k Keeper) RemoveFromBountyExpiryQueue(ctx sdk.Context, bounty types.Bounty) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryQueueKey))
+	store.Delete(GetBountyExpiryQueueKey(bounty.ExpireAt, bounty.Id))
+}
+
+// IterateExpiredBountyQueue iterates over the bounties expiring at or before
+// endTime in expiry order and calls cb on each of them. The iteration stops
+// when cb returns true.
+func (k Keeper) IterateExpiredBountyQueue(ctx sdk.Context, endTime int64, cb func(bounty types.Bounty) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BountyExpiryQueueKey))
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(endTime)+1))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bounty, found := k.GetBounty(ctx, GetBountyIDFromBytes(iterator.Value()))
+		if !found {
+			continue
+		}
+		if cb(bounty) {
+			break
+		}
+	}
+}
+
+// GetBountyExpiryQueueKey returns the expiry queue key of a bounty
+func GetBountyExpiryQueueKey(expireAt int64, bountyId uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expireAt)), GetBountyIDBytes(bountyId)...)
+}
+
 // GetBountyIDBytes returns the Module address for bounty id
 func GetBountyAddress(bountyId uint64) sdk.AccAddress {
 	key := append([]byte("bounty"), sdk.Uint64ToBigEndian(bountyId)...)
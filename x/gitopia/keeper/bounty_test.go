@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/app/params"
 	keepertest "github.com/gitopia/gitopia/testutil/keeper"
 	"github.com/gitopia/gitopia/testutil/nullify"
 	"github.com/gitopia/gitopia/x/gitopia/keeper"
@@ -57,3 +58,89 @@ func TestBountyCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetBountyCount(ctx))
 }
+
+func TestBountyExpiryQueue(t *testing.T) {
+	keeper, ctx := keepertest.GitopiaKeeper(t)
+	items := make([]types.Bounty, 5)
+	for i := range items {
+		items[i].ExpireAt = int64(100 * (len(items) - i))
+		items[i].Id = keeper.AppendBounty(ctx, items[i])
+	}
+
+	var expired []uint64
+	keeper.IterateExpiredBountyQueue(ctx, 300, func(bounty types.Bounty) bool {
+		expired = append(expired, bounty.Id)
+		return false
+	})
+	require.Equal(t, []uint64{4, 3, 2}, expired)
+
+	// closed bounties leave the queue
+	items[4].State = types.BountyStateREVERTEDBACK
+	keeper.SetBounty(ctx, items[4])
+
+	// updated expiry moves the bounty in the queue
+	items[3].ExpireAt = 1000
+	keeper.SetBounty(ctx, items[3])
+
+	keeper.RemoveBounty(ctx, items[2].Id)
+
+	expired = nil
+	keeper.IterateExpiredBountyQueue(ctx, 500, func(bounty types.Bounty) bool {
+		expired = append(expired, bounty.Id)
+		return len(expired) == 1
+	})
+	require.Equal(t, []uint64{1}, expired)
+}
+
+func TestBountyContributions(t *testing.T) {
+	bounty := types.Bounty{
+		Creator: "A",
+		Amount:  sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 100)),
+	}
+	require.Equal(t, []types.BountyContribution{
+		{Address: "A", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 100))},
+	}, keeper.GetBountyContributions(bounty))
+
+	keeper.AddBountyContribution(&bounty, "B", sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 50)))
+	keeper.AddBountyContribution(&bounty, "A", sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 25)))
+	require.Equal(t, []types.BountyContribution{
+		{Address: "A", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 125))},
+		{Address: "B", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 50))},
+	}, bounty.Contributions)
+}
+
+func TestGetBountyRewards(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 100))
+	assignees := []string{"a", "b", "c"}
+
+	recipients, rewards := keeper.GetBountyRewards(amount, assignees, types.BountySplit{}, "b")
+	require.Equal(t, assignees, recipients)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 33)),
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 34)),
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 33)),
+	}, rewards)
+
+	split := types.BountySplit{
+		Type: types.BountySplitTypePercentage,
+		Shares: []types.BountyShare{
+			{Address: "a", Percentage: 70},
+			{Address: "c", Percentage: 30},
+		},
+	}
+	recipients, rewards = keeper.GetBountyRewards(amount, assignees, split, "b")
+	require.Equal(t, []string{"a", "c"}, recipients)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 70)),
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 30)),
+	}, rewards)
+
+	amount = sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 99))
+	recipients, rewards = keeper.GetBountyRewards(amount, assignees, split, "b")
+	require.Equal(t, []string{"a", "c", "b"}, recipients)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 69)),
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 29)),
+		sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1)),
+	}, rewards)
+}
@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
+	"path"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
+// maxBranchProtectionRules bounds the number of patterns matched on every branch update
+const maxBranchProtectionRules = 20
+
 // GetBranchCount get the total number of branch
 func (k Keeper) GetBranchCount(ctx sdk.Context) uint64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{})
@@ -134,3 +140,98 @@ func GetBranchIDBytes(id uint64) []byte {
 func GetBranchIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
+
+// GetBranchProtectionRules returns the repository protection rules whose pattern matches the branch
+func GetBranchProtectionRules(repository types.Repository, branchName string) (rules []*types.BranchProtectionRule) {
+	for _, rule := range repository.BranchProtectionRules {
+		if matched, _ := path.Match(rule.Pattern, branchName); matched {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// branchPushPermission returns the minimum permission required to update the branch
+func branchPushPermission(rules []*types.BranchProtectionRule) types.RepositoryCollaborator_Permission {
+	permission := types.PushBranchPermission
+	for _, rule := range rules {
+		if rule.MinPushPermission > permission {
+			permission = rule.MinPushPermission
+		}
+	}
+	return permission
+}
+
+// branchRequiresPullRequest reports whether changes to the branch must be merged through a pull request
+func branchRequiresPullRequest(rules []*types.BranchProtectionRule) bool {
+	for _, rule := range rules {
+		if rule.RequirePullRequest {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckBranchPush returns an error if the protection rules of the branch
+// don't allow creator to point it to sha
+func (k Keeper) CheckBranchPush(ctx sdk.Context, creator string, repository types.Repository, branchName string, sha string) error {
+	rules := GetBranchProtectionRules(repository, branchName)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if !k.HavePermission(ctx, creator, repository, branchPushPermission(rules)) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to push to protected branch (%v)", creator, branchName))
+	}
+
+	if !branchRequiresPullRequest(rules) {
+		return nil
+	}
+
+	// syncing the branch to its current sha doesn't bypass review
+	branch, found := k.GetRepositoryBranch(ctx, repository.Id, branchName)
+	if found && branch.Sha == sha {
+		return nil
+	}
+
+	if k.IsPullRequestMergeCommit(ctx, repository.Id, branchName, sha) {
+		return nil
+	}
+
+	// a new branch may only start from reviewed changes, i.e. the head of
+	// another branch requiring pull requests, unless the repository is empty
+	if !found {
+		branches := k.GetAllRepositoryBranch(ctx, repository.Id)
+		if len(branches) == 0 {
+			return nil
+		}
+		for _, b := range branches {
+			if b.Sha == sha && branchRequiresPullRequest(GetBranchProtectionRules(repository, b.Name)) {
+				return nil
+			}
+		}
+	}
+
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("branch (%v) is protected: changes must be merged through a pull request", branchName))
+}
+
+// CheckBranchDelete returns an error if the protection rules of the branch
+// don't allow creator to delete it
+func (k Keeper) CheckBranchDelete(ctx sdk.Context, creator string, repository types.Repository, branchName string) error {
+	rules := GetBranchProtectionRules(repository, branchName)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	if !k.HavePermission(ctx, creator, repository, branchPushPermission(rules)) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to push to protected branch (%v)", creator, branchName))
+	}
+
+	for _, rule := range rules {
+		if !rule.AllowDeletion {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("branch (%v) is protected from deletion", branchName))
+		}
+	}
+
+	return nil
+}
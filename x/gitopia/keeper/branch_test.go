@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	keepertest "github.com/gitopia/gitopia/testutil/keeper"
 	"github.com/gitopia/gitopia/testutil/nullify"
+	"github.com/gitopia/gitopia/testutil/sample"
 	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 	"github.com/stretchr/testify/require"
@@ -69,3 +71,70 @@ func TestBranchCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetBranchCount(ctx))
 }
+
+func TestGetBranchProtectionRules(t *testing.T) {
+	repository := types.Repository{
+		BranchProtectionRules: []*types.BranchProtectionRule{
+			{Pattern: "main", MinPushPermission: types.RepositoryCollaborator_ADMIN},
+			{Pattern: "release/*", RequirePullRequest: true},
+			{Pattern: "*", AllowDeletion: true},
+		},
+	}
+
+	for _, tc := range []struct {
+		branch   string
+		patterns []string
+	}{
+		{branch: "main", patterns: []string{"main", "*"}},
+		{branch: "release/v1", patterns: []string{"release/*"}},
+		{branch: "feature", patterns: []string{"*"}},
+		{branch: "release/v1/hotfix", patterns: nil},
+	} {
+		t.Run(tc.branch, func(t *testing.T) {
+			var patterns []string
+			for _, rule := range keeper.GetBranchProtectionRules(repository, tc.branch) {
+				patterns = append(patterns, rule.Pattern)
+			}
+			require.Equal(t, tc.patterns, patterns)
+		})
+	}
+}
+
+func TestCheckBranchPush(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+
+	owner := sample.AccAddress()
+	repository := types.Repository{
+		Name:  "repository",
+		Owner: &types.RepositoryOwner{Id: owner, Type: types.OwnerType_USER},
+		BranchProtectionRules: []*types.BranchProtectionRule{
+			{Pattern: "main", RequirePullRequest: true},
+			{Pattern: "release/*", RequirePullRequest: true},
+		},
+	}
+	repository.Id = k.AppendRepository(ctx, repository)
+
+	mainSha, mergeSha, featureSha := "a", "b", "c"
+
+	// the first push of an empty repository creates the protected branch
+	require.NoError(t, k.CheckBranchPush(ctx, owner, repository, "main", mainSha))
+	k.AppendBranch(ctx, types.Branch{RepositoryId: repository.Id, Name: "main", Sha: mainSha})
+	k.AppendBranch(ctx, types.Branch{RepositoryId: repository.Id, Name: "feature", Sha: featureSha})
+
+	require.ErrorIs(t, k.CheckBranchPush(ctx, owner, repository, "main", mergeSha), sdkerrors.ErrUnauthorized)
+
+	k.AppendPullRequest(ctx, types.PullRequest{
+		Iid:            1,
+		State:          types.PullRequest_MERGED,
+		MergeCommitSha: mergeSha,
+		Base:           &types.PullRequestBase{RepositoryId: repository.Id, Branch: "main"},
+		Head:           &types.PullRequestHead{RepositoryId: repository.Id, Branch: "feature"},
+	})
+	require.NoError(t, k.CheckBranchPush(ctx, owner, repository, "main", mergeSha))
+	require.NoError(t, k.CheckBranchPush(ctx, owner, repository, "main", mainSha))
+
+	// new protected branches start from reviewed changes only
+	require.NoError(t, k.CheckBranchPush(ctx, owner, repository, "release/v1", mainSha))
+	require.ErrorIs(t, k.CheckBranchPush(ctx, owner, repository, "release/v2", featureSha), sdkerrors.ErrUnauthorized)
+	require.NoError(t, k.CheckBranchPush(ctx, owner, repository, "feature-2", featureSha))
+}
@@ -183,6 +183,21 @@ func (k Keeper) GetAllPullRequestComment(ctx sdk.Context, repositoryId uint64, p
 	return
 }
 
+// IsCommentThread reports whether a comment starts a line-anchored review thread
+func IsCommentThread(comment types.Comment) bool {
+	return comment.Parent == types.CommentParentPullRequest && comment.InReplyTo == 0 && comment.Path != ""
+}
+
+// GetPullRequestUnresolvedThreadsCount returns the number of unresolved review threads of a pullRequest
+func (k Keeper) GetPullRequestUnresolvedThreadsCount(ctx sdk.Context, repositoryId uint64, pullRequestIid uint64) (count uint64) {
+	for _, comment := range k.GetAllPullRequestComment(ctx, repositoryId, pullRequestIid) {
+		if IsCommentThread(comment) && !comment.Resolved {
+			count++
+		}
+	}
+	return count
+}
+
 // GetCommentIDBytes returns the byte representation of the ID
 func GetCommentIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -71,3 +71,22 @@ func TestCommentCount(t *testing.T) {
 	count := uint64(len(issueItems) + len(pullRequestItems))
 	require.Equal(t, count, keeper.GetCommentCount(ctx))
 }
+
+func TestPullRequestUnresolvedThreadsCount(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	comments := []types.Comment{
+		{Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 1},
+		{Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 2, Path: "main.go", Replies: []uint64{4}},
+		{Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 3, Path: "main.go", Resolved: true},
+		{Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 4, InReplyTo: 2},
+		{Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 5, Path: "keeper.go"},
+	}
+	for _, comment := range comments {
+		k.AppendComment(ctx, comment)
+	}
+
+	require.True(t, keeper.IsCommentThread(comments[1]))
+	require.False(t, keeper.IsCommentThread(comments[3]))
+	require.Equal(t, uint64(2), k.GetPullRequestUnresolvedThreadsCount(ctx, 0, 1))
+	require.Equal(t, uint64(0), k.GetPullRequestUnresolvedThreadsCount(ctx, 0, 2))
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// getCommitStatusKey returns the store key of a commit status in its repository store
+func getCommitStatusKey(sha string, context string) []byte {
+	return []byte(sha + "/" + context)
+}
+
+// SetRepositoryCommitStatus set a specific commit status in the store for repository-id
+func (k Keeper) SetRepositoryCommitStatus(ctx sdk.Context, commitStatus types.CommitStatus) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForRepositoryId(commitStatus.RepositoryId)),
+	)
+	b := k.cdc.MustMarshal(&commitStatus)
+	store.Set(getCommitStatusKey(commitStatus.Sha, commitStatus.Context), b)
+}
+
+// GetRepositoryCommitStatus returns the status reported for a commit under context
+func (k Keeper) GetRepositoryCommitStatus(ctx sdk.Context, repositoryId uint64, sha string, context string) (val types.CommitStatus, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForRepositoryId(repositoryId)),
+	)
+	b := store.Get(getCommitStatusKey(sha, context))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllCommitStatusForSha returns all the statuses reported for a commit
+func (k Keeper) GetAllCommitStatusForSha(ctx sdk.Context, repositoryId uint64, sha string) (list []types.CommitStatus) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetCommitStatusKeyForRepositoryId(repositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(sha+"/"))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommitStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllCommitStatus returns all commit status
+func (k Keeper) GetAllCommitStatus(ctx sdk.Context) (list []types.CommitStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CommitStatusKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CommitStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPullRequestCommitStatuses returns the statuses reported for the head commit of
+// a pullRequest. Only statuses posted on the base repository count, the head
+// repository of a fork is controlled by the pullRequest author.
+func (k Keeper) GetPullRequestCommitStatuses(ctx sdk.Context, pullRequest types.PullRequest) []types.CommitStatus {
+	return k.GetAllCommitStatusForSha(ctx, pullRequest.Base.RepositoryId, pullRequest.Head.CommitSha)
+}
+
+// commitStatusStateName returns the lower case state name used in messages
+func commitStatusStateName(state types.CommitStatusState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "COMMIT_STATUS_STATE_"))
+}
+
+// CombinedCommitStatusState returns failure if any status failed or errored,
+// success if every status succeeded and pending otherwise
+func CombinedCommitStatusState(list []types.CommitStatus) types.CommitStatusState {
+	if len(list) == 0 {
+		return types.CommitStatusStatePending
+	}
+
+	state := types.CommitStatusStateSuccess
+	for _, commitStatus := range list {
+		switch commitStatus.State {
+		case types.CommitStatusStateFailure, types.CommitStatusStateError:
+			return types.CommitStatusStateFailure
+		case types.CommitStatusStatePending:
+			state = types.CommitStatusStatePending
+		}
+	}
+
+	return state
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitStatusGet(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	statuses := []types.CommitStatus{
+		{RepositoryId: 1, Sha: "sha1", Context: "ci/build", State: types.CommitStatusStateSuccess},
+		{RepositoryId: 1, Sha: "sha1", Context: "ci/lint", State: types.CommitStatusStatePending},
+		{RepositoryId: 1, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStateFailure},
+		{RepositoryId: 10, Sha: "sha1", Context: "ci/build", State: types.CommitStatusStateError},
+	}
+	for _, status := range statuses {
+		k.SetRepositoryCommitStatus(ctx, status)
+	}
+
+	got, found := k.GetRepositoryCommitStatus(ctx, 1, "sha2", "ci/build")
+	require.True(t, found)
+	require.Equal(t, statuses[2], got)
+
+	_, found = k.GetRepositoryCommitStatus(ctx, 1, "sha2", "ci/lint")
+	require.False(t, found)
+
+	require.ElementsMatch(t, statuses[:2], k.GetAllCommitStatusForSha(ctx, 1, "sha1"))
+	require.ElementsMatch(t, statuses, k.GetAllCommitStatus(ctx))
+}
+
+func TestPullRequestCommitStatuses(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	pullRequest := types.PullRequest{
+		Head: &types.PullRequestHead{RepositoryId: 2, CommitSha: "sha1"},
+		Base: &types.PullRequestBase{RepositoryId: 1},
+	}
+
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: 1, Sha: "sha1", Context: "ci/build", State: types.CommitStatusStateSuccess})
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: 2, Sha: "sha1", Context: "ci/build", State: types.CommitStatusStateFailure})
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: 2, Sha: "sha1", Context: "ci/lint", State: types.CommitStatusStateSuccess})
+
+	// statuses posted on the fork don't count
+	statuses := k.GetPullRequestCommitStatuses(ctx, pullRequest)
+	require.Len(t, statuses, 1)
+	require.Equal(t, "ci/build", statuses[0].Context)
+	require.Equal(t, types.CommitStatusStateSuccess, keeper.CombinedCommitStatusState(statuses))
+}
+
+func TestCombinedCommitStatusState(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		states []types.CommitStatusState
+		state  types.CommitStatusState
+	}{
+		{desc: "Empty", state: types.CommitStatusStatePending},
+		{desc: "Success", states: []types.CommitStatusState{types.CommitStatusStateSuccess, types.CommitStatusStateSuccess}, state: types.CommitStatusStateSuccess},
+		{desc: "Pending", states: []types.CommitStatusState{types.CommitStatusStateSuccess, types.CommitStatusStatePending}, state: types.CommitStatusStatePending},
+		{desc: "Error", states: []types.CommitStatusState{types.CommitStatusStatePending, types.CommitStatusStateError}, state: types.CommitStatusStateFailure},
+		{desc: "Failure", states: []types.CommitStatusState{types.CommitStatusStateSuccess, types.CommitStatusStateFailure}, state: types.CommitStatusStateFailure},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var statuses []types.CommitStatus
+			for _, state := range tc.states {
+				statuses = append(statuses, types.CommitStatus{State: state})
+			}
+			require.Equal(t, tc.state, keeper.CombinedCommitStatusState(statuses))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// GetEditHistoryKey returns the store key prefix of the edit history of a comment,
+// or of the issue/pullRequest description when commentIid is 0
+func GetEditHistoryKey(repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64) string {
+	if parent == types.CommentParentPullRequest {
+		return types.GetEditHistoryKeyForPullRequest(repositoryId, parentIid, commentIid)
+	}
+	return types.GetEditHistoryKeyForIssue(repositoryId, parentIid, commentIid)
+}
+
+// AppendEditHistory records a prior revision as the next revision of its comment or description
+func (k Keeper) AppendEditHistory(ctx sdk.Context, entry types.EditHistoryEntry) uint64 {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(GetEditHistoryKey(entry.RepositoryId, entry.Parent, entry.ParentIid, entry.CommentIid)),
+	)
+
+	entry.Revision = 1
+	iterator := sdk.KVStoreReversePrefixIterator(store, []byte{})
+	if iterator.Valid() {
+		entry.Revision = GetEditHistoryRevisionFromBytes(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(GetEditHistoryRevisionBytes(entry.Revision), b)
+
+	return entry.Revision
+}
+
+// SetEditHistory set a specific edit history entry in the store
+func (k Keeper) SetEditHistory(ctx sdk.Context, entry types.EditHistoryEntry) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(GetEditHistoryKey(entry.RepositoryId, entry.Parent, entry.ParentIid, entry.CommentIid)),
+	)
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(GetEditHistoryRevisionBytes(entry.Revision), b)
+}
+
+// GetEditHistory returns the edit history of a comment or description in revision order
+func (k Keeper) GetEditHistory(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64) (list []types.EditHistoryEntry) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(GetEditHistoryKey(repositoryId, parent, parentIid, commentIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EditHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveEditHistory removes the edit history of a comment or description
+func (k Keeper) RemoveEditHistory(ctx sdk.Context, repositoryId uint64, parent types.CommentParent, parentIid uint64, commentIid uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(GetEditHistoryKey(repositoryId, parent, parentIid, commentIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllEditHistory returns all edit history entries
+func (k Keeper) GetAllEditHistory(ctx sdk.Context) (list []types.EditHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EditHistoryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EditHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetEditHistoryRevisionBytes returns the byte representation of the revision
+func GetEditHistoryRevisionBytes(revision uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, revision)
+	return bz
+}
+
+// GetEditHistoryRevisionFromBytes returns revision in uint64 format from a byte array
+func GetEditHistoryRevisionFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
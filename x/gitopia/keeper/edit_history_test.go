@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditHistory(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+
+	for _, body := range []string{"first", "second", "third"} {
+		k.AppendEditHistory(ctx, types.EditHistoryEntry{
+			RepositoryId: 1,
+			Parent:       types.CommentParentIssue,
+			ParentIid:    1,
+			CommentIid:   2,
+			Editor:       "editor",
+			Body:         body,
+		})
+	}
+	// the description of the issue and the pull request with the same iid have their own history
+	descriptionRevision := k.AppendEditHistory(ctx, types.EditHistoryEntry{RepositoryId: 1, Parent: types.CommentParentIssue, ParentIid: 1, Body: "description"})
+	pullRequestRevision := k.AppendEditHistory(ctx, types.EditHistoryEntry{RepositoryId: 1, Parent: types.CommentParentPullRequest, ParentIid: 1, CommentIid: 2, Body: "comment"})
+	require.Equal(t, uint64(1), descriptionRevision)
+	require.Equal(t, uint64(1), pullRequestRevision)
+
+	history := k.GetEditHistory(ctx, 1, types.CommentParentIssue, 1, 2)
+	require.Len(t, history, 3)
+	for i, entry := range history {
+		require.Equal(t, uint64(i+1), entry.Revision)
+	}
+	require.Equal(t, "first", history[0].Body)
+	require.Equal(t, "third", history[2].Body)
+
+	require.Len(t, k.GetAllEditHistory(ctx), 5)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// GetFollowLists returns the followers and following of a user or dao
+func (k Keeper) GetFollowLists(ctx sdk.Context, address WhoisAddress) (followers []string, following []string, found bool) {
+	switch address.OwnerType {
+	case types.OwnerType_USER:
+		user, found := k.GetUser(ctx, address.Address)
+		if !found {
+			return nil, nil, false
+		}
+		return user.Followers, user.Following, true
+	case types.OwnerType_DAO:
+		dao, found := k.GetDao(ctx, address.Address)
+		if !found {
+			return nil, nil, false
+		}
+		return dao.Followers, dao.Following, true
+	}
+	return nil, nil, false
+}
+
+// SetFollowLists sets the followers and following of a user or dao
+func (k Keeper) SetFollowLists(ctx sdk.Context, address WhoisAddress, followers []string, following []string) {
+	switch address.OwnerType {
+	case types.OwnerType_USER:
+		if user, found := k.GetUser(ctx, address.Address); found {
+			user.Followers = followers
+			user.Following = following
+			k.SetUser(ctx, user)
+		}
+	case types.OwnerType_DAO:
+		if dao, found := k.GetDao(ctx, address.Address); found {
+			dao.Followers = followers
+			dao.Following = following
+			k.SetDao(ctx, dao)
+		}
+	}
+}
+
+// RemoveFollowReferences removes a user or dao from the following list of
+// its followers and from the followers list of everyone it follows
+func (k Keeper) RemoveFollowReferences(ctx sdk.Context, address WhoisAddress) {
+	followers, following, found := k.GetFollowLists(ctx, address)
+	if !found {
+		return
+	}
+
+	for _, follower := range followers {
+		followerAddress, err := k.ResolveAddress(ctx, follower)
+		if err != nil {
+			continue
+		}
+		f, g, _ := k.GetFollowLists(ctx, *followerAddress)
+		if i, exists := addressExists(g, address.Address); exists {
+			g = append(g[:i], g[i+1:]...)
+			k.SetFollowLists(ctx, *followerAddress, f, g)
+		}
+	}
+
+	for _, followee := range following {
+		followeeAddress, err := k.ResolveAddress(ctx, followee)
+		if err != nil {
+			continue
+		}
+		f, g, _ := k.GetFollowLists(ctx, *followeeAddress)
+		if i, exists := addressExists(f, address.Address); exists {
+			f = append(f[:i], f[i+1:]...)
+			k.SetFollowLists(ctx, *followeeAddress, f, g)
+		}
+	}
+}
+
+// addressExists returns the index of address in addresses
+func addressExists(addresses []string, address string) (int, bool) {
+	for i, a := range addresses {
+		if a == address {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveFollowReferences(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	users := createNUser(k, ctx, 3)
+
+	addresses := make([]keeper.WhoisAddress, len(users))
+	for i, user := range users {
+		addresses[i] = keeper.WhoisAddress{Address: user.Creator, OwnerType: types.OwnerType_USER}
+	}
+
+	// users[0] and users[1] follow each other, users[2] follows users[0]
+	k.SetFollowLists(ctx, addresses[0], []string{users[1].Creator, users[2].Creator}, []string{users[1].Creator})
+	k.SetFollowLists(ctx, addresses[1], []string{users[0].Creator}, []string{users[0].Creator})
+	k.SetFollowLists(ctx, addresses[2], nil, []string{users[0].Creator})
+
+	k.RemoveFollowReferences(ctx, addresses[0])
+
+	followers, following, found := k.GetFollowLists(ctx, addresses[1])
+	require.True(t, found)
+	require.Empty(t, followers)
+	require.Empty(t, following)
+
+	followers, following, found = k.GetFollowLists(ctx, addresses[2])
+	require.True(t, found)
+	require.Empty(t, followers)
+	require.Empty(t, following)
+}
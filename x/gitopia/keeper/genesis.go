@@ -31,6 +31,26 @@ func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
 	// Set branch count
 	k.SetBranchCount(ctx, genState.BranchCount)
 
+	// Set all the edit history
+	for _, elem := range genState.EditHistoryList {
+		k.SetEditHistory(ctx, elem)
+	}
+
+	// Set all the milestone
+	for _, elem := range genState.MilestoneList {
+		k.SetMilestone(ctx, elem)
+	}
+
+	// Set all the issue redirect
+	for _, elem := range genState.IssueRedirectList {
+		k.SetIssueRedirect(ctx, elem)
+	}
+
+	// Set all the commit status
+	for _, elem := range genState.CommitStatusList {
+		k.SetRepositoryCommitStatus(ctx, elem)
+	}
+
 	// Set all the tag
 	for _, elem := range genState.TagList {
 		k.SetRepositoryTag(ctx, elem)
@@ -177,6 +197,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
 	genesis.BranchList = k.GetAllBranch(ctx)
 	genesis.BranchCount = k.GetBranchCount(ctx)
 
+	genesis.CommitStatusList = k.GetAllCommitStatus(ctx)
+
+	genesis.EditHistoryList = k.GetAllEditHistory(ctx)
+	genesis.MilestoneList = k.GetAllMilestone(ctx)
+	genesis.IssueRedirectList = k.GetAllIssueRedirect(ctx)
+
 	genesis.TagList = k.GetAllTag(ctx)
 	genesis.TagCount = k.GetTagCount(ctx)
 
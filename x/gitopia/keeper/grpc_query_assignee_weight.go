@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryAssigneeWeightAll(c context.Context, req *types.QueryAllRepositoryAssigneeWeightRequest) (*types.QueryAllRepositoryAssigneeWeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryAllRepositoryAssigneeWeightResponse{
+		AssigneeWeights: k.GetRepositoryAssigneeWeights(ctx, repository.Id),
+	}, nil
+}
+
+// GetRepositoryAssigneeWeights sums the weight of the open issues of the
+// repository per assignee, ordered by assignee. An issue with several
+// assignees counts fully towards each of them.
+func (k Keeper) GetRepositoryAssigneeWeights(ctx sdk.Context, repositoryId uint64) []types.AssigneeWeight {
+	weights := make(map[string]*types.AssigneeWeight)
+
+	for _, issue := range k.GetAllRepositoryIssue(ctx, repositoryId) {
+		if issue.State != types.Issue_OPEN {
+			continue
+		}
+		for _, assignee := range issue.Assignees {
+			w, ok := weights[assignee]
+			if !ok {
+				w = &types.AssigneeWeight{Assignee: assignee}
+				weights[assignee] = w
+			}
+			w.Weight += issue.Weight
+			w.OpenIssues++
+		}
+	}
+
+	list := make([]types.AssigneeWeight, 0, len(weights))
+	for _, w := range weights {
+		list = append(list, *w)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Assignee < list[j].Assignee
+	})
+
+	return list
+}
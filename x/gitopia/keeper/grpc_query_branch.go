@@ -133,3 +133,23 @@ func (k Keeper) RepositoryBranchSha(c context.Context, req *types.QueryGetReposi
 
 	return &types.QueryGetRepositoryBranchShaResponse{Sha: branch.Sha}, nil
 }
+
+func (k Keeper) RepositoryBranchProtectionRules(c context.Context, req *types.QueryGetRepositoryBranchProtectionRulesRequest) (*types.QueryGetRepositoryBranchProtectionRulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetRepositoryBranchProtectionRulesResponse{Rules: GetBranchProtectionRules(repository, req.BranchName)}, nil
+}
@@ -37,7 +37,11 @@ func (k Keeper) CommentAll(c context.Context, req *types.QueryAllCommentRequest)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllCommentResponse{Comment: comments, Pagination: pageRes}, nil
+	return &types.QueryAllCommentResponse{
+		Comment:        comments,
+		Pagination:     pageRes,
+		ReactionCounts: GetCommentReactionCounts(comments),
+	}, nil
 }
 
 func (k Keeper) IssueCommentAll(c context.Context, req *types.QueryAllIssueCommentRequest) (*types.QueryAllIssueCommentResponse, error) {
@@ -65,7 +69,11 @@ func (k Keeper) IssueCommentAll(c context.Context, req *types.QueryAllIssueComme
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllIssueCommentResponse{Comment: comments, Pagination: pageRes}, nil
+	return &types.QueryAllIssueCommentResponse{
+		Comment:        comments,
+		Pagination:     pageRes,
+		ReactionCounts: GetCommentReactionCounts(comments),
+	}, nil
 }
 
 func (k Keeper) PullRequestCommentAll(c context.Context, req *types.QueryAllPullRequestCommentRequest) (*types.QueryAllPullRequestCommentResponse, error) {
@@ -93,7 +101,11 @@ func (k Keeper) PullRequestCommentAll(c context.Context, req *types.QueryAllPull
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryAllPullRequestCommentResponse{Comment: comments, Pagination: pageRes}, nil
+	return &types.QueryAllPullRequestCommentResponse{
+		Comment:        comments,
+		Pagination:     pageRes,
+		ReactionCounts: GetCommentReactionCounts(comments),
+	}, nil
 }
 
 func (k Keeper) IssueComment(c context.Context, req *types.QueryGetIssueCommentRequest) (*types.QueryGetIssueCommentResponse, error) {
@@ -106,7 +118,10 @@ func (k Keeper) IssueComment(c context.Context, req *types.QueryGetIssueCommentR
 	if !found {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
-	return &types.QueryGetIssueCommentResponse{Comment: &comment}, nil
+	return &types.QueryGetIssueCommentResponse{
+		Comment:        &comment,
+		ReactionCounts: GetReactionCounts(comment.Reactions),
+	}, nil
 }
 
 func (k Keeper) PullRequestComment(c context.Context, req *types.QueryGetPullRequestCommentRequest) (*types.QueryGetPullRequestCommentResponse, error) {
@@ -119,5 +134,8 @@ func (k Keeper) PullRequestComment(c context.Context, req *types.QueryGetPullReq
 	if !found {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
-	return &types.QueryGetPullRequestCommentResponse{Comment: &comment}, nil
+	return &types.QueryGetPullRequestCommentResponse{
+		Comment:        &comment,
+		ReactionCounts: GetReactionCounts(comment.Reactions),
+	}, nil
 }
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryCommitStatusAll(c context.Context, req *types.QueryAllRepositoryCommitStatusRequest) (*types.QueryAllRepositoryCommitStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	statuses := k.GetAllCommitStatusForSha(ctx, repository.Id, req.Sha)
+
+	return &types.QueryAllRepositoryCommitStatusResponse{
+		State:    CombinedCommitStatusState(statuses),
+		Statuses: statuses,
+	}, nil
+}
+
+func (k Keeper) PullRequestCommitStatusAll(c context.Context, req *types.QueryAllPullRequestCommitStatusRequest) (*types.QueryAllPullRequestCommitStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, req.PullIid)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	statuses := k.GetPullRequestCommitStatuses(ctx, pullRequest)
+
+	return &types.QueryAllPullRequestCommitStatusResponse{
+		Sha:      pullRequest.Head.CommitSha,
+		State:    CombinedCommitStatusState(statuses),
+		Statuses: statuses,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) IssueEditHistoryAll(c context.Context, req *types.QueryAllIssueEditHistoryRequest) (*types.QueryAllIssueEditHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var editHistory []types.EditHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	editHistoryStore := prefix.NewStore(store, types.KeyPrefix(types.GetEditHistoryKeyForIssue(req.RepositoryId, req.IssueIid, req.CommentIid)))
+
+	pageRes, err := query.Paginate(editHistoryStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.EditHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		editHistory = append(editHistory, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllIssueEditHistoryResponse{EditHistory: editHistory, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PullRequestEditHistoryAll(c context.Context, req *types.QueryAllPullRequestEditHistoryRequest) (*types.QueryAllPullRequestEditHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var editHistory []types.EditHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	editHistoryStore := prefix.NewStore(store, types.KeyPrefix(types.GetEditHistoryKeyForPullRequest(req.RepositoryId, req.PullRequestIid, req.CommentIid)))
+
+	pageRes, err := query.Paginate(editHistoryStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.EditHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		editHistory = append(editHistory, entry)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPullRequestEditHistoryResponse{EditHistory: editHistory, Pagination: pageRes}, nil
+}
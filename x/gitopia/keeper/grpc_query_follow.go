@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) FollowerAll(c context.Context, req *types.QueryAllFollowerRequest) (*types.QueryAllFollowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	followers, _, found := k.GetFollowLists(ctx, *address)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var page []string
+
+	pageRes, err := PaginateList(len(followers), req.Pagination, func(i int) error {
+		page = append(page, followers[i])
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFollowerResponse{Followers: page, Pagination: pageRes}, nil
+}
+
+func (k Keeper) FollowingAll(c context.Context, req *types.QueryAllFollowingRequest) (*types.QueryAllFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	_, following, found := k.GetFollowLists(ctx, *address)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var page []string
+
+	pageRes, err := PaginateList(len(following), req.Pagination, func(i int) error {
+		page = append(page, following[i])
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFollowingResponse{Following: page, Pagination: pageRes}, nil
+}
@@ -95,12 +95,33 @@ func (k Keeper) RepositoryIssue(c context.Context, req *types.QueryGetRepository
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
+	var redirect *types.IssueRedirect
 	issue, found := k.GetRepositoryIssue(ctx, repository.Id, req.IssueIid)
 	if !found {
-		return nil, sdkerrors.ErrKeyNotFound
+		r, found := k.ResolveIssueRedirect(ctx, repository.Id, req.IssueIid)
+		if !found {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		issue, found = k.GetRepositoryIssue(ctx, r.TargetRepositoryId, r.TargetIid)
+		if !found {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+		redirect = &r
 	}
 
-	return &types.QueryGetRepositoryIssueResponse{Issue: &issue}, nil
+	var linkedIssues []types.Issue
+	for _, link := range issue.Links {
+		if linkedIssue, found := k.GetRepositoryIssue(ctx, link.RepositoryId, link.Iid); found {
+			linkedIssues = append(linkedIssues, linkedIssue)
+		}
+	}
+
+	return &types.QueryGetRepositoryIssueResponse{
+		Issue:          &issue,
+		ReactionCounts: GetReactionCounts(issue.Reactions),
+		LinkedIssues:   linkedIssues,
+		Redirect:       redirect,
+	}, nil
 }
 
 /* PaginateAllRepositoryIssue does pagination of the provided issue list
@@ -122,6 +143,29 @@ func PaginateAllRepositoryIssue(
 		sort.Sort(sort.Reverse(types.IssueList(issues)))
 	}
 
+	switch strings.ToUpper(option.SortBy) {
+	case "", "CREATED":
+	case "WEIGHT":
+		sort.SliceStable(issues, func(i, j int) bool {
+			if option.Sort == "ASC" {
+				return issues[i].Weight < issues[j].Weight
+			}
+			return issues[i].Weight > issues[j].Weight
+		})
+	case "DUE_DATE":
+		sort.SliceStable(issues, func(i, j int) bool {
+			if issues[i].DueDate == 0 || issues[j].DueDate == 0 {
+				return issues[j].DueDate == 0 && issues[i].DueDate != 0
+			}
+			if option.Sort == "ASC" {
+				return issues[i].DueDate < issues[j].DueDate
+			}
+			return issues[i].DueDate > issues[j].DueDate
+		})
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid sort by (%v)", option.SortBy))
+	}
+
 	if option.CreatedBy != "" {
 		var issueBuffer []*types.Issue
 		for _, issue := range issues {
@@ -170,6 +214,21 @@ func PaginateAllRepositoryIssue(
 		issues = issueBuffer
 	}
 
+	if option.CloseReason != "" {
+		closeReason, ok := types.CloseReason_value["CLOSE_REASON_"+strings.ToUpper(option.CloseReason)]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid close reason (%v)", option.CloseReason))
+		}
+
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.CloseReason == types.CloseReason(closeReason) {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
 	if option.Labels == "ANY" {
 		var issueBuffer []*types.Issue
 		for _, issue := range issues {
@@ -207,6 +266,45 @@ func PaginateAllRepositoryIssue(
 		issues = issueBuffer
 	}
 
+	if option.Milestone == "ANY" {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone != 0 {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	} else if option.Milestone == "NONE" {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone == 0 {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
+	if option.MilestoneIid != 0 {
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.Milestone == option.MilestoneIid {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
+	if option.Overdue {
+		blockTime := ctx.BlockTime().Unix()
+		var issueBuffer []*types.Issue
+		for _, issue := range issues {
+			if issue.State == types.Issue_OPEN && issue.DueDate != 0 && issue.DueDate < blockTime {
+				issueBuffer = append(issueBuffer, issue)
+			}
+		}
+		issues = issueBuffer
+	}
+
 	if option.UpdatedAfter != 0 {
 		var issueBuffer []*types.Issue
 		for _, issue := range issues {
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RepositoryMilestoneAll(c context.Context, req *types.QueryAllRepositoryMilestoneRequest) (*types.QueryAllRepositoryMilestoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var state *types.MilestoneState
+	if req.State != "" {
+		value, ok := types.MilestoneState_value["MILESTONE_STATE_"+strings.ToUpper(req.State)]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid milestone state")
+		}
+		s := types.MilestoneState(value)
+		state = &s
+	}
+
+	var milestones []types.Milestone
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	milestoneStore := prefix.NewStore(
+		store,
+		types.KeyPrefix(types.GetMilestoneKeyForRepositoryId(repository.Id)),
+	)
+
+	pageRes, err := query.FilteredPaginate(milestoneStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var milestone types.Milestone
+		if err := k.cdc.Unmarshal(value, &milestone); err != nil {
+			return false, err
+		}
+
+		if state != nil && milestone.State != *state {
+			return false, nil
+		}
+
+		if accumulate {
+			milestones = append(milestones, milestone)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	progress := make([]types.MilestoneProgress, 0, len(milestones))
+	for _, milestone := range milestones {
+		progress = append(progress, k.GetMilestoneProgress(ctx, repository.Id, milestone.Iid))
+	}
+
+	return &types.QueryAllRepositoryMilestoneResponse{Milestones: milestones, Progress: progress, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RepositoryMilestone(c context.Context, req *types.QueryGetRepositoryMilestoneRequest) (*types.QueryGetRepositoryMilestoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	milestone, found := k.GetRepositoryMilestone(ctx, repository.Id, req.Iid)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	progress := k.GetMilestoneProgress(ctx, repository.Id, milestone.Iid)
+
+	return &types.QueryGetRepositoryMilestoneResponse{Milestone: milestone, Progress: progress}, nil
+}
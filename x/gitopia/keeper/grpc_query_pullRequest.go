@@ -103,7 +103,36 @@ func (k Keeper) RepositoryPullRequest(c context.Context, req *types.QueryGetRepo
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryGetRepositoryPullRequestResponse{PullRequest: &pullRequest}, nil
+	return &types.QueryGetRepositoryPullRequestResponse{
+		PullRequest:            &pullRequest,
+		UnresolvedThreadsCount: k.GetPullRequestUnresolvedThreadsCount(ctx, repository.Id, pullRequest.Iid),
+		ReactionCounts:         GetReactionCounts(pullRequest.Reactions),
+	}, nil
+}
+
+func (k Keeper) PullRequestReviewSummary(c context.Context, req *types.QueryGetPullRequestReviewSummaryRequest) (*types.QueryGetPullRequestReviewSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, req.PullIid)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryGetPullRequestReviewSummaryResponse{Summary: GetPullRequestReviewSummary(pullRequest)}, nil
 }
 
 func (k Keeper) PullRequestMergePermission(c context.Context, req *types.QueryGetPullRequestMergePermissionRequest) (*types.QueryGetPullRequestMergePermissionResponse, error) {
@@ -128,11 +157,18 @@ func (k Keeper) PullRequestMergePermission(c context.Context, req *types.QueryGe
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	if k.HavePermission(ctx, address.Address, repository, types.PullRequestMergePermission) {
-		return &types.QueryGetPullRequestMergePermissionResponse{HavePermission: true}, nil
+	if !k.HavePermission(ctx, address.Address, repository, types.PullRequestMergePermission) {
+		return &types.QueryGetPullRequestMergePermissionResponse{
+			HavePermission: false,
+			Reasons:        []string{fmt.Sprintf("user (%v) doesn't have permission to merge", req.UserId)},
+		}, nil
 	}
 
-	return &types.QueryGetPullRequestMergePermissionResponse{HavePermission: false}, nil
+	if reasons := k.PullRequestMergeBlockers(ctx, repository, pullRequest); len(reasons) > 0 {
+		return &types.QueryGetPullRequestMergePermissionResponse{HavePermission: false, Reasons: reasons}, nil
+	}
+
+	return &types.QueryGetPullRequestMergePermissionResponse{HavePermission: true}, nil
 }
 
 /* PaginateAllRepositoryPullRequest does pagination of the provided pullrequest list
@@ -262,6 +298,34 @@ func PaginateAllRepositoryPullRequest(
 		pullRequests = pullRequestBuffer
 	}
 
+	if option.Milestone == "ANY" {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone != 0 {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	} else if option.Milestone == "NONE" {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone == 0 {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	}
+
+	if option.MilestoneIid != 0 {
+		var pullRequestBuffer []*types.PullRequest
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Milestone == option.MilestoneIid {
+				pullRequestBuffer = append(pullRequestBuffer, pullRequest)
+			}
+		}
+		pullRequests = pullRequestBuffer
+	}
+
 	if option.UpdatedAfter != 0 {
 		var pullRequestBuffer []*types.PullRequest
 		for _, pullRequest := range pullRequests {
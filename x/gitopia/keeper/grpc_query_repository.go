@@ -293,6 +293,93 @@ func PaginateAllForkRepository(
 	return res, nil
 }
 
+func (k Keeper) RepositoryStargazerAll(c context.Context, req *types.QueryAllRepositoryStargazerRequest) (*types.QueryAllRepositoryStargazerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, req.RepositoryName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var stargazers []*types.User
+
+	pageRes, err := PaginateList(len(repository.Stargazers), req.Pagination, func(i int) error {
+		if user, found := k.GetUserById(ctx, repository.Stargazers[i]); found {
+			stargazers = append(stargazers, &user)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRepositoryStargazerResponse{Stargazers: stargazers, Pagination: pageRes}, nil
+}
+
+// PaginateList paginates over an in-memory list of the given length,
+// calling onResult with the index of every element in the requested page
+func PaginateList(
+	length int,
+	pageRequest *query.PageRequest,
+	onResult func(i int) error,
+) (*query.PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	key := pageRequest.Key
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+
+	if offset > 0 && key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = DefaultLimit
+
+		// show total count when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	if len(key) != 0 {
+		offset = sdk.BigEndianToUint64(key)
+	}
+
+	end := offset + limit
+
+	var nextKey []byte
+
+	for i := offset; i < uint64(length); i++ {
+		if i == end {
+			nextKey = sdk.Uint64ToBigEndian(i)
+			break
+		}
+
+		if err := onResult(int(i)); err != nil {
+			return nil, err
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal && len(key) == 0 {
+		res.Total = uint64(length)
+	}
+
+	return res, nil
+}
+
 func PaginateAllRepositoryRelease(
 	k Keeper,
 	ctx sdk.Context,
@@ -112,3 +112,35 @@ func (k Keeper) AnyRepository(c context.Context, req *types.QueryGetAnyRepositor
 
 	return &types.QueryGetAnyRepositoryResponse{Repository: &repository}, nil
 }
+
+func (k Keeper) UserStarredRepositoryAll(c context.Context, req *types.QueryAllUserStarredRepositoryRequest) (*types.QueryAllUserStarredRepositoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	address, err := k.ResolveAddress(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	user, found := k.GetUser(ctx, address.Address)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var repositories []*types.Repository
+
+	pageRes, err := PaginateList(len(user.StarredRepos), req.Pagination, func(i int) error {
+		if repository, found := k.GetRepositoryById(ctx, user.StarredRepos[i]); found {
+			repositories = append(repositories, &repository)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllUserStarredRepositoryResponse{Repository: repositories, Pagination: pageRes}, nil
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"encoding/binary"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
 // GetIssueCount get the total number of issue
@@ -47,20 +50,28 @@ func (k Keeper) AppendIssue(
 	appendedValue := k.cdc.MustMarshal(&issue)
 	store.Set(GetIssueIDBytes(issue.Iid), appendedValue)
 
+	k.setIssueIndexes(ctx, issue)
+
 	// Update issue count
 	k.SetIssueCount(ctx, count+1)
 
 	return count
 }
 
-// SetIssue set a specific repository issue in the store
+// SetIssue set a specific repository issue in the store and keeps its indexes in sync
 func (k Keeper) SetIssue(ctx sdk.Context, issue types.Issue) {
+	if old, found := k.GetRepositoryIssue(ctx, issue.RepositoryId, issue.Iid); found {
+		k.removeIssueIndexes(ctx, old)
+	}
+
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
 		types.KeyPrefix(types.GetIssueKeyForRepositoryId(issue.RepositoryId)),
 	)
 	b := k.cdc.MustMarshal(&issue)
 	store.Set(GetIssueIDBytes(issue.Iid), b)
+
+	k.setIssueIndexes(ctx, issue)
 }
 
 // GetRepositoryIssue returns a repository issue from its id
@@ -79,6 +90,10 @@ func (k Keeper) GetRepositoryIssue(ctx sdk.Context, repositoryId uint64, issueIi
 
 // RemoveRepositoryIssue removes a repository issue from the store
 func (k Keeper) RemoveRepositoryIssue(ctx sdk.Context, repositoryId uint64, issueIid uint64) {
+	if issue, found := k.GetRepositoryIssue(ctx, repositoryId, issueIid); found {
+		k.removeIssueIndexes(ctx, issue)
+	}
+
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
 		types.KeyPrefix(types.GetIssueKeyForRepositoryId(repositoryId)),
@@ -124,6 +139,150 @@ func (k Keeper) GetAllIssue(ctx sdk.Context) (list []types.Issue) {
 	return
 }
 
+// hasOtherOpenPullRequest checks whether any pull request linked to the
+// issue, other than pullRequestIid, is still open
+func (k Keeper) hasOtherOpenPullRequest(ctx sdk.Context, repositoryId uint64, issue types.Issue, pullRequestIid uint64) bool {
+	for _, p := range issue.PullRequests {
+		if p.Iid == pullRequestIid {
+			continue
+		}
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, p.Iid)
+		if found && pullRequest.State == types.PullRequest_OPEN {
+			return true
+		}
+	}
+	return false
+}
+
+// getAssigneeMergedPullRequest returns a merged pull request linked to the
+// issue whose creator is an assignee of the issue
+func (k Keeper) getAssigneeMergedPullRequest(ctx sdk.Context, repositoryId uint64, issue types.Issue) (types.PullRequest, bool) {
+	for _, p := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, p.Iid)
+		if !found || pullRequest.State != types.PullRequest_MERGED {
+			continue
+		}
+		if _, exists := utils.AssigneeExists(issue.Assignees, pullRequest.Creator); exists {
+			return pullRequest, true
+		}
+	}
+	return types.PullRequest{}, false
+}
+
+// closeMergedIssue closes an issue resolved by a merged pull request and
+// rewards its bounties to the issue assignees
+func (k Keeper) closeMergedIssue(ctx sdk.Context, issue types.Issue, closedBy string, pullRequestCreator string) {
+	blockTime := ctx.BlockTime().Unix()
+
+	issue.State = types.Issue_CLOSED
+	issue.ClosedBy = closedBy
+	issue.ClosedAt = blockTime
+	issue.CloseReason = types.CloseReasonMerged
+	issue.UpdatedAt = blockTime
+	k.SetIssue(ctx, issue)
+
+	for _, bountyId := range issue.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State != types.BountyStateSRCDEBITTED {
+			continue
+		}
+
+		recipients, rewards := GetBountyRewards(GetBountyRemainingAmount(bounty), issue.Assignees, issue.BountySplit, pullRequestCreator)
+		if err := k.RewardBounty(ctx, bounty, recipients, rewards); err != nil {
+			continue
+		}
+	}
+}
+
+// closeIssue closes the issue for the given reason, which stays unspecified
+// when none is given, and refunds its unclaimed bounties. An issue with an open linked pull request
+// can't be closed.
+func (k Keeper) closeIssue(ctx sdk.Context, issue *types.Issue, closedBy string, reason types.CloseReason) error {
+	for _, pullRequestIid := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, issue.RepositoryId, pullRequestIid.Iid)
+		if !found {
+			continue
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close issue with OPEN linked pull request")
+		}
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	issue.State = types.Issue_CLOSED
+	issue.ClosedBy = closedBy
+	issue.ClosedAt = blockTime
+	issue.CloseReason = reason
+
+	for _, bountyId := range issue.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State != types.BountyStateSRCDEBITTED {
+			continue
+		}
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			continue
+		}
+
+		bounty.State = types.BountyStateREVERTEDBACK
+		bounty.ExpireAt = time.Time{}.Unix()
+		bounty.UpdatedAt = blockTime
+
+		k.SetBounty(ctx, bounty)
+	}
+
+	return nil
+}
+
+// appendIssueSystemComment adds a system comment to the issue
+func (k Keeper) appendIssueSystemComment(ctx sdk.Context, issue *types.Issue, body string, commentType types.CommentType) {
+	blockTime := ctx.BlockTime().Unix()
+
+	issue.CommentsCount += 1
+	issue.UpdatedAt = blockTime
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: issue.RepositoryId,
+		ParentIid:    issue.Iid,
+		Parent:       types.CommentParentIssue,
+		CommentIid:   issue.CommentsCount,
+		Body:         body,
+		System:       true,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+		CommentType:  commentType,
+	})
+}
+
+// setIssueIndexes indexes the milestone of an issue
+func (k Keeper) setIssueIndexes(ctx sdk.Context, issue types.Issue) {
+	if issue.Milestone != 0 {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetMilestoneIssueKey(issue.RepositoryId, issue.Milestone)),
+		)
+		store.Set(GetIssueIDBytes(issue.Iid), []byte{})
+	}
+}
+
+// removeIssueIndexes removes the index entries of an issue
+func (k Keeper) removeIssueIndexes(ctx sdk.Context, issue types.Issue) {
+	if issue.Milestone != 0 {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetMilestoneIssueKey(issue.RepositoryId, issue.Milestone)),
+		)
+		store.Delete(GetIssueIDBytes(issue.Iid))
+	}
+}
+
 // GetIssueIDBytes returns the byte representation of the ID
 func GetIssueIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
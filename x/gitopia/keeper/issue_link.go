@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+const maxIssueLinks = 50
+
+// InverseIssueLinkType returns the link type stored on the other end of a link
+func InverseIssueLinkType(linkType types.IssueLinkType) types.IssueLinkType {
+	switch linkType {
+	case types.IssueLinkTypeBlocks:
+		return types.IssueLinkTypeBlockedBy
+	case types.IssueLinkTypeBlockedBy:
+		return types.IssueLinkTypeBlocks
+	case types.IssueLinkTypeDuplicateOf:
+		return types.IssueLinkTypeDuplicatedBy
+	case types.IssueLinkTypeDuplicatedBy:
+		return types.IssueLinkTypeDuplicateOf
+	}
+	return linkType
+}
+
+// addIssueLink links issue to target and target back to issue
+func (k Keeper) addIssueLink(ctx sdk.Context, creator string, issue *types.Issue, target *types.Issue, linkType types.IssueLinkType) error {
+	if _, exists := utils.IssueLinkExists(issue.Links, target.RepositoryId, target.Iid); exists {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%v) already linked", target.Iid))
+	}
+
+	if len(issue.Links)+1 > maxIssueLinks || len(target.Links)+1 > maxIssueLinks {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue can't have more than %v linked issues", maxIssueLinks))
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	issue.Links = append(issue.Links, types.IssueLink{
+		LinkType:     linkType,
+		RepositoryId: target.RepositoryId,
+		Iid:          target.Iid,
+		Creator:      creator,
+		CreatedAt:    blockTime,
+	})
+	target.Links = append(target.Links, types.IssueLink{
+		LinkType:     InverseIssueLinkType(linkType),
+		RepositoryId: issue.RepositoryId,
+		Iid:          issue.Iid,
+		Creator:      creator,
+		CreatedAt:    blockTime,
+	})
+
+	return nil
+}
+
+// removeIssueLink removes the link between issue and target from both issues
+func removeIssueLink(issue *types.Issue, target *types.Issue) bool {
+	i, exists := utils.IssueLinkExists(issue.Links, target.RepositoryId, target.Iid)
+	if !exists {
+		return false
+	}
+	issue.Links = append(issue.Links[:i], issue.Links[i+1:]...)
+
+	if i, exists := utils.IssueLinkExists(target.Links, issue.RepositoryId, issue.Iid); exists {
+		target.Links = append(target.Links[:i], target.Links[i+1:]...)
+	}
+
+	return true
+}
+
+// removeAllIssueLinks removes the links pointing to a deleted issue
+func (k Keeper) removeAllIssueLinks(ctx sdk.Context, issue types.Issue) {
+	for _, link := range issue.Links {
+		target, found := k.GetRepositoryIssue(ctx, link.RepositoryId, link.Iid)
+		if !found {
+			continue
+		}
+		if i, exists := utils.IssueLinkExists(target.Links, issue.RepositoryId, issue.Iid); exists {
+			target.Links = append(target.Links[:i], target.Links[i+1:]...)
+			k.SetIssue(ctx, target)
+		}
+	}
+}
+
+// issueReference returns how the issue is referred to from repositoryId, which
+// is #iid within the same repository and owner/repository#iid across repositories
+func (k Keeper) issueReference(ctx sdk.Context, repositoryId uint64, issue types.Issue) string {
+	if issue.RepositoryId == repositoryId {
+		return fmt.Sprintf("#%v", issue.Iid)
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found || repository.Owner == nil {
+		return fmt.Sprintf("#%v", issue.Iid)
+	}
+
+	return fmt.Sprintf("%v/%v#%v", repository.Owner.Id, repository.Name, issue.Iid)
+}
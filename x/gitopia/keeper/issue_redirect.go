@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+const maxIssueRedirectHops = 20
+
+// SetIssueRedirect set a specific issue redirect in the store and indexes it by target repository
+func (k Keeper) SetIssueRedirect(ctx sdk.Context, redirect types.IssueRedirect) {
+	if old, found := k.GetIssueRedirect(ctx, redirect.RepositoryId, redirect.Iid); found {
+		k.removeIssueRedirectTarget(ctx, old)
+	}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectKeyForRepositoryId(redirect.RepositoryId)),
+	)
+	b := k.cdc.MustMarshal(&redirect)
+	store.Set(GetIssueIDBytes(redirect.Iid), b)
+
+	targetStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectTargetKey(redirect.TargetRepositoryId)),
+	)
+	targetStore.Set(getIssueRedirectIndexKey(redirect.RepositoryId, redirect.Iid), []byte{})
+}
+
+// RemoveIssueRedirect removes an issue redirect from the store
+func (k Keeper) RemoveIssueRedirect(ctx sdk.Context, repositoryId uint64, iid uint64) {
+	redirect, found := k.GetIssueRedirect(ctx, repositoryId, iid)
+	if !found {
+		return
+	}
+	k.removeIssueRedirectTarget(ctx, redirect)
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectKeyForRepositoryId(repositoryId)),
+	)
+	store.Delete(GetIssueIDBytes(iid))
+}
+
+// removeIssueRedirectTarget removes the target repository index entry of a redirect
+func (k Keeper) removeIssueRedirectTarget(ctx sdk.Context, redirect types.IssueRedirect) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectTargetKey(redirect.TargetRepositoryId)),
+	)
+	store.Delete(getIssueRedirectIndexKey(redirect.RepositoryId, redirect.Iid))
+}
+
+// GetIssueRedirect returns the redirect of a transferred issue
+func (k Keeper) GetIssueRedirect(ctx sdk.Context, repositoryId uint64, iid uint64) (val types.IssueRedirect, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectKeyForRepositoryId(repositoryId)),
+	)
+	b := store.Get(GetIssueIDBytes(iid))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllIssueRedirect returns all issue redirects
+func (k Keeper) GetAllIssueRedirect(ctx sdk.Context) (list []types.IssueRedirect) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.IssueRedirectKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IssueRedirect
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllRepositoryIssueRedirect returns the redirects of the issues transferred out of the repository
+func (k Keeper) GetAllRepositoryIssueRedirect(ctx sdk.Context, repositoryId uint64) (list []types.IssueRedirect) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectKeyForRepositoryId(repositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.IssueRedirect
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetTargetRepositoryIssueRedirects returns the redirects of the issues transferred into the repository
+func (k Keeper) GetTargetRepositoryIssueRedirects(ctx sdk.Context, targetRepositoryId uint64) (list []types.IssueRedirect) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetIssueRedirectTargetKey(targetRepositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		repositoryId, iid := GetIssueIDFromBytes(iterator.Key()[:8]), GetIssueIDFromBytes(iterator.Key()[8:])
+		if redirect, found := k.GetIssueRedirect(ctx, repositoryId, iid); found {
+			list = append(list, redirect)
+		}
+	}
+
+	return
+}
+
+// RemoveRepositoryIssueRedirects removes the redirects from and to a deleted
+// repository. Redirects to an issue which has been transferred again skip the
+// repository instead.
+func (k Keeper) RemoveRepositoryIssueRedirects(ctx sdk.Context, repositoryId uint64) {
+	for _, redirect := range k.GetTargetRepositoryIssueRedirects(ctx, repositoryId) {
+		next, found := k.GetIssueRedirect(ctx, repositoryId, redirect.TargetIid)
+		if !found {
+			k.RemoveIssueRedirect(ctx, redirect.RepositoryId, redirect.Iid)
+			continue
+		}
+		redirect.TargetRepositoryId = next.TargetRepositoryId
+		redirect.TargetIid = next.TargetIid
+		k.SetIssueRedirect(ctx, redirect)
+	}
+
+	for _, redirect := range k.GetAllRepositoryIssueRedirect(ctx, repositoryId) {
+		k.RemoveIssueRedirect(ctx, redirect.RepositoryId, redirect.Iid)
+	}
+}
+
+// ResolveIssueRedirect follows the redirects of an issue which has been
+// transferred, possibly more than once, to its current location
+func (k Keeper) ResolveIssueRedirect(ctx sdk.Context, repositoryId uint64, iid uint64) (val types.IssueRedirect, found bool) {
+	val, found = k.GetIssueRedirect(ctx, repositoryId, iid)
+	if !found {
+		return val, false
+	}
+
+	// a transferred issue always gets a new iid, so the chain can't loop unless
+	// the store has been tampered with
+	for i := 0; i < maxIssueRedirectHops; i++ {
+		next, found := k.GetIssueRedirect(ctx, val.TargetRepositoryId, val.TargetIid)
+		if !found {
+			break
+		}
+		val.TargetRepositoryId = next.TargetRepositoryId
+		val.TargetIid = next.TargetIid
+	}
+
+	return val, true
+}
+
+// getIssueRedirectIndexKey returns the index key of a redirect from its repository-id and iid
+func getIssueRedirectIndexKey(repositoryId uint64, iid uint64) []byte {
+	return append(GetIssueIDBytes(repositoryId), GetIssueIDBytes(iid)...)
+}
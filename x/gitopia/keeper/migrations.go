@@ -19,3 +19,24 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	// re-setting the bounties populates the bounty expiry queue
+	for _, bounty := range m.keeper.GetAllBounty(ctx) {
+		m.keeper.SetBounty(ctx, bounty)
+	}
+	// re-setting the issues populates the issue indexes
+	for _, issue := range m.keeper.GetAllIssue(ctx) {
+		m.keeper.SetIssue(ctx, issue)
+	}
+	// re-setting the pull requests populates the pull request indexes
+	for _, pullRequest := range m.keeper.GetAllPullRequest(ctx) {
+		m.keeper.SetPullRequest(ctx, pullRequest)
+	}
+	// re-setting the users populates the user id index
+	for _, user := range m.keeper.GetAllUser(ctx) {
+		m.keeper.SetUser(ctx, user)
+	}
+	return nil
+}
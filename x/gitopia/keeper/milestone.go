@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// SetMilestone set a specific milestone in the store
+func (k Keeper) SetMilestone(ctx sdk.Context, milestone types.Milestone) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestoneKeyForRepositoryId(milestone.RepositoryId)),
+	)
+	b := k.cdc.MustMarshal(&milestone)
+	store.Set(GetMilestoneIDBytes(milestone.Iid), b)
+}
+
+// GetRepositoryMilestone returns a milestone from its iid
+func (k Keeper) GetRepositoryMilestone(ctx sdk.Context, repositoryId uint64, iid uint64) (val types.Milestone, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestoneKeyForRepositoryId(repositoryId)),
+	)
+	b := store.Get(GetMilestoneIDBytes(iid))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRepositoryMilestone removes a milestone from the store
+func (k Keeper) RemoveRepositoryMilestone(ctx sdk.Context, repositoryId uint64, iid uint64) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestoneKeyForRepositoryId(repositoryId)),
+	)
+	store.Delete(GetMilestoneIDBytes(iid))
+}
+
+// GetAllRepositoryMilestone returns all milestones of a repository
+func (k Keeper) GetAllRepositoryMilestone(ctx sdk.Context, repositoryId uint64) (list []types.Milestone) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestoneKeyForRepositoryId(repositoryId)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Milestone
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllMilestone returns all milestones
+func (k Keeper) GetAllMilestone(ctx sdk.Context) (list []types.Milestone) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.MilestoneKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Milestone
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetMilestoneIssues returns the issues of the repository milestone
+func (k Keeper) GetMilestoneIssues(ctx sdk.Context, repositoryId uint64, milestoneIid uint64) (list []types.Issue) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestoneIssueKey(repositoryId, milestoneIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if issue, found := k.GetRepositoryIssue(ctx, repositoryId, GetIssueIDFromBytes(iterator.Key())); found {
+			list = append(list, issue)
+		}
+	}
+
+	return
+}
+
+// GetMilestonePullRequests returns the pullRequests of the repository milestone
+func (k Keeper) GetMilestonePullRequests(ctx sdk.Context, repositoryId uint64, milestoneIid uint64) (list []types.PullRequest) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetMilestonePullRequestKey(repositoryId, milestoneIid)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, GetPullRequestIDFromBytes(iterator.Key())); found {
+			list = append(list, pullRequest)
+		}
+	}
+
+	return
+}
+
+// GetMilestoneProgress returns the progress of a repository milestone
+func (k Keeper) GetMilestoneProgress(ctx sdk.Context, repositoryId uint64, milestoneIid uint64) types.MilestoneProgress {
+	progress := types.MilestoneProgress{MilestoneIid: milestoneIid}
+
+	for _, issue := range k.GetMilestoneIssues(ctx, repositoryId, milestoneIid) {
+		if issue.State == types.Issue_OPEN {
+			progress.OpenIssues += 1
+		} else {
+			progress.ClosedIssues += 1
+		}
+	}
+
+	for _, pullRequest := range k.GetMilestonePullRequests(ctx, repositoryId, milestoneIid) {
+		if pullRequest.State == types.PullRequest_OPEN {
+			progress.OpenPullRequests += 1
+		} else {
+			progress.ClosedPullRequests += 1
+		}
+	}
+
+	progress.PercentComplete = MilestonePercentComplete(progress)
+
+	return progress
+}
+
+// MilestonePercentComplete returns the share of closed issues and pullRequests
+func MilestonePercentComplete(progress types.MilestoneProgress) uint64 {
+	closed := progress.ClosedIssues + progress.ClosedPullRequests
+	total := closed + progress.OpenIssues + progress.OpenPullRequests
+	if total == 0 {
+		return 0
+	}
+	return closed * 100 / total
+}
+
+// GetMilestoneIDBytes returns the byte representation of the ID
+func GetMilestoneIDBytes(iid uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, iid)
+	return bz
+}
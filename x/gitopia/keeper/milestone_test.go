@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/testutil/nullify"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNMilestone(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Milestone {
+	items := make([]types.Milestone, n)
+	for i := range items {
+		items[i].RepositoryId = 1
+		items[i].Iid = uint64(i + 1)
+		items[i].Title = fmt.Sprintf("milestone-%d", i)
+		keeper.SetMilestone(ctx, items[i])
+	}
+	return items
+}
+
+func TestMilestoneGet(t *testing.T) {
+	keeper, ctx := keepertest.GitopiaKeeper(t)
+	items := createNMilestone(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetRepositoryMilestone(ctx, item.RepositoryId, item.Iid)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&got),
+		)
+	}
+	require.Len(t, keeper.GetAllRepositoryMilestone(ctx, 1), 10)
+	require.Empty(t, keeper.GetAllRepositoryMilestone(ctx, 2))
+}
+
+func TestMilestoneRemove(t *testing.T) {
+	keeper, ctx := keepertest.GitopiaKeeper(t)
+	items := createNMilestone(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveRepositoryMilestone(ctx, item.RepositoryId, item.Iid)
+		_, found := keeper.GetRepositoryMilestone(ctx, item.RepositoryId, item.Iid)
+		require.False(t, found)
+	}
+	require.Empty(t, keeper.GetAllMilestone(ctx))
+}
+
+func TestMilestoneProgress(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	createNMilestone(k, ctx, 2)
+
+	for i, issue := range []types.Issue{
+		{Milestone: 1, State: types.Issue_OPEN},
+		{Milestone: 1, State: types.Issue_CLOSED},
+		{Milestone: 1, State: types.Issue_CLOSED},
+		{Milestone: 0, State: types.Issue_CLOSED},
+	} {
+		issue.RepositoryId = 1
+		issue.Iid = uint64(i + 1)
+		k.SetIssue(ctx, issue)
+	}
+	k.SetPullRequest(ctx, types.PullRequest{
+		Iid:       1,
+		Base:      &types.PullRequestBase{RepositoryId: 1},
+		Milestone: 1,
+		State:     types.PullRequest_MERGED,
+	})
+
+	require.Equal(t, types.MilestoneProgress{
+		MilestoneIid:       1,
+		OpenIssues:         1,
+		ClosedIssues:       2,
+		ClosedPullRequests: 1,
+		PercentComplete:    75,
+	}, k.GetMilestoneProgress(ctx, 1, 1))
+	require.Equal(t, types.MilestoneProgress{MilestoneIid: 2}, k.GetMilestoneProgress(ctx, 1, 2))
+
+	// Moving an item to another milestone updates both milestones
+	issue, _ := k.GetRepositoryIssue(ctx, 1, 1)
+	issue.Milestone = 2
+	k.SetIssue(ctx, issue)
+	require.Len(t, k.GetMilestoneIssues(ctx, 1, 1), 2)
+	require.Len(t, k.GetMilestoneIssues(ctx, 1, 2), 1)
+
+	k.RemoveRepositoryPullRequest(ctx, 1, 1)
+	require.Empty(t, k.GetMilestonePullRequests(ctx, 1, 1))
+}
+
+func TestMilestoneDelete(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	repository, _ := k.GetAddressRepository(ctx, users[0], repositoryId.Name)
+
+	milestone, err := srv.CreateMilestone(goCtx, &types.MsgCreateMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v1"})
+	require.NoError(t, err)
+	issue, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "title"})
+	require.NoError(t, err)
+	_, err = srv.SetIssueMilestone(goCtx, &types.MsgSetIssueMilestone{Creator: users[0], RepositoryId: repository.Id, Iid: issue.Iid, MilestoneIid: milestone.Iid})
+	require.NoError(t, err)
+	require.Len(t, k.GetMilestoneIssues(ctx, repository.Id, milestone.Iid), 1)
+
+	_, err = srv.DeleteMilestone(goCtx, &types.MsgDeleteMilestone{Creator: users[0], RepositoryId: repositoryId, Iid: milestone.Iid})
+	require.NoError(t, err)
+	require.Empty(t, k.GetMilestoneIssues(ctx, repository.Id, milestone.Iid))
+
+	got, _ := k.GetRepositoryIssue(ctx, repository.Id, issue.Iid)
+	require.Equal(t, uint64(0), got.Milestone)
+	comment, found := k.GetIssueComment(ctx, repository.Id, issue.Iid, got.CommentsCount)
+	require.True(t, found)
+	require.Equal(t, types.CommentTypeMilestoneRemoved, comment.CommentType)
+
+	// Milestones are removed with their repository
+	_, err = srv.CreateMilestone(goCtx, &types.MsgCreateMilestone{Creator: users[0], RepositoryId: repositoryId, Title: "v2"})
+	require.NoError(t, err)
+	_, err = srv.DeleteRepository(goCtx, &types.MsgDeleteRepository{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllRepositoryMilestone(ctx, repository.Id))
+}
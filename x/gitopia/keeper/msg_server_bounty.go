@@ -26,15 +26,30 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "expire time can't be less then current time")
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", msg.RepositoryId))
+	}
+
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch msg.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.ParentIid)
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", msg.ParentIid))
 		}
-		if len(issue.Assignees) > 1 {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue with bounty can't have more then 1 assignee")
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", msg.ParentIid))
+		}
+		if pullRequest.State != types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pull request (%d) isn't open", msg.ParentIid))
 		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
@@ -50,6 +65,13 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 		ExpireAt:     msg.Expiry,
 		CreatedAt:    blockTime,
 		UpdatedAt:    blockTime,
+		Contributions: []types.BountyContribution{
+			{
+				Address: msg.Creator,
+				Amount:  msg.Amount,
+			},
+		},
+		Milestones: msg.Milestones,
 	}
 
 	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
@@ -75,30 +97,14 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 	switch msg.Parent {
 	case types.BountyParentIssue:
 		issue.Bounties = append(issue.Bounties, id)
-		issue.CommentsCount += 1
-		issue.UpdatedAt = blockTime
-
-		var comment = types.Comment{
-			Creator:      "GITOPIA",
-			RepositoryId: msg.RepositoryId,
-			ParentIid:    msg.ParentIid,
-			Parent:       types.CommentParentIssue,
-			CommentIid:   issue.CommentsCount,
-			Body:         utils.CreateBountyCommentBody(msg.Creator, msg.Amount),
-			System:       true,
-			CreatedAt:    blockTime,
-			UpdatedAt:    blockTime,
-			CommentType:  types.CommentTypeAddBounty,
-		}
-
-		k.AppendComment(
-			ctx,
-			comment,
-		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		pullRequest.Bounties = append(pullRequest.Bounties, id)
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
+	k.appendBountyComment(ctx, bounty, utils.CreateBountyCommentBody(msg.Creator, msg.Amount), types.CommentTypeAddBounty)
 
 	bountyAmountJson, _ := json.Marshal(bounty.Amount)
 
@@ -107,7 +113,7 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(bountyAmountJson)),
 			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
@@ -124,6 +130,215 @@ func (k msgServer) CreateBounty(goCtx context.Context, msg *types.MsgCreateBount
 	}, nil
 }
 
+func (k msgServer) FundBounty(goCtx context.Context, msg *types.MsgFundBounty) (*types.MsgFundBountyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	if bounty.ExpireAt <= blockTime {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty expired")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", bounty.RepositoryId))
+	}
+
+	var pullRequest types.PullRequest
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		_, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", bounty.ParentIid))
+		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State != types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pull request (%d) isn't open", bounty.ParentIid))
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	bountyAddress := GetBountyAddress(bounty.Id)
+	err = k.bankKeeper.SendCoins(ctx, creatorAccAddress, bountyAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	AddBountyContribution(&bounty, msg.Creator, msg.Amount)
+	bounty.Amount = bounty.Amount.Add(msg.Amount...)
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	k.appendBountyComment(ctx, bounty, utils.FundBountyCommentBody(msg.Creator, msg.Amount), types.CommentTypeAddBounty)
+
+	fundAmountJson, _ := json.Marshal(msg.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.FundBountyEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(fundAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgFundBountyResponse{}, nil
+}
+
+func (k msgServer) ReleaseBountyMilestone(goCtx context.Context, msg *types.MsgReleaseBountyMilestone) (*types.MsgReleaseBountyMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", bounty.RepositoryId))
+	}
+
+	if msg.Creator != bounty.Creator {
+		if !k.HavePermission(ctx, msg.Creator, repository, types.ReleaseBountyMilestonePermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+		}
+	}
+
+	if msg.Milestone >= uint64(len(bounty.Milestones)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("milestone (%d) doesn't exist", msg.Milestone))
+	}
+	milestone := &bounty.Milestones[msg.Milestone]
+	if milestone.Released {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) already released", msg.Milestone))
+	}
+	if msg.Milestone > 0 && !bounty.Milestones[msg.Milestone-1].Released {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "milestones must be released in order")
+	}
+	if !milestone.Amount.IsAllLTE(GetBountyRemainingAmount(bounty)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "milestone amount exceeds remaining bounty amount")
+	}
+
+	switch bounty.Parent {
+	case types.BountyParentIssue:
+		if _, found := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid); !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist", bounty.ParentIid))
+		}
+	case types.BountyParentPullRequest:
+		if _, found := k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid); !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", bounty.ParentIid))
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, milestone.Amount...); err != nil {
+		return nil, err
+	}
+
+	recipientAccAddress, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if k.bankKeeper.BlockedAddr(recipientAccAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Recipient)
+	}
+	err = k.bankKeeper.SendCoins(ctx, GetBountyAddress(bounty.Id), recipientAccAddress, milestone.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone.Released = true
+	milestone.ReleasedTo = msg.Recipient
+	milestone.ReleasedAt = blockTime
+
+	bounty.Released = bounty.Released.Add(milestone.Amount...)
+	if _, exists := utils.AssigneeExists(bounty.RewardedTo, msg.Recipient); !exists {
+		bounty.RewardedTo = append(bounty.RewardedTo, msg.Recipient)
+	}
+	if GetBountyRemainingAmount(bounty).IsZero() {
+		bounty.State = types.BountyStateDESTCREDITED
+		bounty.ExpireAt = time.Time{}.Unix()
+	}
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+
+	k.appendBountyComment(ctx, bounty, utils.ReleaseBountyMilestoneCommentBody(msg.Creator, milestone.Title, milestone.Amount, msg.Recipient), types.CommentTypeModifiedBounty)
+
+	milestoneAmountJson, _ := json.Marshal(milestone.Amount)
+	releasedJson, _ := json.Marshal(bounty.Released)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ReleaseBountyMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyMilestoneKey, strconv.FormatUint(msg.Milestone, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyAmountKey, string(milestoneAmountJson)),
+			sdk.NewAttribute(types.EventAttributeBountyReleasedKey, string(releasedJson)),
+			sdk.NewAttribute(types.EventAttributeBountyRewardedTo, msg.Recipient),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgReleaseBountyMilestoneResponse{}, nil
+}
+
 func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdateBountyExpiry) (*types.MsgUpdateBountyExpiryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	blockTime := ctx.BlockTime().Unix()
@@ -151,6 +366,7 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 	}
 
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -162,6 +378,16 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contains open PR")
 			}
 		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			if msg.Expiry < bounty.ExpireAt {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "pull request is open")
+			}
+		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
 	}
@@ -171,40 +397,14 @@ func (k msgServer) UpdateBountyExpiry(goCtx context.Context, msg *types.MsgUpdat
 
 	k.SetBounty(ctx, bounty)
 
-	/* can never be default */
-	switch bounty.Parent {
-	case types.BountyParentIssue:
-		issue.CommentsCount += 1
-		issue.UpdatedAt = blockTime
-
-		var comment = types.Comment{
-			Creator:      "GITOPIA",
-			RepositoryId: bounty.RepositoryId,
-			ParentIid:    bounty.ParentIid,
-			Parent:       types.CommentParentIssue,
-			CommentIid:   issue.CommentsCount,
-			Body:         utils.UpdateBountyExpiryCommentBody(msg.Creator),
-			System:       true,
-			CreatedAt:    blockTime,
-			UpdatedAt:    blockTime,
-			CommentType:  types.CommentTypeModifiedBounty,
-		}
-
-		k.AppendComment(
-			ctx,
-			comment,
-		)
-		k.SetIssue(ctx, issue)
-	default:
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
-	}
+	k.appendBountyComment(ctx, bounty, utils.UpdateBountyExpiryCommentBody(msg.Creator), types.CommentTypeModifiedBounty)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateBountyExpiryEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
@@ -238,6 +438,7 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 	}
 
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -247,26 +448,19 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 		if len(issue.PullRequests) > 0 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close bounty; contains open PR")
 		}
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", bounty.ParentIid))
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close bounty; pull request is open")
+		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
 	}
 
-	creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-		return nil, err
-	}
-	if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Creator)
-	}
-
-	bountyAddress := GetBountyAddress(bounty.Id)
-	if err := k.bankKeeper.SendCoins(
-		ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-	); err != nil {
+	if err := k.RefundBounty(ctx, bounty); err != nil {
 		return nil, err
 	}
 
@@ -277,40 +471,14 @@ func (k msgServer) CloseBounty(goCtx context.Context, msg *types.MsgCloseBounty)
 
 	k.SetBounty(ctx, bounty)
 
-	/* can never be default */
-	switch bounty.Parent {
-	case types.BountyParentIssue:
-		issue.CommentsCount += 1
-		issue.UpdatedAt = blockTime
-
-		var comment = types.Comment{
-			Creator:      "GITOPIA",
-			RepositoryId: bounty.RepositoryId,
-			ParentIid:    bounty.ParentIid,
-			Parent:       types.CommentParentIssue,
-			CommentIid:   issue.CommentsCount,
-			Body:         utils.CloseBountyCommentBody(msg.Creator),
-			System:       true,
-			CreatedAt:    blockTime,
-			UpdatedAt:    blockTime,
-			CommentType:  types.CommentTypeClosedBounty,
-		}
-
-		k.AppendComment(
-			ctx,
-			comment,
-		)
-		k.SetIssue(ctx, issue)
-	default:
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
-	}
+	k.appendBountyComment(ctx, bounty, utils.CloseBountyCommentBody(msg.Creator), types.CommentTypeClosedBounty)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.CloseBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
@@ -340,7 +508,12 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if bounty.State == types.BountyStateDISPUTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete bounty; bounty is disputed")
+	}
+
 	var issue types.Issue
+	var pullRequest types.PullRequest
 	switch bounty.Parent {
 	case types.BountyParentIssue:
 		issue, found = k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
@@ -357,69 +530,50 @@ func (k msgServer) DeleteBounty(goCtx context.Context, msg *types.MsgDeleteBount
 		} else {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't find bountyId (%d) under issue (%d)", bounty.Id, bounty.ParentIid))
 		}
-	default:
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
-	}
-
-	if bounty.State == types.BountyStateSRCDEBITTED {
-		creatorAccAddress, err := sdk.AccAddressFromBech32(msg.Creator)
-		if err != nil {
-			return nil, err
+	case types.BountyParentPullRequest:
+		pullRequest, found = k.GetRepositoryPullRequest(ctx, bounty.RepositoryId, bounty.ParentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pull request (%d) doesn't exist", bounty.ParentIid))
 		}
 
-		if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-			return nil, err
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete bounty; pull request is open")
 		}
-		if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.Creator)
+
+		if i, exists := utils.BountyIdExists(pullRequest.Bounties, bounty.Id); exists {
+			pullRequest.Bounties = append(pullRequest.Bounties[:i], pullRequest.Bounties[i+1:]...)
+		} else {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't find bountyId (%d) under pull request (%d)", bounty.Id, bounty.ParentIid))
 		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid bounty parent")
+	}
 
-		bountyAddress := GetBountyAddress(bounty.Id)
-		if err := k.bankKeeper.SendCoins(
-			ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-		); err != nil {
+	if bounty.State == types.BountyStateSRCDEBITTED {
+		if err := k.RefundBounty(ctx, bounty); err != nil {
 			return nil, err
 		}
 	}
 
 	k.RemoveBounty(ctx, msg.Id)
 
-	blockTime := ctx.BlockTime().Unix()
-
 	/* can never be default */
 	switch bounty.Parent {
 	case types.BountyParentIssue:
-		issue.CommentsCount += 1
-		issue.UpdatedAt = blockTime
-
-		var comment = types.Comment{
-			Creator:      "GITOPIA",
-			RepositoryId: bounty.RepositoryId,
-			ParentIid:    bounty.ParentIid,
-			Parent:       types.CommentParentIssue,
-			CommentIid:   issue.CommentsCount,
-			Body:         utils.DeleteBountyCommentBody(msg.Creator),
-			System:       true,
-			CreatedAt:    blockTime,
-			UpdatedAt:    blockTime,
-			CommentType:  types.CommentTypeNone,
-		}
-
-		k.AppendComment(
-			ctx,
-			comment,
-		)
 		k.SetIssue(ctx, issue)
+	case types.BountyParentPullRequest:
+		k.SetPullRequest(ctx, pullRequest)
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "invalid bounty parent")
 	}
+	k.appendBountyComment(ctx, bounty, utils.DeleteBountyCommentBody(msg.Creator), types.CommentTypeNone)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteBountyEventKey),
 			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
 			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
 			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
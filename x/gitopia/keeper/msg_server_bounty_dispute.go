@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) OpenBountyDispute(goCtx context.Context, msg *types.MsgOpenBountyDispute) (*types.MsgOpenBountyDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateSRCDEBITTED {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty already closed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", bounty.RepositoryId))
+	}
+
+	if repository.Owner.Type != types.OwnerType_DAO {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty disputes are only supported in repositories owned by a dao")
+	}
+
+	if msg.Creator != bounty.Creator && msg.Creator != msg.Contributor {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if msg.Contributor == bounty.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty creator can't be the disputed contributor")
+	}
+
+	if !k.IsBountyClaimant(ctx, bounty, msg.Contributor) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("contributor (%v) isn't working on the bounty", msg.Contributor))
+	}
+
+	bounty.State = types.BountyStateDISPUTED
+	bounty.Dispute = &types.BountyDispute{
+		OpenedBy:    msg.Creator,
+		Contributor: msg.Contributor,
+		Reason:      msg.Reason,
+		OpenedAt:    blockTime,
+	}
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+	k.appendBountyComment(ctx, bounty, utils.OpenBountyDisputeCommentBody(msg.Creator, msg.Contributor), types.CommentTypeBountyDispute)
+
+	disputeJson, _ := json.Marshal(bounty.Dispute)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.OpenBountyDisputeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyDisputeKey, string(disputeJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgOpenBountyDisputeResponse{}, nil
+}
+
+func (k msgServer) VoteBountyDispute(goCtx context.Context, msg *types.MsgVoteBountyDispute) (*types.MsgVoteBountyDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime().Unix()
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateDISPUTED || bounty.Dispute == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty isn't disputed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", bounty.RepositoryId))
+	}
+
+	if repository.Owner.Type != types.OwnerType_DAO {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty disputes are only supported in repositories owned by a dao")
+	}
+
+	member, found := k.GetDaoMember(ctx, repository.Owner.Id, msg.Creator)
+	if !found || member.Role != types.MemberRole_OWNER {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	vote := types.BountyDisputeVote{
+		Voter:            msg.Creator,
+		Resolution:       msg.Resolution,
+		PayoutPercentage: msg.PayoutPercentage,
+		VotedAt:          blockTime,
+	}
+	voted := false
+	for i := range bounty.Dispute.Votes {
+		if bounty.Dispute.Votes[i].Voter == msg.Creator {
+			bounty.Dispute.Votes[i] = vote
+			voted = true
+			break
+		}
+	}
+	if !voted {
+		bounty.Dispute.Votes = append(bounty.Dispute.Votes, vote)
+	}
+	bounty.UpdatedAt = blockTime
+
+	k.SetBounty(ctx, bounty)
+	k.appendBountyComment(ctx, bounty, utils.VoteBountyDisputeCommentBody(msg.Creator, msg.Resolution, msg.PayoutPercentage), types.CommentTypeBountyDispute)
+
+	// the dispute is resolved once a majority of the dao owners agree
+	owners := k.GetAllDaoOwner(ctx, repository.Owner.Id)
+	agreed := 0
+	for _, owner := range owners {
+		for _, v := range bounty.Dispute.Votes {
+			if v.Voter == owner.Address && v.Resolution == msg.Resolution && v.PayoutPercentage == msg.PayoutPercentage {
+				agreed += 1
+			}
+		}
+	}
+
+	resolved := agreed*2 > len(owners)
+	if resolved {
+		if err := k.SettleBountyDispute(ctx, bounty, msg.Resolution, msg.PayoutPercentage, repository.Owner.Id); err != nil {
+			return nil, err
+		}
+		k.appendBountyComment(ctx, bounty, utils.ResolveBountyDisputeCommentBody(msg.Resolution, msg.PayoutPercentage), types.CommentTypeBountyDispute)
+		bounty, _ = k.GetBounty(ctx, bounty.Id)
+	}
+
+	disputeJson, _ := json.Marshal(bounty.Dispute)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.VoteBountyDisputeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyDisputeKey, string(disputeJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+
+	if resolved {
+		emitResolveBountyDisputeEvent(ctx, bounty)
+	}
+
+	return &types.MsgVoteBountyDisputeResponse{}, nil
+}
+
+// ResolveBountyDispute lets a party of the dispute concede it without waiting
+// for the dao owner votes: the bounty creator can pay the contributor out and
+// the contributor can hand the escrow back to the funders.
+func (k msgServer) ResolveBountyDispute(goCtx context.Context, msg *types.MsgResolveBountyDispute) (*types.MsgResolveBountyDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bounty, found := k.GetBounty(ctx, msg.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("bounty with key %d doesn't exist", msg.Id))
+	}
+
+	if bounty.State != types.BountyStateDISPUTED || bounty.Dispute == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty isn't disputed")
+	}
+
+	repository, found := k.GetRepositoryById(ctx, bounty.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", bounty.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", bounty.RepositoryId))
+	}
+
+	if repository.Owner.Type != types.OwnerType_DAO {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty disputes are only supported in repositories owned by a dao")
+	}
+
+	switch msg.Creator {
+	case bounty.Creator:
+		if msg.Resolution != types.BountyDisputeResolutionPayout {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bounty creator can only resolve the dispute with a payout")
+		}
+	case bounty.Dispute.Contributor:
+		if msg.Resolution != types.BountyDisputeResolutionRefund {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "contributor can only resolve the dispute with a refund")
+		}
+	default:
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if err := k.SettleBountyDispute(ctx, bounty, msg.Resolution, msg.PayoutPercentage, msg.Creator); err != nil {
+		return nil, err
+	}
+	k.appendBountyComment(ctx, bounty, utils.ResolveBountyDisputeCommentBody(msg.Resolution, msg.PayoutPercentage), types.CommentTypeBountyDispute)
+
+	bounty, _ = k.GetBounty(ctx, bounty.Id)
+	emitResolveBountyDisputeEvent(ctx, bounty)
+
+	return &types.MsgResolveBountyDisputeResponse{}, nil
+}
+
+func emitResolveBountyDisputeEvent(ctx sdk.Context, bounty types.Bounty) {
+	disputeJson, _ := json.Marshal(bounty.Dispute)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ResolveBountyDisputeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, bounty.Dispute.ResolvedBy),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(bounty.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyIdKey, strconv.FormatUint(bounty.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyStateKey, bounty.State.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentKey, bounty.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeBountyParentIidKey, strconv.FormatUint(bounty.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountyDisputeKey, string(disputeJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(bounty.UpdatedAt, 10)),
+		),
+	)
+}
@@ -6,10 +6,17 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gitopia/gitopia/app"
 	"github.com/gitopia/gitopia/app/params"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/testutil/simapp"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func (suite *KeeperTestSuite) TestBountyMsgServerCreate() {
@@ -134,6 +141,449 @@ func TestBountyMsgServerDelete(t *testing.T) {
 	}
 }
 
+func TestBountyMsgServerFund(t *testing.T) {
+	a, ctx, srv, users, repositoryId := setupPreBountyApp(t)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+	repository, _ := k.GetAddressRepository(ctx, users[0], repositoryId.Name)
+	expiry := ctx.BlockTime().Unix() + 10
+
+	for i := 0; i < 2; i++ {
+		_, err := srv.CreateBounty(goCtx, &types.MsgCreateBounty{Creator: users[0], Amount: bountyCoins(100), Expiry: expiry, RepositoryId: repository.Id, ParentIid: 1, Parent: types.BountyParentIssue})
+		require.NoError(t, err)
+	}
+	archivedBountyId := setupPreBountyArchivedRepository(t, a, ctx, srv, users[0])
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgFundBounty
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgFundBounty{Creator: "X", Id: 0, Amount: bountyCoins(200)},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Bounty Not Exists",
+			request: &types.MsgFundBounty{Creator: users[1], Id: 10, Amount: bountyCoins(200)},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgFundBounty{Creator: users[1], Id: archivedBountyId, Amount: bountyCoins(200)},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgFundBounty{Creator: users[1], Id: 0, Amount: bountyCoins(200)},
+		},
+		{
+			desc:    "Completed Other Contributor",
+			request: &types.MsgFundBounty{Creator: users[2], Id: 0, Amount: bountyCoins(100)},
+		},
+		{
+			desc:    "Completed Other Bounty",
+			request: &types.MsgFundBounty{Creator: users[1], Id: 1, Amount: bountyCoins(50)},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.FundBounty(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, bountyCoins(400), bounty.Amount)
+	require.Equal(t, []types.BountyContribution{
+		{Address: users[0], Amount: bountyCoins(100)},
+		{Address: users[1], Amount: bountyCoins(200)},
+		{Address: users[2], Amount: bountyCoins(100)},
+	}, bounty.Contributions)
+	require.Equal(t, int64(400), getBalance(a, ctx, keeper.GetBountyAddress(0).String()))
+
+	// closing refunds every contributor
+	_, err := srv.CloseBounty(goCtx, &types.MsgCloseBounty{Creator: users[0], Id: 0})
+	require.NoError(t, err)
+	bounty, _ = k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	require.Equal(t, int64(800), getBalance(a, ctx, users[0]))
+	require.Equal(t, int64(950), getBalance(a, ctx, users[1]))
+	require.Equal(t, int64(1000), getBalance(a, ctx, users[2]))
+
+	// so does expiry, which also refunds the bounty of the archived repository
+	ctx = ctx.WithBlockTime(time.Unix(expiry, 0))
+	k.ExpireBounties(ctx)
+	bounty, _ = k.GetBounty(ctx, 1)
+	require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	require.Equal(t, int64(1000), getBalance(a, ctx, users[0]))
+	require.Equal(t, int64(1000), getBalance(a, ctx, users[1]))
+	require.Equal(t, int64(0), getBalance(a, ctx, keeper.GetBountyAddress(1).String()))
+}
+
+func TestBountyMsgServerReleaseMilestone(t *testing.T) {
+	a, ctx, srv, users, repositoryId := setupPreBountyApp(t)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+	repository, _ := k.GetAddressRepository(ctx, users[0], repositoryId.Name)
+
+	_, err := srv.CreateBounty(goCtx, &types.MsgCreateBounty{
+		Creator:      users[0],
+		Amount:       bountyCoins(300),
+		Expiry:       ctx.BlockTime().Unix() + 10,
+		RepositoryId: repository.Id,
+		ParentIid:    1,
+		Parent:       types.BountyParentIssue,
+		Milestones: []types.BountyMilestone{
+			{Title: "design", Amount: bountyCoins(100)},
+			{Title: "implementation", Amount: bountyCoins(100)},
+		},
+	})
+	require.NoError(t, err)
+	_, err = srv.FundBounty(goCtx, &types.MsgFundBounty{Creator: users[1], Id: 0, Amount: bountyCoins(101)})
+	require.NoError(t, err)
+	archivedBountyId := setupPreBountyArchivedRepository(t, a, ctx, srv, users[0])
+
+	_, err = srv.UpdateRepositoryCollaborator(goCtx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "MAINTAIN"})
+	require.NoError(t, err)
+	_, err = srv.UpdateRepositoryCollaborator(goCtx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[3], Role: "WRITE"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgReleaseBountyMilestone
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgReleaseBountyMilestone{Creator: "X", Id: 0, Milestone: 0, Recipient: users[2]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Bounty Not Exists",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: 10, Milestone: 0, Recipient: users[2]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[3], Id: 0, Milestone: 0, Recipient: users[2]},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: archivedBountyId, Milestone: 0, Recipient: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Milestone Not Exists",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: 0, Milestone: 2, Recipient: users[2]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Out Of Order",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: 0, Milestone: 1, Recipient: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: 0, Milestone: 0, Recipient: users[2]},
+		},
+		{
+			desc:    "Already Released",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[0], Id: 0, Milestone: 0, Recipient: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed By Maintainer",
+			request: &types.MsgReleaseBountyMilestone{Creator: users[1], Id: 0, Milestone: 1, Recipient: users[2]},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ReleaseBountyMilestone(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateSRCDEBITTED, bounty.State)
+	require.Equal(t, bountyCoins(200), bounty.Released)
+	require.Equal(t, bountyCoins(201), keeper.GetBountyRemainingAmount(bounty))
+	require.Equal(t, []string{users[2]}, bounty.RewardedTo)
+	for _, milestone := range bounty.Milestones {
+		require.True(t, milestone.Released)
+		require.Equal(t, users[2], milestone.ReleasedTo)
+	}
+	require.Equal(t, int64(1200), getBalance(a, ctx, users[2]))
+
+	// only the unreleased remainder is refunded, pro-rata with the rounding
+	// remainder going to the creator
+	_, err = srv.CloseBounty(goCtx, &types.MsgCloseBounty{Creator: users[0], Id: 0})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000-300-100+151), getBalance(a, ctx, users[0]))
+	require.Equal(t, int64(1000-101+50), getBalance(a, ctx, users[1]))
+	require.Equal(t, int64(0), getBalance(a, ctx, keeper.GetBountyAddress(0).String()))
+}
+
+func TestBountyMsgServerOpenDispute(t *testing.T) {
+	a, ctx, srv, users, _, archivedBountyId := setupPreBountyDispute(t, 1)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// bounty in the repository of a user
+	repository, _ := k.GetAddressRepository(ctx, users[0], "repository")
+	res, err := srv.CreateBounty(goCtx, &types.MsgCreateBounty{Creator: users[1], Amount: bountyCoins(100), Expiry: ctx.BlockTime().Unix() + 10, RepositoryId: repository.Id, ParentIid: 1, Parent: types.BountyParentIssue})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgOpenBountyDispute
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgOpenBountyDispute{Creator: "X", Id: 0, Contributor: users[2]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Bounty Not Exists",
+			request: &types.MsgOpenBountyDispute{Creator: users[2], Id: 10, Contributor: users[2]},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Owned By Dao",
+			request: &types.MsgOpenBountyDispute{Creator: users[2], Id: res.Id, Contributor: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgOpenBountyDispute{Creator: users[1], Id: archivedBountyId, Contributor: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgOpenBountyDispute{Creator: users[3], Id: 0, Contributor: users[2]},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Contributor Is Bounty Creator",
+			request: &types.MsgOpenBountyDispute{Creator: users[1], Id: 0, Contributor: users[1]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Contributor Not Working On Bounty",
+			request: &types.MsgOpenBountyDispute{Creator: users[1], Id: 0, Contributor: users[3]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgOpenBountyDispute{Creator: users[2], Id: 0, Contributor: users[2], Reason: "reason"},
+		},
+		{
+			desc:    "Already Disputed",
+			request: &types.MsgOpenBountyDispute{Creator: users[1], Id: 0, Contributor: users[2]},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.OpenBountyDispute(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateDISPUTED, bounty.State)
+	require.Equal(t, users[2], bounty.Dispute.OpenedBy)
+	require.Equal(t, users[2], bounty.Dispute.Contributor)
+	require.Equal(t, "reason", bounty.Dispute.Reason)
+
+	// the escrow is frozen
+	_, err = srv.CloseBounty(goCtx, &types.MsgCloseBounty{Creator: users[1], Id: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, int64(100), getBalance(a, ctx, keeper.GetBountyAddress(0).String()))
+}
+
+func TestBountyMsgServerVoteDispute(t *testing.T) {
+	a, ctx, srv, users, dao, archivedBountyId := setupPreBountyDispute(t, 4)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for id := uint64(0); id < 3; id++ {
+		_, err := srv.OpenBountyDispute(goCtx, &types.MsgOpenBountyDispute{Creator: users[2], Id: id, Contributor: users[2]})
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgVoteBountyDispute
+		err     error
+	}{
+		{
+			desc:    "Bounty Not Exists",
+			request: &types.MsgVoteBountyDispute{Creator: users[0], Id: 10, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Bounty Not Disputed",
+			request: &types.MsgVoteBountyDispute{Creator: users[0], Id: 3, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgVoteBountyDispute{Creator: users[0], Id: archivedBountyId, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgVoteBountyDispute{Creator: users[1], Id: 0, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgVoteBountyDispute{Creator: users[0], Id: 0, Resolution: types.BountyDisputeResolutionPayout},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.VoteBountyDispute(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// a single vote of the two dao owners isn't a majority
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateDISPUTED, bounty.State)
+	require.Len(t, bounty.Dispute.Votes, 1)
+
+	vote := func(voter string, id uint64, resolution types.BountyDisputeResolution, payoutPercentage uint64) {
+		_, err := srv.VoteBountyDispute(goCtx, &types.MsgVoteBountyDispute{Creator: voter, Id: id, Resolution: resolution, PayoutPercentage: payoutPercentage})
+		require.NoError(t, err)
+	}
+
+	vote(users[3], 0, types.BountyDisputeResolutionPayout, 0)
+	bounty, _ = k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	require.Equal(t, types.BountyDisputeResolutionPayout, bounty.Dispute.Resolution)
+	require.Equal(t, dao, bounty.Dispute.ResolvedBy)
+	require.Equal(t, []string{users[2]}, bounty.RewardedTo)
+
+	vote(users[0], 1, types.BountyDisputeResolutionRefund, 0)
+	vote(users[3], 1, types.BountyDisputeResolutionRefund, 0)
+	bounty, _ = k.GetBounty(ctx, 1)
+	require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+
+	// votes only count towards the same split, and a vote can be changed
+	vote(users[0], 2, types.BountyDisputeResolutionSplit, 30)
+	vote(users[3], 2, types.BountyDisputeResolutionSplit, 40)
+	bounty, _ = k.GetBounty(ctx, 2)
+	require.Equal(t, types.BountyStateDISPUTED, bounty.State)
+	vote(users[3], 2, types.BountyDisputeResolutionSplit, 30)
+	bounty, _ = k.GetBounty(ctx, 2)
+	require.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	require.Equal(t, uint64(30), bounty.Dispute.PayoutPercentage)
+	require.Equal(t, bountyCoins(30), bounty.Released)
+
+	issue, _ := k.GetRepositoryIssue(ctx, bounty.RepositoryId, bounty.ParentIid)
+	comment, _ := k.GetIssueComment(ctx, bounty.RepositoryId, bounty.ParentIid, issue.CommentsCount)
+	require.Equal(t, utils.ResolveBountyDisputeCommentBody(types.BountyDisputeResolutionSplit, 30), comment.Body)
+	require.Equal(t, types.CommentTypeBountyDispute, comment.CommentType)
+
+	require.Equal(t, int64(1000-500+100+70), getBalance(a, ctx, users[1]))
+	require.Equal(t, int64(1000+100+30), getBalance(a, ctx, users[2]))
+	for id := uint64(0); id < 3; id++ {
+		require.Equal(t, int64(0), getBalance(a, ctx, keeper.GetBountyAddress(id).String()))
+	}
+}
+
+func TestBountyMsgServerResolveDispute(t *testing.T) {
+	a, ctx, srv, users, _, archivedBountyId := setupPreBountyDispute(t, 3)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for id := uint64(0); id < 2; id++ {
+		_, err := srv.OpenBountyDispute(goCtx, &types.MsgOpenBountyDispute{Creator: users[1], Id: id, Contributor: users[2]})
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgResolveBountyDispute
+		err     error
+	}{
+		{
+			desc:    "Bounty Not Exists",
+			request: &types.MsgResolveBountyDispute{Creator: users[1], Id: 10, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Bounty Not Disputed",
+			request: &types.MsgResolveBountyDispute{Creator: users[1], Id: 2, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgResolveBountyDispute{Creator: users[1], Id: archivedBountyId, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgResolveBountyDispute{Creator: users[0], Id: 0, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Bounty Creator Refund",
+			request: &types.MsgResolveBountyDispute{Creator: users[1], Id: 0, Resolution: types.BountyDisputeResolutionRefund},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Contributor Payout",
+			request: &types.MsgResolveBountyDispute{Creator: users[2], Id: 0, Resolution: types.BountyDisputeResolutionPayout},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Bounty Creator Concedes",
+			request: &types.MsgResolveBountyDispute{Creator: users[1], Id: 0, Resolution: types.BountyDisputeResolutionPayout},
+		},
+		{
+			desc:    "Contributor Concedes",
+			request: &types.MsgResolveBountyDispute{Creator: users[2], Id: 1, Resolution: types.BountyDisputeResolutionRefund},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ResolveBountyDispute(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	bounty, _ := k.GetBounty(ctx, 0)
+	require.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+	require.Equal(t, users[1], bounty.Dispute.ResolvedBy)
+	bounty, _ = k.GetBounty(ctx, 1)
+	require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	require.Equal(t, users[2], bounty.Dispute.ResolvedBy)
+
+	require.Equal(t, int64(1000-400+100), getBalance(a, ctx, users[1]))
+	require.Equal(t, int64(1000+100), getBalance(a, ctx, users[2]))
+}
+
 func (suite *KeeperTestSuite) setupPreBounty() (users []string, repositoryId types.RepositoryId, issueId uint64, pullRequestId uint64) {
 	users = append(users,
 		string(suite.TestAccs[0]),
@@ -171,3 +621,104 @@ func (suite *KeeperTestSuite) setupPreBounty() (users []string, repositoryId typ
 
 	return users, repositoryId, issue.Iid, pullRequest.Id
 }
+
+func bountyCoins(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, amount))
+}
+
+func getBalance(a *app.GitopiaApp, ctx sdk.Context, address string) int64 {
+	return a.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), params.BaseCoinUnit).Amount.Int64()
+}
+
+// setupPreBountyApp creates four users holding 1000 coins each and a
+// repository of the first one with an issue
+func setupPreBountyApp(t *testing.T) (a *app.GitopiaApp, ctx sdk.Context, srv types.MsgServer, users []string, repositoryId types.RepositoryId) {
+	a = simapp.Setup(t)
+	ctx = a.BaseApp.NewContext(false, tmtypes.Header{Height: 1, ChainID: "gitopia-1", Time: time.Now().UTC()})
+	srv = keeper.NewMsgServerImpl(a.GitopiaKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	for _, username := range []string{"A", "B", "C", "D"} {
+		user := sample.AccAddress()
+		err := testutil.FundAccount(a.BankKeeper, ctx, sdk.MustAccAddressFromBech32(user), bountyCoins(1000))
+		require.NoError(t, err)
+		_, err = srv.CreateUser(goCtx, &types.MsgCreateUser{Creator: user, Username: username})
+		require.NoError(t, err)
+		users = append(users, user)
+	}
+
+	repositoryId = types.RepositoryId{Id: users[0], Name: "repository"}
+	_, err := srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: users[0], Name: repositoryId.Name, Owner: users[0]})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "issue"})
+	require.NoError(t, err)
+
+	return a, ctx, srv, users, repositoryId
+}
+
+// setupPreBountyArchivedRepository creates an archived repository of the
+// given user with a bounty on its issue
+func setupPreBountyArchivedRepository(t *testing.T, a *app.GitopiaApp, ctx sdk.Context, srv types.MsgServer, user string) uint64 {
+	goCtx := sdk.WrapSDKContext(ctx)
+	repositoryId := types.RepositoryId{Id: user, Name: "archived"}
+
+	_, err := srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: user, Name: repositoryId.Name, Owner: user})
+	require.NoError(t, err)
+	issue, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: user, RepositoryId: repositoryId, Title: "issue"})
+	require.NoError(t, err)
+	repository, _ := a.GitopiaKeeper.GetAddressRepository(ctx, user, repositoryId.Name)
+	bounty, err := srv.CreateBounty(goCtx, &types.MsgCreateBounty{Creator: user, Amount: bountyCoins(100), Expiry: ctx.BlockTime().Unix() + 10, RepositoryId: repository.Id, ParentIid: issue.Iid, Parent: types.BountyParentIssue})
+	require.NoError(t, err)
+	_, err = srv.ToggleRepositoryArchived(goCtx, &types.MsgToggleRepositoryArchived{Creator: user, RepositoryId: repositoryId})
+	require.NoError(t, err)
+
+	return bounty.Id
+}
+
+// setupPreBountyDispute creates a dao owned by the first and the fourth user
+// with a repository whose issue is assigned to the third user and carries n
+// bounties of the second user. It also creates an archived repository of the
+// dao holding a disputed bounty.
+func setupPreBountyDispute(t *testing.T, n int) (a *app.GitopiaApp, ctx sdk.Context, srv types.MsgServer, users []string, dao string, archivedBountyId uint64) {
+	a, ctx, srv, users, _ = setupPreBountyApp(t)
+	k := a.GitopiaKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	res, err := srv.CreateDao(goCtx, &types.MsgCreateDao{Creator: users[0], Name: "organization"})
+	require.NoError(t, err)
+	dao = res.Id
+	_, err = srv.AddMember(goCtx, &types.MsgAddMember{Creator: users[0], DaoId: dao, UserId: users[3], Role: types.MemberRole_OWNER})
+	require.NoError(t, err)
+
+	createIssue := func(name string) types.Issue {
+		repositoryId := types.RepositoryId{Id: dao, Name: name}
+		_, err := srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: users[0], Name: name, Owner: dao})
+		require.NoError(t, err)
+		_, err = srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Title: "issue"})
+		require.NoError(t, err)
+		repository, _ := k.GetAddressRepository(ctx, dao, name)
+		issue, _ := k.GetRepositoryIssue(ctx, repository.Id, 1)
+		issue.Assignees = []string{users[2]}
+		k.SetIssue(ctx, issue)
+		return issue
+	}
+	createBounty := func(issue types.Issue) uint64 {
+		res, err := srv.CreateBounty(goCtx, &types.MsgCreateBounty{Creator: users[1], Amount: bountyCoins(100), Expiry: ctx.BlockTime().Unix() + 10, RepositoryId: issue.RepositoryId, ParentIid: issue.Iid, Parent: types.BountyParentIssue})
+		require.NoError(t, err)
+		return res.Id
+	}
+
+	issue := createIssue("repository")
+	for i := 0; i < n; i++ {
+		createBounty(issue)
+	}
+
+	issue = createIssue("archived")
+	archivedBountyId = createBounty(issue)
+	_, err = srv.OpenBountyDispute(goCtx, &types.MsgOpenBountyDispute{Creator: users[1], Id: archivedBountyId, Contributor: users[2]})
+	require.NoError(t, err)
+	_, err = srv.ToggleRepositoryArchived(goCtx, &types.MsgToggleRepositoryArchived{Creator: users[0], RepositoryId: types.RepositoryId{Id: dao, Name: "archived"}})
+	require.NoError(t, err)
+
+	return a, ctx, srv, users, dao, archivedBountyId
+}
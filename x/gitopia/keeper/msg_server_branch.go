@@ -29,10 +29,18 @@ func (k msgServer) SetBranch(goCtx context.Context, msg *types.MsgSetBranch) (*t
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is archived", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	if err := k.CheckBranchPush(ctx, msg.Creator, repository, msg.Branch.Name, msg.Branch.Sha); err != nil {
+		return nil, err
+	}
+
 	// Set default branch if this is the first branch
 	if len(k.GetAllRepositoryBranch(ctx, repository.Id)) == 0 {
 		repository.DefaultBranch = msg.Branch.Name
@@ -57,6 +65,8 @@ func (k msgServer) SetBranch(goCtx context.Context, msg *types.MsgSetBranch) (*t
 		branch.Id = id // For event attribute
 	}
 
+	k.UpdatePullRequestHeads(ctx, msg.Creator, repository, branch.Name, branch.Sha)
+
 	repository.UpdatedAt = ctx.BlockTime().Unix()
 	k.SetRepository(ctx, repository)
 
@@ -99,10 +109,20 @@ func (k msgServer) MultiSetBranch(goCtx context.Context, msg *types.MsgMultiSetB
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is archived", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.PushBranchPermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	for _, branch := range msg.Branches {
+		if err := k.CheckBranchPush(ctx, msg.Creator, repository, branch.Name, branch.Sha); err != nil {
+			return nil, err
+		}
+	}
+
 	// Set default branch if this is the first branch
 	if len(k.GetAllRepositoryBranch(ctx, repository.Id)) == 0 {
 		repository.DefaultBranch = msg.Branches[0].Name
@@ -130,6 +150,8 @@ func (k msgServer) MultiSetBranch(goCtx context.Context, msg *types.MsgMultiSetB
 			b.Id = id // For event attribute
 			updatedBranches = append(updatedBranches, b)
 		}
+
+		k.UpdatePullRequestHeads(ctx, msg.Creator, repository, branch.Name, branch.Sha)
 	}
 
 	repository.UpdatedAt = ctx.BlockTime().Unix()
@@ -231,6 +253,10 @@ func (k msgServer) DeleteBranch(goCtx context.Context, msg *types.MsgDeleteBranc
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	if err := k.CheckBranchDelete(ctx, msg.Creator, repository, msg.Branch); err != nil {
+		return nil, err
+	}
+
 	branch, found := k.GetRepositoryBranch(ctx, repository.Id, msg.Branch)
 	if found {
 		k.RemoveRepositoryBranch(ctx, repository.Id, msg.Branch)
@@ -284,6 +310,12 @@ func (k msgServer) MultiDeleteBranch(goCtx context.Context, msg *types.MsgMultiD
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
+	for _, branch := range msg.Branches {
+		if err := k.CheckBranchDelete(ctx, msg.Creator, repository, branch); err != nil {
+			return nil, err
+		}
+	}
+
 	/* Check if all branch exists */
 	var deletedBranches []types.Branch
 	for _, branch := range msg.Branches {
@@ -376,3 +408,127 @@ func (k msgServer) ToggleForcePush(goCtx context.Context, msg *types.MsgToggleFo
 
 	return &types.MsgToggleForcePushResponse{}, nil
 }
+
+func (k msgServer) SetBranchProtectionRule(goCtx context.Context, msg *types.MsgSetBranchProtectionRule) (*types.MsgSetBranchProtectionRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BranchProtectionRulePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if !isMergeStrategyAllowed(repository.AllowedMergeStrategies, msg.DefaultMergeStrategy) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("merge strategy (%v) is not allowed in repository", msg.DefaultMergeStrategy.String()))
+	}
+
+	rule := &types.BranchProtectionRule{
+		Pattern:              msg.Pattern,
+		MinPushPermission:    msg.MinPushPermission,
+		RequirePullRequest:   msg.RequirePullRequest,
+		AllowDeletion:        msg.AllowDeletion,
+		MergeRequirements:    msg.MergeRequirements,
+		DefaultMergeStrategy: msg.DefaultMergeStrategy,
+	}
+
+	updated := false
+	for i, r := range repository.BranchProtectionRules {
+		if r.Pattern == msg.Pattern {
+			repository.BranchProtectionRules[i] = rule
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		if len(repository.BranchProtectionRules)+1 > maxBranchProtectionRules {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository can't have more than %v branch protection rules", maxBranchProtectionRules))
+		}
+		repository.BranchProtectionRules = append(repository.BranchProtectionRules, rule)
+	}
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ruleJson, _ := json.Marshal(rule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetBranchProtectionRuleEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeBranchProtectionRuleKey, string(ruleJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetBranchProtectionRuleResponse{}, nil
+}
+
+func (k msgServer) DeleteBranchProtectionRule(goCtx context.Context, msg *types.MsgDeleteBranchProtectionRule) (*types.MsgDeleteBranchProtectionRuleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.BranchProtectionRulePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	var rule *types.BranchProtectionRule
+	for i, r := range repository.BranchProtectionRules {
+		if r.Pattern == msg.Pattern {
+			rule = r
+			repository.BranchProtectionRules = append(repository.BranchProtectionRules[:i], repository.BranchProtectionRules[i+1:]...)
+			break
+		}
+	}
+	if rule == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("branch protection rule (%v) doesn't exist", msg.Pattern))
+	}
+
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ruleJson, _ := json.Marshal(rule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteBranchProtectionRuleEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeBranchProtectionRuleKey, string(ruleJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDeleteBranchProtectionRuleResponse{}, nil
+}
@@ -406,3 +406,26 @@ func TestBranchMsgServerMultiDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestBranchMsgServerSetProtectionRuleLimit(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	creator := "A"
+	repositoryId := types.RepositoryId{Id: creator, Name: "repository"}
+
+	_, err := srv.CreateUser(ctx, &types.MsgCreateUser{Creator: creator, Username: creator})
+	require.NoError(t, err)
+	_, err = srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: creator, Name: "repository", Owner: creator})
+	require.NoError(t, err)
+
+	for i := 0; i < 20; i++ {
+		_, err = srv.SetBranchProtectionRule(ctx, &types.MsgSetBranchProtectionRule{Creator: creator, RepositoryId: repositoryId, Pattern: fmt.Sprintf("release-%d/*", i), RequirePullRequest: true})
+		require.NoError(t, err)
+	}
+
+	_, err = srv.SetBranchProtectionRule(ctx, &types.MsgSetBranchProtectionRule{Creator: creator, RepositoryId: repositoryId, Pattern: "master", RequirePullRequest: true})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// existing rules can still be updated
+	_, err = srv.SetBranchProtectionRule(ctx, &types.MsgSetBranchProtectionRule{Creator: creator, RepositoryId: repositoryId, Pattern: "release-0/*", AllowDeletion: true})
+	require.NoError(t, err)
+}
@@ -3,10 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
 func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComment) (*types.MsgCreateCommentResponse, error) {
@@ -17,10 +19,18 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
 	}
 
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", msg.RepositoryId))
+	}
+
 	var commentIid uint64
 	var issue types.Issue
 	var pullRequest types.PullRequest
-	var found bool
 	commentType := types.CommentTypeReply
 
 	if msg.Parent == types.CommentParentIssue {
@@ -28,20 +38,44 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.ParentIid))
 		}
+		if issue.Locked && !k.HavePermission(ctx, msg.Creator, repository, types.CommentOnLockedConversationPermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("issue (%d) is locked", msg.ParentIid))
+		}
 		commentIid = issue.CommentsCount + 1
 	} else if msg.Parent == types.CommentParentPullRequest {
 		pullRequest, found = k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
 		if !found {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.ParentIid))
 		}
+		if pullRequest.Locked && !k.HavePermission(ctx, msg.Creator, repository, types.CommentOnLockedConversationPermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("pullRequest (%d) is locked", msg.ParentIid))
+		}
 		commentIid = pullRequest.CommentsCount + 1
-		if len(msg.DiffHunk) > 0 {
+		if len(msg.Path) > 0 {
 			commentType = types.CommentTypeReview
 		}
 	} else {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid parent type %v", msg.Parent))
 	}
 
+	var threadRoot types.Comment
+	if msg.ReplyTo > 0 {
+		threadRoot, found = k.getParentComment(ctx, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.ReplyTo)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", msg.ReplyTo))
+		}
+		// replies to a reply join the thread of the comment it replies to
+		if threadRoot.InReplyTo > 0 {
+			threadRoot, found = k.getParentComment(ctx, msg.RepositoryId, msg.ParentIid, msg.Parent, threadRoot.InReplyTo)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", msg.ReplyTo))
+			}
+		}
+		if threadRoot.System {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't reply to system comment")
+		}
+	}
+
 	var comment = types.Comment{
 		Creator:      msg.Creator,
 		RepositoryId: msg.RepositoryId,
@@ -56,6 +90,7 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		CreatedAt:    ctx.BlockTime().Unix(),
 		UpdatedAt:    ctx.BlockTime().Unix(),
 		CommentType:  commentType,
+		InReplyTo:    threadRoot.CommentIid,
 	}
 
 	id := k.AppendComment(
@@ -63,6 +98,11 @@ func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComm
 		comment,
 	)
 
+	if msg.ReplyTo > 0 {
+		threadRoot.Replies = append(threadRoot.Replies, commentIid)
+		k.SetComment(ctx, threadRoot)
+	}
+
 	/* Increment comment count in the parent issue/pullRequest */
 	if comment.Parent == types.CommentParentIssue {
 		issue.CommentsCount += 1
@@ -106,6 +146,18 @@ func (k msgServer) UpdateComment(goCtx context.Context, msg *types.MsgUpdateComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if comment.Body != msg.Body {
+		k.AppendEditHistory(ctx, types.EditHistoryEntry{
+			RepositoryId: comment.RepositoryId,
+			Parent:       comment.Parent,
+			ParentIid:    comment.ParentIid,
+			CommentIid:   comment.CommentIid,
+			Editor:       msg.Creator,
+			Body:         comment.Body,
+			EditedAt:     ctx.BlockTime().Unix(),
+		})
+	}
+
 	comment.Body = msg.Body
 	comment.Attachments = msg.Attachments
 	comment.UpdatedAt = ctx.BlockTime().Unix()
@@ -144,6 +196,15 @@ func (k msgServer) DeleteComment(goCtx context.Context, msg *types.MsgDeleteComm
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	if comment.InReplyTo > 0 {
+		if threadRoot, found := k.getParentComment(ctx, comment.RepositoryId, comment.ParentIid, comment.Parent, comment.InReplyTo); found {
+			if i, exists := utils.CommentReplyExists(threadRoot.Replies, comment.CommentIid); exists {
+				threadRoot.Replies = append(threadRoot.Replies[:i], threadRoot.Replies[i+1:]...)
+				k.SetComment(ctx, threadRoot)
+			}
+		}
+	}
+
 	switch msg.Parent {
 	case types.CommentParentIssue:
 		k.RemoveIssueComment(ctx, comment.RepositoryId, comment.ParentIid, comment.CommentIid)
@@ -153,3 +214,335 @@ func (k msgServer) DeleteComment(goCtx context.Context, msg *types.MsgDeleteComm
 
 	return &types.MsgDeleteCommentResponse{}, nil
 }
+
+func (k msgServer) ResolveCommentThread(goCtx context.Context, msg *types.MsgResolveCommentThread) (*types.MsgResolveCommentThreadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.setCommentThreadResolved(ctx, msg.Creator, msg.RepositoryId, msg.PullIid, msg.CommentIid, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ResolveCommentThreadEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(comment.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(comment.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(comment.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(comment.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgResolveCommentThreadResponse{}, nil
+}
+
+func (k msgServer) UnresolveCommentThread(goCtx context.Context, msg *types.MsgUnresolveCommentThread) (*types.MsgUnresolveCommentThreadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.setCommentThreadResolved(ctx, msg.Creator, msg.RepositoryId, msg.PullIid, msg.CommentIid, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnresolveCommentThreadEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(comment.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(comment.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(comment.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(comment.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUnresolveCommentThreadResponse{}, nil
+}
+
+func (k msgServer) ToggleCommentReaction(goCtx context.Context, msg *types.MsgToggleCommentReaction) (*types.MsgToggleCommentReactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", msg.RepositoryId))
+	}
+
+	var added bool
+
+	if msg.CommentIid > 0 {
+		comment, found := k.getParentComment(ctx, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.CommentIid)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", msg.CommentIid))
+		}
+		if comment.System {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't react to system comment")
+		}
+
+		comment.Reactions, added = ToggleReaction(comment.Reactions, msg.Creator, msg.Emoji)
+		k.SetComment(ctx, comment)
+	} else {
+		switch msg.Parent {
+		case types.CommentParentIssue:
+			issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.ParentIid)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.ParentIid))
+			}
+
+			issue.Reactions, added = ToggleReaction(issue.Reactions, msg.Creator, msg.Emoji)
+			k.SetIssue(ctx, issue)
+		case types.CommentParentPullRequest:
+			pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.ParentIid)
+			if !found {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.ParentIid))
+			}
+
+			pullRequest.Reactions, added = ToggleReaction(pullRequest.Reactions, msg.Creator, msg.Emoji)
+			k.SetPullRequest(ctx, pullRequest)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid comment parent (%v)", msg.Parent))
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ToggleCommentReactionEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, msg.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(msg.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(msg.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeReactionEmojiKey, msg.Emoji.String()),
+			sdk.NewAttribute(types.EventAttributeReactionAddedKey, strconv.FormatBool(added)),
+		),
+	)
+
+	return &types.MsgToggleCommentReactionResponse{
+		Added: added,
+	}, nil
+}
+
+func (k msgServer) HideComment(goCtx context.Context, msg *types.MsgHideComment) (*types.MsgHideCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.setCommentHidden(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.CommentIid, true, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.HideCommentEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(comment.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, comment.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(comment.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(comment.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenKey, strconv.FormatBool(comment.Hidden)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenReasonKey, comment.HiddenReason.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(comment.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgHideCommentResponse{}, nil
+}
+
+func (k msgServer) UnhideComment(goCtx context.Context, msg *types.MsgUnhideComment) (*types.MsgUnhideCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	comment, err := k.setCommentHidden(ctx, msg.Creator, msg.RepositoryId, msg.ParentIid, msg.Parent, msg.CommentIid, false, types.CommentHiddenReasonNone)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnhideCommentEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(comment.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentParentKey, comment.Parent.String()),
+			sdk.NewAttribute(types.EventAttributeCommentParentIidKey, strconv.FormatUint(comment.ParentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentIidKey, strconv.FormatUint(comment.CommentIid, 10)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenKey, strconv.FormatBool(comment.Hidden)),
+			sdk.NewAttribute(types.EventAttributeCommentHiddenReasonKey, comment.HiddenReason.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(comment.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUnhideCommentResponse{}, nil
+}
+
+// setCommentHidden hides or unhides a comment on behalf of a TRIAGE collaborator
+// and records it as a system comment on the issue or pullRequest
+func (k msgServer) setCommentHidden(ctx sdk.Context, creator string, repositoryId uint64, parentIid uint64, parent types.CommentParent, commentIid uint64, hidden bool, reason types.CommentHiddenReason) (types.Comment, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if repository.Archived {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repositoryId))
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.HideCommentPermission) {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	comment, found := k.getParentComment(ctx, repositoryId, parentIid, parent, commentIid)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", commentIid))
+	}
+
+	if comment.System {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't hide system comment")
+	}
+
+	if comment.Hidden == hidden {
+		if hidden {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is already hidden", commentIid))
+		}
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) is not hidden", commentIid))
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	comment.Hidden = hidden
+	comment.HiddenReason = reason
+	comment.UpdatedAt = blockTime
+	if hidden {
+		comment.HiddenBy = creator
+		comment.HiddenAt = blockTime
+	} else {
+		comment.HiddenBy = ""
+		comment.HiddenAt = 0
+	}
+
+	k.SetComment(ctx, comment)
+
+	var systemComment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		System:       true,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+	}
+	if hidden {
+		systemComment.Body = utils.HideCommentCommentBody(creator, commentIid, reason)
+		systemComment.CommentType = types.CommentTypeCommentHidden
+	} else {
+		systemComment.Body = utils.UnhideCommentCommentBody(creator, commentIid)
+		systemComment.CommentType = types.CommentTypeCommentUnhidden
+	}
+
+	switch parent {
+	case types.CommentParentIssue:
+		issue, found := k.GetRepositoryIssue(ctx, repositoryId, parentIid)
+		if !found {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", parentIid))
+		}
+
+		issue.CommentsCount += 1
+		issue.UpdatedAt = blockTime
+		systemComment.CommentIid = issue.CommentsCount
+
+		k.AppendComment(ctx, systemComment)
+		k.SetIssue(ctx, issue)
+	case types.CommentParentPullRequest:
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, parentIid)
+		if !found {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", parentIid))
+		}
+
+		pullRequest.CommentsCount += 1
+		pullRequest.UpdatedAt = blockTime
+		systemComment.CommentIid = pullRequest.CommentsCount
+
+		k.AppendComment(ctx, systemComment)
+		k.SetPullRequest(ctx, pullRequest)
+	}
+
+	return comment, nil
+}
+
+// setCommentThreadResolved resolves or unresolves a review thread on behalf of
+// the pullRequest author or a TRIAGE collaborator
+func (k msgServer) setCommentThreadResolved(ctx sdk.Context, creator string, repositoryId uint64, pullIid uint64, commentIid uint64, resolved bool) (types.Comment, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if repository.Archived {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repositoryId))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, pullIid)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", pullIid))
+	}
+
+	if creator != pullRequest.Creator {
+		if !k.HavePermission(ctx, creator, repository, types.ResolveCommentThreadPermission) {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+		}
+	}
+
+	comment, found := k.GetPullRequestComment(ctx, repositoryId, pullIid, commentIid)
+	if !found {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment (%d) doesn't exist", commentIid))
+	}
+
+	if !IsCommentThread(comment) {
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("comment (%d) doesn't start a review thread", commentIid))
+	}
+
+	if comment.Resolved == resolved {
+		if resolved {
+			return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("thread (%d) is already resolved", commentIid))
+		}
+		return types.Comment{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("thread (%d) is not resolved", commentIid))
+	}
+
+	comment.Resolved = resolved
+	comment.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetComment(ctx, comment)
+
+	return comment, nil
+}
+
+// getParentComment returns a comment of an issue or a pullRequest
+func (k Keeper) getParentComment(ctx sdk.Context, repositoryId uint64, parentIid uint64, parent types.CommentParent, commentIid uint64) (types.Comment, bool) {
+	switch parent {
+	case types.CommentParentIssue:
+		return k.GetIssueComment(ctx, repositoryId, parentIid, commentIid)
+	case types.CommentParentPullRequest:
+		return k.GetPullRequestComment(ctx, repositoryId, parentIid, commentIid)
+	}
+	return types.Comment{}, false
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) SetCommitStatus(goCtx context.Context, msg *types.MsgSetCommitStatus) (*types.MsgSetCommitStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", msg.RepositoryId))
+	}
+
+	// Commit statuses are posted on behalf of the repository owner by the
+	// accounts it granted the CI provider permission to through authz
+	if msg.Creator != repository.Owner.Id {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	commitStatus, found := k.GetRepositoryCommitStatus(ctx, repository.Id, msg.Sha, msg.Context)
+	if !found {
+		commitStatus = types.CommitStatus{
+			RepositoryId: repository.Id,
+			Sha:          msg.Sha,
+			Context:      msg.Context,
+			CreatedAt:    blockTime,
+		}
+	}
+	commitStatus.State = msg.State
+	commitStatus.TargetUrl = msg.TargetUrl
+	commitStatus.Description = msg.Description
+	commitStatus.Creator = msg.Creator
+	commitStatus.UpdatedAt = blockTime
+
+	k.SetRepositoryCommitStatus(ctx, commitStatus)
+
+	if commitStatus.State == types.CommitStatusStateSuccess {
+		k.AutoMergeCommitPullRequests(ctx, repository, commitStatus.Sha)
+	}
+
+	commitStatusJson, _ := json.Marshal(commitStatus)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetCommitStatusEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeCommitStatusKey, string(commitStatusJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(commitStatus.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetCommitStatusResponse{}, nil
+}
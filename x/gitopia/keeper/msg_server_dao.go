@@ -413,5 +413,7 @@ func DoRemoveDao(ctx sdk.Context, k msgServer, user types.User, dao types.Dao) {
 		k.RemoveDaoMember(ctx, dao.Address, member.Address)
 	}
 
+	k.RemoveFollowReferences(ctx, WhoisAddress{Address: dao.Address, OwnerType: types.OwnerType_DAO})
+
 	k.RemoveDao(ctx, dao.Address)
 }
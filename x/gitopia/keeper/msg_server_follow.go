@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) Follow(goCtx context.Context, msg *types.MsgFollow) (*types.MsgFollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.ResolveAddress(ctx, msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("user or dao (%v) doesn't exist", msg.Id))
+	}
+
+	if creator.Address == address.Address {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't follow self")
+	}
+
+	creatorFollowers, creatorFollowing, _ := k.GetFollowLists(ctx, *creator)
+	if _, exists := addressExists(creatorFollowing, address.Address); exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("already following (%v)", msg.Id))
+	}
+	k.SetFollowLists(ctx, *creator, creatorFollowers, append(creatorFollowing, address.Address))
+
+	followers, following, _ := k.GetFollowLists(ctx, *address)
+	if _, exists := addressExists(followers, creator.Address); !exists {
+		followers = append(followers, creator.Address)
+		k.SetFollowLists(ctx, *address, followers, following)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.FollowEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeFollowIdKey, address.Address),
+			sdk.NewAttribute(types.EventAttributeFollowTypeKey, address.OwnerType.String()),
+			sdk.NewAttribute(types.EventAttributeFollowersCountKey, strconv.Itoa(len(followers))),
+		),
+	)
+
+	return &types.MsgFollowResponse{}, nil
+}
+
+func (k msgServer) Unfollow(goCtx context.Context, msg *types.MsgUnfollow) (*types.MsgUnfollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := k.ResolveAddress(ctx, msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.Id)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("user or dao (%v) doesn't exist", msg.Id))
+	}
+
+	creatorFollowers, creatorFollowing, _ := k.GetFollowLists(ctx, *creator)
+	i, exists := addressExists(creatorFollowing, address.Address)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("not following (%v)", msg.Id))
+	}
+	k.SetFollowLists(ctx, *creator, creatorFollowers, append(creatorFollowing[:i], creatorFollowing[i+1:]...))
+
+	followers, following, _ := k.GetFollowLists(ctx, *address)
+	if i, exists := addressExists(followers, creator.Address); exists {
+		followers = append(followers[:i], followers[i+1:]...)
+		k.SetFollowLists(ctx, *address, followers, following)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnfollowEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeFollowIdKey, address.Address),
+			sdk.NewAttribute(types.EventAttributeFollowTypeKey, address.OwnerType.String()),
+			sdk.NewAttribute(types.EventAttributeFollowersCountKey, strconv.Itoa(len(followers))),
+		),
+	)
+
+	return &types.MsgUnfollowResponse{}, nil
+}
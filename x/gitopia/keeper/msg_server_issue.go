@@ -31,6 +31,10 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is archived", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
 	repository.IssuesCount += 1
 
 	var issue = types.Issue{
@@ -82,6 +86,12 @@ func (k msgServer) CreateIssue(goCtx context.Context, msg *types.MsgCreateIssue)
 			ExpireAt:     msg.BountyExpiry,
 			CreatedAt:    ctx.BlockTime().Unix(),
 			UpdatedAt:    ctx.BlockTime().Unix(),
+			Contributions: []types.BountyContribution{
+				{
+					Address: msg.Creator,
+					Amount:  msg.BountyAmount,
+				},
+			},
 		}
 
 		if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.BountyAmount...); err != nil {
@@ -231,6 +241,15 @@ func (k msgServer) UpdateIssueDescription(goCtx context.Context, msg *types.MsgU
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	k.AppendEditHistory(ctx, types.EditHistoryEntry{
+		RepositoryId: issue.RepositoryId,
+		Parent:       types.CommentParentIssue,
+		ParentIid:    issue.Iid,
+		Editor:       msg.Creator,
+		Body:         issue.Description,
+		EditedAt:     ctx.BlockTime().Unix(),
+	})
+
 	issue.Description = msg.Description
 	issue.UpdatedAt = ctx.BlockTime().Unix()
 	issue.CommentsCount += 1
@@ -299,14 +318,8 @@ func (k msgServer) ToggleIssueState(goCtx context.Context, msg *types.MsgToggleI
 
 	switch issue.State {
 	case types.Issue_OPEN:
-		for _, pullRequestIid := range issue.PullRequests {
-			pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
-			if !found {
-				continue
-			}
-			if pullRequest.State == types.PullRequest_OPEN {
-				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't close issue with OPEN linked pull request")
-			}
+		if err := k.closeIssue(ctx, &issue, msg.Creator, msg.CloseReason); err != nil {
+			return nil, err
 		}
 
 		if msg.CommentBody != "" {
@@ -325,47 +338,15 @@ func (k msgServer) ToggleIssueState(goCtx context.Context, msg *types.MsgToggleI
 			})
 		}
 
-		issue.State = types.Issue_CLOSED
-		issue.ClosedBy = msg.Creator
-		issue.ClosedAt = blockTime
-
-		for _, bountyId := range issue.Bounties {
-			bounty, found := k.GetBounty(ctx, bountyId)
-			if !found {
-				continue
-			}
-			if bounty.State != types.BountyStateSRCDEBITTED {
-				continue
-			}
-			creatorAccAddress, err := sdk.AccAddressFromBech32(bounty.Creator)
-			if err != nil {
-				continue
-			}
-
-			if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-				continue
-			}
-			if k.bankKeeper.BlockedAddr(creatorAccAddress) {
-				continue
-			}
-			bountyAddress := GetBountyAddress(bounty.Id)
-			if err := k.bankKeeper.SendCoins(
-				ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-			); err != nil {
-				continue
-			}
-
-			bounty.State = types.BountyStateREVERTEDBACK
-			bounty.ExpireAt = time.Time{}.Unix()
-			bounty.UpdatedAt = blockTime
-
-			k.SetBounty(ctx, bounty)
-		}
 		commentType = types.CommentTypeIssueClosed
 	case types.Issue_CLOSED:
+		if msg.CloseReason != types.CloseReasonUnspecified {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "close reason can't be set when reopening issue")
+		}
 		issue.State = types.Issue_OPEN
 		issue.ClosedBy = string("")
 		issue.ClosedAt = time.Time{}.Unix()
+		issue.CloseReason = types.CloseReasonUnspecified
 		commentType = types.CommentTypeIssueOpened
 	}
 
@@ -401,6 +382,7 @@ func (k msgServer) ToggleIssueState(goCtx context.Context, msg *types.MsgToggleI
 			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
 			sdk.NewAttribute(types.EventAttributeIssueStateKey, issue.State.String()),
 			sdk.NewAttribute(types.EventAttributeClosedByKey, issue.ClosedBy),
+			sdk.NewAttribute(types.EventAttributeCloseReasonKey, issue.CloseReason.String()),
 			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
 			sdk.NewAttribute(types.EventAttributeClosedAtKey, strconv.FormatInt(issue.ClosedAt, 10)),
 		),
@@ -435,10 +417,6 @@ func (k msgServer) AddIssueAssignees(goCtx context.Context, msg *types.MsgAddIss
 
 	totalAssignees := len(issue.Assignees) + len(msg.Assignees)
 
-	if len(issue.Bounties) > 0 && totalAssignees > 1 {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue with bounty can't have more then 1 assignee")
-	}
-
 	if totalAssignees > 10 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't have more than 10 assignees")
 	}
@@ -526,6 +504,11 @@ func (k msgServer) RemoveIssueAssignees(goCtx context.Context, msg *types.MsgRem
 		} else {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("assignee (%v) aren't assigned", a))
 		}
+
+		// fall back to an equal split when a removed assignee had a share
+		if _, exists := utils.BountyShareExists(issue.BountySplit.Shares, a); exists {
+			issue.BountySplit = types.BountySplit{}
+		}
 	}
 
 	issue.CommentsCount += 1
@@ -568,6 +551,79 @@ func (k msgServer) RemoveIssueAssignees(goCtx context.Context, msg *types.MsgRem
 	return &types.MsgRemoveIssueAssigneesResponse{}, nil
 }
 
+func (k msgServer) SetIssueBountySplit(goCtx context.Context, msg *types.MsgSetIssueBountySplit) (*types.MsgSetIssueBountySplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.AssignPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if issue.State != types.Issue_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't change bounty split of a closed issue")
+	}
+
+	for _, share := range msg.Split.Shares {
+		if _, exists := utils.AssigneeExists(issue.Assignees, share.Address); !exists {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("(%v) isn't assigned to the issue", share.Address))
+		}
+	}
+
+	issue.BountySplit = msg.Split
+	issue.CommentsCount += 1
+	issue.UpdatedAt = ctx.BlockTime().Unix()
+
+	var comment = types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: issue.RepositoryId,
+		ParentIid:    msg.Iid,
+		Parent:       types.CommentParentIssue,
+		CommentIid:   issue.CommentsCount,
+		Body:         utils.SetBountySplitCommentBody(msg.Creator, msg.Split),
+		System:       true,
+		CreatedAt:    issue.UpdatedAt,
+		UpdatedAt:    issue.UpdatedAt,
+		CommentType:  types.CommentTypeModifiedBounty,
+	}
+
+	k.AppendComment(
+		ctx,
+		comment,
+	)
+	k.SetIssue(ctx, issue)
+
+	splitJson, _ := json.Marshal(msg.Split)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetIssueBountySplitEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeBountySplitKey, string(splitJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetIssueBountySplitResponse{}, nil
+}
+
 func (k msgServer) AddIssueLabels(goCtx context.Context, msg *types.MsgAddIssueLabels) (*types.MsgAddIssueLabelsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -763,6 +819,10 @@ func (k msgServer) DeleteIssue(goCtx context.Context, msg *types.MsgDeleteIssue)
 		}
 	}
 
+	if k.HasDisputedBounty(ctx, issue.Bounties) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete issue having DISPUTED bounty")
+	}
+
 	DoRemoveIssue(ctx, k, issue, repository)
 
 	repository.UpdatedAt = ctx.BlockTime().Unix()
@@ -805,29 +865,25 @@ func DoRemoveIssue(ctx sdk.Context, k msgServer, issue types.Issue, repository t
 		k.SetPullRequest(ctx, pullRequest)
 	}
 
+	k.removeAllIssueLinks(ctx, issue)
+
 	for _, bountyId := range issue.Bounties {
 		bounty, found := k.GetBounty(ctx, bountyId)
 		if !found {
 			continue
 		}
-		if bounty.State != types.BountyStateSRCDEBITTED {
-			continue
-		}
-		creatorAccAddress, err := sdk.AccAddressFromBech32(bounty.Creator)
-		if err != nil {
-			continue
-		}
-
-		if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
+		if bounty.State == types.BountyStateDISPUTED {
+			// the parent is going away, hand the escrow back to the funders
+			if err := k.SettleBountyDispute(ctx, bounty, types.BountyDisputeResolutionRefund, 0, "GITOPIA"); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("error settling disputed bounty (%d): %v", bounty.Id, err))
+			}
 			continue
 		}
-		if k.bankKeeper.BlockedAddr(creatorAccAddress) {
+		if bounty.State != types.BountyStateSRCDEBITTED {
 			continue
 		}
-		bountyAddress := GetBountyAddress(bounty.Id)
-		if err := k.bankKeeper.SendCoins(
-			ctx, bountyAddress, creatorAccAddress, bounty.Amount,
-		); err != nil {
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("error refunding bounty (%d): %v", bounty.Id, err))
 			continue
 		}
 
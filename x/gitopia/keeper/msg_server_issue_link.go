@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// getLinkableIssues returns the two issues to link, creator needs to be able
+// to triage issues in the repositories of both
+func (k msgServer) getLinkableIssues(ctx sdk.Context, creator string, repositoryId uint64, iid uint64, targetRepositoryId uint64, targetIid uint64) (types.Issue, types.Issue, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, repositoryId, iid)
+	if !found {
+		return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", iid))
+	}
+
+	target, found := k.GetRepositoryIssue(ctx, targetRepositoryId, targetIid)
+	if !found {
+		return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository id (%d)", targetIid, targetRepositoryId))
+	}
+
+	for _, id := range []uint64{repositoryId, targetRepositoryId} {
+		repository, found := k.GetRepositoryById(ctx, id)
+		if !found {
+			return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", id))
+		}
+
+		if repository.Archived {
+			return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+		}
+
+		if !k.HavePermission(ctx, creator, repository, types.LinkIssuePermission) {
+			return types.Issue{}, types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+		}
+	}
+
+	return issue, target, nil
+}
+
+func (k msgServer) LinkIssue(goCtx context.Context, msg *types.MsgLinkIssue) (*types.MsgLinkIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, target, err := k.getLinkableIssues(ctx, msg.Creator, msg.RepositoryId, msg.Iid, msg.TargetRepositoryId, msg.TargetIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.addIssueLink(ctx, msg.Creator, &issue, &target, msg.LinkType); err != nil {
+		return nil, err
+	}
+
+	k.appendIssueSystemComment(ctx, &issue, utils.LinkIssueToIssueCommentBody(msg.Creator, msg.LinkType, k.issueReference(ctx, issue.RepositoryId, target)), types.CommentTypeIssueLinked)
+	k.appendIssueSystemComment(ctx, &target, utils.LinkIssueToIssueCommentBody(msg.Creator, InverseIssueLinkType(msg.LinkType), k.issueReference(ctx, target.RepositoryId, issue)), types.CommentTypeIssueLinked)
+
+	k.SetIssue(ctx, issue)
+	k.SetIssue(ctx, target)
+
+	linkJson, _ := json.Marshal(issue.Links[len(issue.Links)-1])
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.LinkIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueLinkKey, string(linkJson)),
+			sdk.NewAttribute(types.EventAttributeTargetRepoIdKey, strconv.FormatUint(target.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetIssueIidKey, strconv.FormatUint(target.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgLinkIssueResponse{}, nil
+}
+
+func (k msgServer) UnlinkIssue(goCtx context.Context, msg *types.MsgUnlinkIssue) (*types.MsgUnlinkIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, target, err := k.getLinkableIssues(ctx, msg.Creator, msg.RepositoryId, msg.Iid, msg.TargetRepositoryId, msg.TargetIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !removeIssueLink(&issue, &target) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%v) isn't linked", target.Iid))
+	}
+
+	k.appendIssueSystemComment(ctx, &issue, utils.UnlinkIssueFromIssueCommentBody(msg.Creator, k.issueReference(ctx, issue.RepositoryId, target)), types.CommentTypeIssueUnlinked)
+	k.appendIssueSystemComment(ctx, &target, utils.UnlinkIssueFromIssueCommentBody(msg.Creator, k.issueReference(ctx, target.RepositoryId, issue)), types.CommentTypeIssueUnlinked)
+
+	k.SetIssue(ctx, issue)
+	k.SetIssue(ctx, target)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnlinkIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetRepoIdKey, strconv.FormatUint(target.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetIssueIidKey, strconv.FormatUint(target.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUnlinkIssueResponse{}, nil
+}
+
+func (k msgServer) CloseIssueAsDuplicate(goCtx context.Context, msg *types.MsgCloseIssueAsDuplicate) (*types.MsgCloseIssueAsDuplicateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, target, err := k.getLinkableIssues(ctx, msg.Creator, msg.RepositoryId, msg.Iid, msg.DuplicateOfRepositoryId, msg.DuplicateOfIid)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.State != types.Issue_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't close (%v) issue", issue.State.String()))
+	}
+
+	// An existing link of another type is replaced by the duplicate link
+	if i, exists := utils.IssueLinkExists(issue.Links, target.RepositoryId, target.Iid); !exists || issue.Links[i].LinkType != types.IssueLinkTypeDuplicateOf {
+		removeIssueLink(&issue, &target)
+		if err := k.addIssueLink(ctx, msg.Creator, &issue, &target, types.IssueLinkTypeDuplicateOf); err != nil {
+			return nil, err
+		}
+		k.appendIssueSystemComment(ctx, &target, utils.LinkIssueToIssueCommentBody(msg.Creator, types.IssueLinkTypeDuplicatedBy, k.issueReference(ctx, target.RepositoryId, issue)), types.CommentTypeIssueLinked)
+	}
+
+	if err := k.closeIssue(ctx, &issue, msg.Creator, types.CloseReasonDuplicate); err != nil {
+		return nil, err
+	}
+
+	k.appendIssueSystemComment(ctx, &issue, utils.CloseIssueAsDuplicateCommentBody(msg.Creator, k.issueReference(ctx, issue.RepositoryId, target), msg.Reason), types.CommentTypeIssueClosed)
+
+	k.SetIssue(ctx, issue)
+	k.SetIssue(ctx, target)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.CloseIssueAsDuplicateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueStateKey, issue.State.String()),
+			sdk.NewAttribute(types.EventAttributeTargetRepoIdKey, strconv.FormatUint(target.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetIssueIidKey, strconv.FormatUint(target.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeDuplicateReasonKey, msg.Reason),
+			sdk.NewAttribute(types.EventAttributeClosedByKey, issue.ClosedBy),
+			sdk.NewAttribute(types.EventAttributeCloseReasonKey, issue.CloseReason.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+			sdk.NewAttribute(types.EventAttributeClosedAtKey, strconv.FormatInt(issue.ClosedAt, 10)),
+		),
+	)
+
+	return &types.MsgCloseIssueAsDuplicateResponse{}, nil
+}
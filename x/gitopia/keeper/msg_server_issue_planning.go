@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// getPlanningIssue returns the issue to plan, creator needs to be able to
+// triage issues in its repository
+func (k msgServer) getPlanningIssue(ctx sdk.Context, creator string, repositoryId uint64, iid uint64) (types.Issue, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, repositoryId, iid)
+	if !found {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if repository.Archived {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.IssuePlanningPermission) {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	return issue, nil
+}
+
+func (k msgServer) SetIssueWeight(goCtx context.Context, msg *types.MsgSetIssueWeight) (*types.MsgSetIssueWeightResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, err := k.getPlanningIssue(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.Weight == msg.Weight {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue weight is already (%v)", msg.Weight))
+	}
+
+	issue.Weight = msg.Weight
+	k.appendIssueSystemComment(ctx, &issue, utils.IssueWeightCommentBody(msg.Creator, msg.Weight), types.CommentTypeWeightChanged)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetIssueWeightEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueWeightKey, strconv.FormatUint(issue.Weight, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetIssueWeightResponse{}, nil
+}
+
+func (k msgServer) SetIssueDueDate(goCtx context.Context, msg *types.MsgSetIssueDueDate) (*types.MsgSetIssueDueDateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, err := k.getPlanningIssue(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.DueDate == msg.DueDate {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue due date is already (%v)", msg.DueDate))
+	}
+
+	issue.DueDate = msg.DueDate
+	k.appendIssueSystemComment(ctx, &issue, utils.IssueDueDateCommentBody(msg.Creator, msg.DueDate), types.CommentTypeDueDateChanged)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetIssueDueDateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueDueDateKey, strconv.FormatInt(issue.DueDate, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetIssueDueDateResponse{}, nil
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
@@ -175,6 +179,63 @@ func TestIssueMsgServerSetState(t *testing.T) {
 	}
 }
 
+func TestIssueMsgServerSetStateCloseReason(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	for i := 0; i < 2; i++ {
+		_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgToggleIssueState
+		err     error
+	}{
+		{
+			desc:    "Close With Reason",
+			request: &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 1, CloseReason: types.CloseReasonWontFix},
+		},
+		{
+			desc:    "Reopen With Reason",
+			request: &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 1, CloseReason: types.CloseReasonCompleted},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Reopen",
+			request: &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 1},
+		},
+		{
+			desc:    "Close Without Reason",
+			request: &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 2},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ToggleIssueState(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// reopening clears the reason and closing without one leaves it unspecified
+	issue, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	require.Equal(t, types.Issue_OPEN, issue.State)
+	require.Equal(t, types.CloseReasonUnspecified, issue.CloseReason)
+	issue, _ = k.GetRepositoryIssue(ctx, 0, 2)
+	require.Equal(t, types.Issue_CLOSED, issue.State)
+	require.Equal(t, types.CloseReasonUnspecified, issue.CloseReason)
+
+	_, err := srv.ToggleIssueState(goCtx, &types.MsgToggleIssueState{Creator: users[0], RepositoryId: 0, Iid: 1, CloseReason: types.CloseReasonWontFix})
+	require.NoError(t, err)
+	issue, _ = k.GetRepositoryIssue(ctx, 0, 1)
+	require.Equal(t, types.CloseReasonWontFix, issue.CloseReason)
+}
+
 func TestIssueMsgServerAddAssignees(t *testing.T) {
 	srv, ctx := setupMsgServer(t)
 
@@ -428,6 +489,592 @@ func TestIssueMsgServerDelete(t *testing.T) {
 	}
 }
 
+func TestIssueMsgServerDeleteDisputedBounty(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner := sample.AccAddress()
+	k.SetUser(ctx, types.User{Creator: owner})
+	repositoryId := k.AppendRepository(ctx, types.Repository{
+		Name:  "repository",
+		Owner: &types.RepositoryOwner{Id: owner, Type: types.OwnerType_USER},
+	})
+
+	bountyId := k.AppendBounty(ctx, types.Bounty{
+		RepositoryId: repositoryId,
+		ParentIid:    1,
+		Parent:       types.BountyParentIssue,
+		State:        types.BountyStateDISPUTED,
+		Dispute:      &types.BountyDispute{Contributor: sample.AccAddress()},
+	})
+	k.AppendIssue(ctx, types.Issue{RepositoryId: repositoryId, Iid: 1, Bounties: []uint64{bountyId}})
+
+	_, err := srv.DeleteIssue(goCtx, &types.MsgDeleteIssue{Creator: owner, RepositoryId: repositoryId, Iid: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// removing the repository refunds the escrow of the dispute
+	_, err = srv.DeleteRepository(goCtx, &types.MsgDeleteRepository{Creator: owner, RepositoryId: types.RepositoryId{Id: owner, Name: "repository"}})
+	require.NoError(t, err)
+
+	bounty, _ := k.GetBounty(ctx, bountyId)
+	require.Equal(t, types.BountyStateREVERTEDBACK, bounty.State)
+	require.Equal(t, types.BountyDisputeResolutionRefund, bounty.Dispute.Resolution)
+}
+
+func TestIssueMsgServerLinkIssue(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	for i := 0; i < 2; i++ {
+		_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+	setupPreIssueArchivedRepository(goCtx, t, srv, users[0])
+	archived, _ := k.GetAddressRepository(ctx, users[0], "archived")
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgLinkIssue
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgLinkIssue{Creator: "C", RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 2},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 3},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgLinkIssue{Creator: users[1], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 2},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: archived.Id, TargetIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 2},
+		},
+		{
+			desc:    "Already Linked",
+			request: &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 2, LinkType: types.IssueLinkTypeRelatesTo, TargetRepositoryId: 0, TargetIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.LinkIssue(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	blocked, _ := k.GetRepositoryIssue(ctx, 0, 2)
+	require.Equal(t, []types.IssueLink{{LinkType: types.IssueLinkTypeBlockedBy, RepositoryId: 0, Iid: 1, Creator: users[0], CreatedAt: ctx.BlockTime().Unix()}}, blocked.Links)
+	require.Equal(t, uint64(1), blocked.CommentsCount)
+	comment, found := k.GetIssueComment(ctx, 0, 2, 1)
+	require.True(t, found)
+	require.Equal(t, types.CommentTypeIssueLinked, comment.CommentType)
+}
+
+func TestIssueMsgServerUnlinkIssue(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+	_, err := srv.LinkIssue(goCtx, &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 2})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgUnlinkIssue
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgUnlinkIssue{Creator: "C", RepositoryId: 0, Iid: 2, TargetRepositoryId: 0, TargetIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgUnlinkIssue{Creator: users[1], RepositoryId: 0, Iid: 2, TargetRepositoryId: 0, TargetIid: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Not Linked",
+			request: &types.MsgUnlinkIssue{Creator: users[0], RepositoryId: 0, Iid: 2, TargetRepositoryId: 0, TargetIid: 3},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgUnlinkIssue{Creator: users[0], RepositoryId: 0, Iid: 2, TargetRepositoryId: 0, TargetIid: 1},
+		},
+		{
+			desc:    "Already Unlinked",
+			request: &types.MsgUnlinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: 0, TargetIid: 2},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.UnlinkIssue(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	blocked, _ := k.GetRepositoryIssue(ctx, 0, 2)
+	require.Empty(t, issue.Links)
+	require.Empty(t, blocked.Links)
+}
+
+func TestIssueMsgServerCloseAsDuplicate(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+	_, err = srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: users[0], Name: "other", Owner: users[0]})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "other"}})
+	require.NoError(t, err)
+	setupPreIssueArchivedRepository(goCtx, t, srv, users[0])
+	archived, _ := k.GetAddressRepository(ctx, users[0], "archived")
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgCloseIssueAsDuplicate
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgCloseIssueAsDuplicate{Creator: "C", RepositoryId: 1, Iid: 1, DuplicateOfRepositoryId: 0, DuplicateOfIid: 1},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgCloseIssueAsDuplicate{Creator: users[1], RepositoryId: 1, Iid: 1, DuplicateOfRepositoryId: 0, DuplicateOfIid: 1},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgCloseIssueAsDuplicate{Creator: users[0], RepositoryId: 1, Iid: 1, DuplicateOfRepositoryId: archived.Id, DuplicateOfIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgCloseIssueAsDuplicate{Creator: users[0], RepositoryId: 1, Iid: 1, DuplicateOfRepositoryId: 0, DuplicateOfIid: 1, Reason: "same crash"},
+		},
+		{
+			desc:    "Issue Closed",
+			request: &types.MsgCloseIssueAsDuplicate{Creator: users[0], RepositoryId: 1, Iid: 1, DuplicateOfRepositoryId: 0, DuplicateOfIid: 1},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.CloseIssueAsDuplicate(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	duplicate, _ := k.GetRepositoryIssue(ctx, 1, 1)
+	require.Equal(t, types.Issue_CLOSED, duplicate.State)
+	require.Equal(t, types.CloseReasonDuplicate, duplicate.CloseReason)
+	require.Equal(t, types.IssueLinkTypeDuplicateOf, duplicate.Links[0].LinkType)
+	comment, found := k.GetIssueComment(ctx, 1, 1, duplicate.CommentsCount)
+	require.True(t, found)
+	require.Equal(t, "@A closed this as a duplicate of A/repository#1: same crash", comment.Body)
+
+	original, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	require.Len(t, original.Links, 1)
+	require.Equal(t, types.IssueLinkTypeDuplicatedBy, original.Links[0].LinkType)
+}
+
+func TestInverseIssueLinkType(t *testing.T) {
+	for linkType, inverse := range map[types.IssueLinkType]types.IssueLinkType{
+		types.IssueLinkTypeRelatesTo:    types.IssueLinkTypeRelatesTo,
+		types.IssueLinkTypeBlocks:       types.IssueLinkTypeBlockedBy,
+		types.IssueLinkTypeBlockedBy:    types.IssueLinkTypeBlocks,
+		types.IssueLinkTypeDuplicateOf:  types.IssueLinkTypeDuplicatedBy,
+		types.IssueLinkTypeDuplicatedBy: types.IssueLinkTypeDuplicateOf,
+	} {
+		require.Equal(t, inverse, keeper.InverseIssueLinkType(linkType))
+	}
+}
+
+func TestIssueMsgServerTransfer(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	targetRepositoryId := types.RepositoryId{Id: users[0], Name: "target"}
+	_, err := srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: users[0], Name: targetRepositoryId.Name, Owner: users[0]})
+	require.NoError(t, err)
+	_, err = srv.CreateRepository(goCtx, &types.MsgCreateRepository{Creator: users[1], Name: "repository", Owner: users[1]})
+	require.NoError(t, err)
+	setupPreIssueArchivedRepository(goCtx, t, srv, users[0])
+
+	for _, label := range []struct {
+		repositoryId types.RepositoryId
+		name         string
+	}{
+		{repositoryId, "bug"},
+		{repositoryId, "question"},
+		{targetRepositoryId, "feature"},
+		{targetRepositoryId, "bug"},
+	} {
+		_, err = srv.CreateRepositoryLabel(goCtx, &types.MsgCreateRepositoryLabel{Creator: users[0], RepositoryId: label.repositoryId, Name: label.name, Color: "color"})
+		require.NoError(t, err)
+	}
+	for i := 0; i < 4; i++ {
+		_, err = srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId, Description: "description"})
+		require.NoError(t, err)
+	}
+	_, err = srv.CreateComment(goCtx, &types.MsgCreateComment{Creator: users[0], RepositoryId: 0, ParentIid: 1, Parent: types.CommentParentIssue, Body: "comment"})
+	require.NoError(t, err)
+	_, err = srv.LinkIssue(goCtx, &types.MsgLinkIssue{Creator: users[0], RepositoryId: 0, Iid: 1, LinkType: types.IssueLinkTypeBlocks, TargetRepositoryId: 0, TargetIid: 2})
+	require.NoError(t, err)
+
+	source, _ := k.GetRepositoryById(ctx, 0)
+	target, _ := k.GetAddressRepository(ctx, users[0], targetRepositoryId.Name)
+	other, _ := k.GetAddressRepository(ctx, users[1], "repository")
+	archived, _ := k.GetAddressRepository(ctx, users[0], "archived")
+
+	// issue 1 has labels, a milestone, a bounty and a closed pull request,
+	// issue 3 an open pull request and issue 4 a disputed bounty
+	issue, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	issue.Labels = []uint64{1, 2}
+	issue.Milestone = 1
+	issue.Bounties = []uint64{k.AppendBounty(ctx, types.Bounty{RepositoryId: 0, ParentIid: 1, Parent: types.BountyParentIssue})}
+	issue.PullRequests = []*types.PullRequestIid{{Id: 0, Iid: 1}}
+	k.SetIssue(ctx, issue)
+	k.AppendPullRequest(ctx, types.PullRequest{Iid: 1, State: types.PullRequest_CLOSED, Base: &types.PullRequestBase{RepositoryId: 0}, Issues: []*types.IssueIid{{Id: issue.Id, Iid: 1}}})
+
+	issue, _ = k.GetRepositoryIssue(ctx, 0, 3)
+	issue.PullRequests = []*types.PullRequestIid{{Id: 1, Iid: 2}}
+	k.SetIssue(ctx, issue)
+	k.AppendPullRequest(ctx, types.PullRequest{Iid: 2, State: types.PullRequest_OPEN, Base: &types.PullRequestBase{RepositoryId: 0}, Issues: []*types.IssueIid{{Id: issue.Id, Iid: 3}}})
+
+	issue, _ = k.GetRepositoryIssue(ctx, 0, 4)
+	issue.Bounties = []uint64{k.AppendBounty(ctx, types.Bounty{RepositoryId: 0, ParentIid: 4, Parent: types.BountyParentIssue, State: types.BountyStateDISPUTED})}
+	k.SetIssue(ctx, issue)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgTransferIssue
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgTransferIssue{Creator: "C", RepositoryId: 0, Iid: 1, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 5, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Target Repository Not Exists",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgTransferIssue{Creator: users[1], RepositoryId: 0, Iid: 1, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Unauthorized In Target Repository",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: other.Id},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Target Repository Archived",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: archived.Id},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Open Linked Pull Request",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 3, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Disputed Bounty",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 4, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: target.Id},
+		},
+		{
+			desc:    "Transferred Issue Not Exists",
+			request: &types.MsgTransferIssue{Creator: users[0], RepositoryId: 0, Iid: 1, TargetRepositoryId: target.Id},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.TransferIssue(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, target.Id, 1)
+	require.True(t, found)
+	require.Equal(t, []uint64{2}, issue.Labels)
+	require.Equal(t, uint64(0), issue.Milestone)
+	require.Empty(t, issue.PullRequests)
+
+	comment, found := k.GetIssueComment(ctx, target.Id, 1, 1)
+	require.True(t, found)
+	require.Equal(t, "comment", comment.Body)
+	comment, _ = k.GetIssueComment(ctx, target.Id, 1, issue.CommentsCount)
+	require.Equal(t, "@A transferred this issue from A/repository#1", comment.Body)
+	require.Equal(t, types.CommentTypeIssueTransferred, comment.CommentType)
+
+	bounty, _ := k.GetBounty(ctx, issue.Bounties[0])
+	require.Equal(t, target.Id, bounty.RepositoryId)
+	require.Equal(t, uint64(1), bounty.ParentIid)
+
+	blocked, _ := k.GetRepositoryIssue(ctx, 0, 2)
+	require.Equal(t, target.Id, blocked.Links[0].RepositoryId)
+	require.Equal(t, uint64(1), blocked.Links[0].Iid)
+
+	// linked pull requests are notified of the dropped link
+	pullRequest, _ := k.GetRepositoryPullRequest(ctx, 0, 1)
+	require.Empty(t, pullRequest.Issues)
+	comment, found = k.GetPullRequestComment(ctx, 0, 1, pullRequest.CommentsCount)
+	require.True(t, found)
+	require.Equal(t, "@A unlinked issue #1 which was transferred to A/target#1", comment.Body)
+
+	// the old location resolves through the redirect, also after a second transfer
+	_, err = srv.TransferIssue(goCtx, &types.MsgTransferIssue{Creator: users[0], RepositoryId: target.Id, Iid: 1, TargetRepositoryId: 0})
+	require.NoError(t, err)
+	redirect, found := k.ResolveIssueRedirect(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(0), redirect.TargetRepositoryId)
+	require.Equal(t, source.IssuesCount+1, redirect.TargetIid)
+
+	// deleting the intermediate repository keeps the redirect
+	_, err = srv.DeleteRepository(goCtx, &types.MsgDeleteRepository{Creator: users[0], RepositoryId: targetRepositoryId})
+	require.NoError(t, err)
+	redirect, found = k.GetIssueRedirect(ctx, 0, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(0), redirect.TargetRepositoryId)
+	require.Equal(t, source.IssuesCount+1, redirect.TargetIid)
+
+	_, err = srv.DeleteRepository(goCtx, &types.MsgDeleteRepository{Creator: users[0], RepositoryId: repositoryId})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllIssueRedirect(ctx))
+}
+
+func TestIssueMsgServerSetWeight(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	setupPreIssueArchivedRepository(goCtx, t, srv, users[0])
+	archived, _ := k.GetAddressRepository(ctx, users[0], "archived")
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+	for iid, assignees := range map[uint64][]string{1: {"alice", "bob"}, 2: {"alice"}, 3: {"alice"}} {
+		issue, _ := k.GetRepositoryIssue(ctx, 0, iid)
+		issue.Assignees = assignees
+		if iid == 3 {
+			issue.Weight = 8
+			issue.State = types.Issue_CLOSED
+		}
+		k.SetIssue(ctx, issue)
+	}
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetIssueWeight
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetIssueWeight{Creator: "C", RepositoryId: 0, Iid: 1, Weight: 3},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgSetIssueWeight{Creator: users[0], RepositoryId: 0, Iid: 10, Weight: 3},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetIssueWeight{Creator: users[1], RepositoryId: 0, Iid: 1, Weight: 3},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgSetIssueWeight{Creator: users[0], RepositoryId: archived.Id, Iid: 1, Weight: 3},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetIssueWeight{Creator: users[0], RepositoryId: 0, Iid: 1, Weight: 3},
+		},
+		{
+			desc:    "Same Weight",
+			request: &types.MsgSetIssueWeight{Creator: users[0], RepositoryId: 0, Iid: 1, Weight: 3},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed Other Issue",
+			request: &types.MsgSetIssueWeight{Creator: users[0], RepositoryId: 0, Iid: 2, Weight: 5},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetIssueWeight(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	require.Equal(t, uint64(3), issue.Weight)
+	require.Equal(t, uint64(1), issue.CommentsCount)
+	comment, _ := k.GetIssueComment(ctx, 0, 1, 1)
+	require.Equal(t, "@A set the weight to 3", comment.Body)
+	require.Equal(t, types.CommentTypeWeightChanged, comment.CommentType)
+
+	require.Equal(t, []types.AssigneeWeight{
+		{Assignee: "alice", Weight: 8, OpenIssues: 2},
+		{Assignee: "bob", Weight: 3, OpenIssues: 1},
+	}, k.GetRepositoryAssigneeWeights(ctx, 0))
+}
+
+func TestIssueMsgServerSetDueDate(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv, goCtx := keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
+
+	users, repositoryId := setupPreIssue(goCtx, t, srv)
+	setupPreIssueArchivedRepository(goCtx, t, srv, users[0])
+	archived, _ := k.GetAddressRepository(ctx, users[0], "archived")
+	for i := 0; i < 3; i++ {
+		_, err := srv.CreateIssue(goCtx, &types.MsgCreateIssue{Creator: users[0], RepositoryId: repositoryId})
+		require.NoError(t, err)
+	}
+	for iid, weight := range map[uint64]uint64{1: 3, 2: 5, 3: 8} {
+		issue, _ := k.GetRepositoryIssue(ctx, 0, iid)
+		issue.Weight = weight
+		if iid == 3 {
+			issue.State = types.Issue_CLOSED
+		}
+		k.SetIssue(ctx, issue)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetIssueDueDate
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetIssueDueDate{Creator: "C", RepositoryId: 0, Iid: 1, DueDate: blockTime + 86400},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Issue Not Exists",
+			request: &types.MsgSetIssueDueDate{Creator: users[0], RepositoryId: 0, Iid: 10, DueDate: blockTime + 86400},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetIssueDueDate{Creator: users[1], RepositoryId: 0, Iid: 1, DueDate: blockTime + 86400},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Repository Archived",
+			request: &types.MsgSetIssueDueDate{Creator: users[0], RepositoryId: archived.Id, Iid: 1, DueDate: blockTime + 86400},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetIssueDueDate{Creator: users[0], RepositoryId: 0, Iid: 1, DueDate: blockTime + 86400},
+		},
+		{
+			desc:    "Same Due Date",
+			request: &types.MsgSetIssueDueDate{Creator: users[0], RepositoryId: 0, Iid: 1, DueDate: blockTime + 86400},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed Overdue",
+			request: &types.MsgSetIssueDueDate{Creator: users[0], RepositoryId: 0, Iid: 2, DueDate: blockTime - 86400},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetIssueDueDate(goCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	issue, _ := k.GetRepositoryIssue(ctx, 0, 1)
+	require.Equal(t, blockTime+86400, issue.DueDate)
+	require.Equal(t, uint64(1), issue.CommentsCount)
+	comment, _ := k.GetIssueComment(ctx, 0, 1, 1)
+	require.Equal(t, "@A set the due date to 0001-01-02", comment.Body)
+	require.Equal(t, types.CommentTypeDueDateChanged, comment.CommentType)
+
+	paginate := func(option *types.IssueOptions) (iids []uint64) {
+		var issues []*types.Issue
+		for _, issue := range k.GetAllRepositoryIssue(ctx, 0) {
+			issue := issue
+			issues = append(issues, &issue)
+		}
+		_, err := keeper.PaginateAllRepositoryIssue(*k, ctx, issues, nil, option, func(issue types.Issue) error {
+			iids = append(iids, issue.Iid)
+			return nil
+		})
+		require.NoError(t, err)
+		return iids
+	}
+
+	require.Equal(t, []uint64{3, 2, 1}, paginate(&types.IssueOptions{SortBy: "WEIGHT"}))
+	require.Equal(t, []uint64{1, 2, 3}, paginate(&types.IssueOptions{SortBy: "DUE_DATE"}))
+	require.Equal(t, []uint64{2, 1, 3}, paginate(&types.IssueOptions{SortBy: "DUE_DATE", Sort: "ASC"}))
+	require.Equal(t, []uint64{2}, paginate(&types.IssueOptions{Overdue: true}))
+}
+
 func setupPreIssue(ctx context.Context, t *testing.T, srv types.MsgServer) (users []string, repositoryId types.RepositoryId) {
 	users = append(users, "A", "B")
 	repositoryId = types.RepositoryId{
@@ -446,3 +1093,14 @@ func setupPreIssue(ctx context.Context, t *testing.T, srv types.MsgServer) (user
 
 	return users, repositoryId
 }
+
+// setupPreIssueArchivedRepository creates an archived repository of creator with one issue
+func setupPreIssueArchivedRepository(ctx context.Context, t *testing.T, srv types.MsgServer, creator string) {
+	repositoryId := types.RepositoryId{Id: creator, Name: "archived"}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: creator, Name: repositoryId.Name, Owner: creator})
+	require.NoError(t, err)
+	_, err = srv.CreateIssue(ctx, &types.MsgCreateIssue{Creator: creator, RepositoryId: repositoryId})
+	require.NoError(t, err)
+	_, err = srv.ToggleRepositoryArchived(ctx, &types.MsgToggleRepositoryArchived{Creator: creator, RepositoryId: repositoryId})
+	require.NoError(t, err)
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) TransferIssue(goCtx context.Context, msg *types.MsgTransferIssue) (*types.MsgTransferIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, msg.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
+	}
+
+	targetRepository, found := k.GetRepositoryById(ctx, msg.TargetRepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.TargetRepositoryId))
+	}
+
+	for _, r := range []types.Repository{repository, targetRepository} {
+		if r.Archived {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", r.Id))
+		}
+
+		if !k.HavePermission(ctx, msg.Creator, r, types.TransferIssuePermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+		}
+	}
+
+	for _, pullRequestIid := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
+		if !found {
+			continue
+		}
+		if pullRequest.State == types.PullRequest_OPEN {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't transfer issue having OPEN linked pull request")
+		}
+	}
+
+	var bounties []types.Bounty
+	for _, bountyId := range issue.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State == types.BountyStateDISPUTED {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't transfer issue having disputed bounty (%v)", bounty.Id))
+		}
+		bounties = append(bounties, bounty)
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	reference := k.issueReference(ctx, targetRepository.Id, issue)
+
+	targetRepository.IssuesCount += 1
+	iid := targetRepository.IssuesCount
+	targetReference := fmt.Sprintf("%v/%v#%v", targetRepository.Owner.Id, targetRepository.Name, iid)
+
+	// Pull requests can only reference issues of their own repository
+	for _, pullRequestIid := range issue.PullRequests {
+		pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequestIid.Iid)
+		if !found {
+			continue
+		}
+		if i, exists := utils.IssueIidExists(pullRequest.Issues, issue.Iid); exists {
+			pullRequest.Issues = append(pullRequest.Issues[:i], pullRequest.Issues[i+1:]...)
+			k.appendPullRequestSystemComment(ctx, &pullRequest, utils.TransferIssueUnlinkCommentBody(msg.Creator, issue.Iid, targetReference), types.CommentTypeNone)
+			k.SetPullRequest(ctx, pullRequest)
+		}
+	}
+	issue.PullRequests = nil
+
+	// Labels are kept when the target repository has a label of the same name
+	var labels []uint64
+	for _, labelId := range issue.Labels {
+		i, exists := utils.RepositoryLabelIdExists(repository.Labels, labelId)
+		if !exists {
+			continue
+		}
+		if j, exists := utils.RepositoryLabelExists(targetRepository.Labels, repository.Labels[i].Name); exists {
+			labels = append(labels, targetRepository.Labels[j].Id)
+		}
+	}
+	issue.Labels = labels
+
+	// Milestones belong to the source repository
+	issue.Milestone = 0
+
+	for _, comment := range k.GetAllIssueComment(ctx, repository.Id, issue.Iid) {
+		k.RemoveIssueComment(ctx, repository.Id, issue.Iid, comment.CommentIid)
+		k.moveIssueEditHistory(ctx, repository.Id, issue.Iid, targetRepository.Id, iid, comment.CommentIid)
+
+		comment.RepositoryId = targetRepository.Id
+		comment.ParentIid = iid
+		k.SetComment(ctx, comment)
+	}
+	k.moveIssueEditHistory(ctx, repository.Id, issue.Iid, targetRepository.Id, iid, 0)
+
+	for _, bounty := range bounties {
+		bounty.RepositoryId = targetRepository.Id
+		bounty.ParentIid = iid
+		bounty.UpdatedAt = blockTime
+		k.SetBounty(ctx, bounty)
+	}
+
+	for _, link := range issue.Links {
+		target, found := k.GetRepositoryIssue(ctx, link.RepositoryId, link.Iid)
+		if !found {
+			continue
+		}
+		if i, exists := utils.IssueLinkExists(target.Links, issue.RepositoryId, issue.Iid); exists {
+			target.Links[i].RepositoryId = targetRepository.Id
+			target.Links[i].Iid = iid
+			k.SetIssue(ctx, target)
+		}
+	}
+
+	k.RemoveRepositoryIssue(ctx, repository.Id, issue.Iid)
+	k.SetIssueRedirect(ctx, types.IssueRedirect{
+		RepositoryId:       repository.Id,
+		Iid:                issue.Iid,
+		TargetRepositoryId: targetRepository.Id,
+		TargetIid:          iid,
+		Creator:            msg.Creator,
+		CreatedAt:          blockTime,
+	})
+
+	issue.RepositoryId = targetRepository.Id
+	issue.Iid = iid
+
+	k.appendIssueSystemComment(ctx, &issue, utils.TransferIssueCommentBody(msg.Creator, reference), types.CommentTypeIssueTransferred)
+
+	k.SetIssue(ctx, issue)
+	k.SetRepository(ctx, targetRepository)
+
+	labelsJson, _ := json.Marshal(issue.Labels)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.TransferIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(msg.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(msg.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeTargetIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeLabelsKey, string(labelsJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgTransferIssueResponse{
+		RepositoryId: issue.RepositoryId,
+		Iid:          issue.Iid,
+	}, nil
+}
+
+// moveIssueEditHistory moves the edit history of an issue comment, or of the
+// issue description when commentIid is 0, to the transferred issue
+func (k msgServer) moveIssueEditHistory(ctx sdk.Context, repositoryId uint64, iid uint64, targetRepositoryId uint64, targetIid uint64, commentIid uint64) {
+	entries := k.GetEditHistory(ctx, repositoryId, types.CommentParentIssue, iid, commentIid)
+	if len(entries) == 0 {
+		return
+	}
+
+	k.RemoveEditHistory(ctx, repositoryId, types.CommentParentIssue, iid, commentIid)
+	for _, entry := range entries {
+		entry.RepositoryId = targetRepositoryId
+		entry.ParentIid = targetIid
+		k.SetEditHistory(ctx, entry)
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+func (k msgServer) LockPullRequest(goCtx context.Context, msg *types.MsgLockPullRequest) (*types.MsgLockPullRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pullRequest, err := k.getLockablePullRequest(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if pullRequest.Locked {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is already locked", msg.Iid))
+	}
+
+	pullRequest.Locked = true
+	pullRequest.LockReason = msg.Reason
+
+	k.appendPullRequestSystemComment(ctx, &pullRequest, utils.LockConversationCommentBody(msg.Creator, msg.Reason), types.CommentTypeLocked)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.LockPullRequestEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeLockedKey, strconv.FormatBool(pullRequest.Locked)),
+			sdk.NewAttribute(types.EventAttributeLockReasonKey, pullRequest.LockReason.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgLockPullRequestResponse{}, nil
+}
+
+func (k msgServer) UnlockPullRequest(goCtx context.Context, msg *types.MsgUnlockPullRequest) (*types.MsgUnlockPullRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pullRequest, err := k.getLockablePullRequest(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !pullRequest.Locked {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not locked", msg.Iid))
+	}
+
+	pullRequest.Locked = false
+	pullRequest.LockReason = types.LockReasonNone
+
+	k.appendPullRequestSystemComment(ctx, &pullRequest, utils.UnlockConversationCommentBody(msg.Creator), types.CommentTypeUnlocked)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnlockPullRequestEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeLockedKey, strconv.FormatBool(pullRequest.Locked)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUnlockPullRequestResponse{}, nil
+}
+
+func (k msgServer) LockIssue(goCtx context.Context, msg *types.MsgLockIssue) (*types.MsgLockIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, err := k.getLockableIssue(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if issue.Locked {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%d) is already locked", msg.Iid))
+	}
+
+	issue.Locked = true
+	issue.LockReason = msg.Reason
+
+	k.appendIssueSystemComment(ctx, &issue, utils.LockConversationCommentBody(msg.Creator, msg.Reason), types.CommentTypeLocked)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.LockIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeLockedKey, strconv.FormatBool(issue.Locked)),
+			sdk.NewAttribute(types.EventAttributeLockReasonKey, issue.LockReason.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgLockIssueResponse{}, nil
+}
+
+func (k msgServer) UnlockIssue(goCtx context.Context, msg *types.MsgUnlockIssue) (*types.MsgUnlockIssueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	issue, err := k.getLockableIssue(ctx, msg.Creator, msg.RepositoryId, msg.Iid)
+	if err != nil {
+		return nil, err
+	}
+
+	if !issue.Locked {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("issue (%d) is not locked", msg.Iid))
+	}
+
+	issue.Locked = false
+	issue.LockReason = types.LockReasonNone
+
+	k.appendIssueSystemComment(ctx, &issue, utils.UnlockConversationCommentBody(msg.Creator), types.CommentTypeUnlocked)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnlockIssueEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeLockedKey, strconv.FormatBool(issue.Locked)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUnlockIssueResponse{}, nil
+}
+
+// getLockablePullRequest returns the pullRequest whose conversation creator may lock or unlock
+func (k msgServer) getLockablePullRequest(ctx sdk.Context, creator string, repositoryId uint64, iid uint64) (types.PullRequest, error) {
+	repository, err := k.getLockConversationRepository(ctx, creator, repositoryId)
+	if err != nil {
+		return types.PullRequest{}, err
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, repository.Id, iid)
+	if !found {
+		return types.PullRequest{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", iid))
+	}
+
+	return pullRequest, nil
+}
+
+// getLockableIssue returns the issue whose conversation creator may lock or unlock
+func (k msgServer) getLockableIssue(ctx sdk.Context, creator string, repositoryId uint64, iid uint64) (types.Issue, error) {
+	repository, err := k.getLockConversationRepository(ctx, creator, repositoryId)
+	if err != nil {
+		return types.Issue{}, err
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, repository.Id, iid)
+	if !found {
+		return types.Issue{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", iid))
+	}
+
+	return issue, nil
+}
+
+func (k msgServer) getLockConversationRepository(ctx sdk.Context, creator string, repositoryId uint64) (types.Repository, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, repositoryId)
+	if !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", repositoryId))
+	}
+
+	if repository.Archived {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repositoryId))
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.LockConversationPermission) {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	return repository, nil
+}
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
+)
+
+// getMilestoneRepository returns the repository whose milestones creator manages
+func (k msgServer) getMilestoneRepository(ctx sdk.Context, creator string, repositoryId types.RepositoryId) (types.Repository, error) {
+	_, found := k.GetUser(ctx, creator)
+	if !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, repositoryId.Id)
+	if err != nil {
+		return types.Repository{}, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, repositoryId.Name)
+	if !found {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", repositoryId.Id, repositoryId.Name))
+	}
+
+	if repository.Archived {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if !k.HavePermission(ctx, creator, repository, types.RepositoryMilestonePermission) {
+		return types.Repository{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", creator))
+	}
+
+	return repository, nil
+}
+
+func (k msgServer) CreateMilestone(goCtx context.Context, msg *types.MsgCreateMilestone) (*types.MsgCreateMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repository, err := k.getMilestoneRepository(ctx, msg.Creator, msg.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	repository.MilestonesCount += 1
+	milestone := types.Milestone{
+		RepositoryId: repository.Id,
+		Iid:          repository.MilestonesCount,
+		Creator:      msg.Creator,
+		Title:        msg.Title,
+		Description:  msg.Description,
+		DueDate:      msg.DueDate,
+		State:        types.MilestoneStateOpen,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+	}
+
+	k.SetMilestone(ctx, milestone)
+
+	repository.UpdatedAt = blockTime
+	k.SetRepository(ctx, repository)
+
+	milestoneJson, _ := json.Marshal(milestone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.CreateMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMilestoneKey, string(milestoneJson)),
+			sdk.NewAttribute(types.EventAttributeCreatedAtKey, strconv.FormatInt(milestone.CreatedAt, 10)),
+		),
+	)
+
+	return &types.MsgCreateMilestoneResponse{
+		Iid: milestone.Iid,
+	}, nil
+}
+
+func (k msgServer) UpdateMilestone(goCtx context.Context, msg *types.MsgUpdateMilestone) (*types.MsgUpdateMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repository, err := k.getMilestoneRepository(ctx, msg.Creator, msg.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, found := k.GetRepositoryMilestone(ctx, repository.Id, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("milestone (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	milestone.Title = msg.Title
+	milestone.Description = msg.Description
+	milestone.DueDate = msg.DueDate
+	milestone.UpdatedAt = ctx.BlockTime().Unix()
+
+	k.SetMilestone(ctx, milestone)
+
+	milestoneJson, _ := json.Marshal(milestone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UpdateMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMilestoneKey, string(milestoneJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(milestone.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgUpdateMilestoneResponse{}, nil
+}
+
+func (k msgServer) ToggleMilestoneState(goCtx context.Context, msg *types.MsgToggleMilestoneState) (*types.MsgToggleMilestoneStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repository, err := k.getMilestoneRepository(ctx, msg.Creator, msg.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, found := k.GetRepositoryMilestone(ctx, repository.Id, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("milestone (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	milestone.UpdatedAt = ctx.BlockTime().Unix()
+	if milestone.State == types.MilestoneStateOpen {
+		milestone.State = types.MilestoneStateClosed
+		milestone.ClosedAt = milestone.UpdatedAt
+	} else {
+		milestone.State = types.MilestoneStateOpen
+		milestone.ClosedAt = 0
+	}
+
+	k.SetMilestone(ctx, milestone)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ToggleMilestoneStateEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMilestoneIidKey, strconv.FormatUint(milestone.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeMilestoneStateKey, milestone.State.String()),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(milestone.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgToggleMilestoneStateResponse{
+		State: milestone.State,
+	}, nil
+}
+
+func (k msgServer) DeleteMilestone(goCtx context.Context, msg *types.MsgDeleteMilestone) (*types.MsgDeleteMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	repository, err := k.getMilestoneRepository(ctx, msg.Creator, msg.RepositoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone, found := k.GetRepositoryMilestone(ctx, repository.Id, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("milestone (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	// Detach the issues and pullRequests of the milestone
+	commentBody := utils.MilestoneCommentBody(msg.Creator, milestone.Title, "")
+	for _, issue := range k.GetMilestoneIssues(ctx, repository.Id, msg.Iid) {
+		issue.Milestone = 0
+		k.appendIssueSystemComment(ctx, &issue, commentBody, types.CommentTypeMilestoneRemoved)
+		k.SetIssue(ctx, issue)
+	}
+	for _, pullRequest := range k.GetMilestonePullRequests(ctx, repository.Id, msg.Iid) {
+		pullRequest.Milestone = 0
+		k.appendPullRequestSystemComment(ctx, &pullRequest, commentBody, types.CommentTypeMilestoneRemoved)
+		k.SetPullRequest(ctx, pullRequest)
+	}
+
+	k.RemoveRepositoryMilestone(ctx, repository.Id, msg.Iid)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DeleteMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMilestoneIidKey, strconv.FormatUint(msg.Iid, 10)),
+		),
+	)
+
+	return &types.MsgDeleteMilestoneResponse{}, nil
+}
+
+// getMilestoneChange returns the titles of the current and requested milestone
+func (k msgServer) getMilestoneChange(ctx sdk.Context, repositoryId uint64, current uint64, requested uint64) (oldTitle string, newTitle string, err error) {
+	if current == requested {
+		if requested == 0 {
+			return "", "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "milestone is not set")
+		}
+		return "", "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("milestone (%d) is already set", requested))
+	}
+
+	if requested != 0 {
+		milestone, found := k.GetRepositoryMilestone(ctx, repositoryId, requested)
+		if !found {
+			return "", "", sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("milestone (%d) doesn't exist in repository", requested))
+		}
+		newTitle = milestone.Title
+	}
+
+	if current != 0 {
+		if milestone, found := k.GetRepositoryMilestone(ctx, repositoryId, current); found {
+			oldTitle = milestone.Title
+		}
+	}
+
+	return oldTitle, newTitle, nil
+}
+
+func (k msgServer) SetIssueMilestone(goCtx context.Context, msg *types.MsgSetIssueMilestone) (*types.MsgSetIssueMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	issue, found := k.GetRepositoryIssue(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("issue (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, issue.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", issue.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	oldTitle, newTitle, err := k.getMilestoneChange(ctx, repository.Id, issue.Milestone, msg.MilestoneIid)
+	if err != nil {
+		return nil, err
+	}
+
+	commentType := types.CommentTypeMilestoneAdded
+	if msg.MilestoneIid == 0 {
+		commentType = types.CommentTypeMilestoneRemoved
+	}
+
+	issue.Milestone = msg.MilestoneIid
+
+	k.appendIssueSystemComment(ctx, &issue, utils.MilestoneCommentBody(msg.Creator, oldTitle, newTitle), commentType)
+	k.SetIssue(ctx, issue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetIssueMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(issue.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIdKey, strconv.FormatUint(issue.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeIssueIidKey, strconv.FormatUint(issue.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeMilestoneIidKey, strconv.FormatUint(issue.Milestone, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(issue.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetIssueMilestoneResponse{}, nil
+}
+
+func (k msgServer) SetPullRequestMilestone(goCtx context.Context, msg *types.MsgSetPullRequestMilestone) (*types.MsgSetPullRequestMilestoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MilestonePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	oldTitle, newTitle, err := k.getMilestoneChange(ctx, repository.Id, pullRequest.Milestone, msg.MilestoneIid)
+	if err != nil {
+		return nil, err
+	}
+
+	commentType := types.CommentTypeMilestoneAdded
+	if msg.MilestoneIid == 0 {
+		commentType = types.CommentTypeMilestoneRemoved
+	}
+
+	pullRequest.Milestone = msg.MilestoneIid
+
+	k.appendPullRequestSystemComment(ctx, &pullRequest, utils.MilestoneCommentBody(msg.Creator, oldTitle, newTitle), commentType)
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetPullRequestMilestoneEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeMilestoneIidKey, strconv.FormatUint(pullRequest.Milestone, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetPullRequestMilestoneResponse{}, nil
+}
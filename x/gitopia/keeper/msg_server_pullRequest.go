@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,7 +32,8 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("head-repository (%v/%v) doesn't exist", msg.HeadRepositoryId.Id, msg.HeadRepositoryId.Name))
 	}
 
-	if _, found := k.GetRepositoryBranch(ctx, headRepository.Id, msg.HeadBranch); !found {
+	headBranch, found := k.GetRepositoryBranch(ctx, headRepository.Id, msg.HeadBranch)
+	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("head-branch (%v) doesn't exist", msg.HeadBranch))
 	}
 
@@ -45,6 +47,10 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("base-repository (%v/%v) doesn't exist", msg.BaseRepositoryId.Id, msg.BaseRepositoryId.Name))
 	}
 
+	if baseRepository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("base-repository (%v/%v) is archived", msg.BaseRepositoryId.Id, msg.BaseRepositoryId.Name))
+	}
+
 	if _, found := k.GetRepositoryBranch(ctx, baseRepository.Id, msg.BaseBranch); !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("base-branch (%v) doesn't exist", msg.BaseBranch))
 	}
@@ -72,6 +78,7 @@ func (k msgServer) CreatePullRequest(goCtx context.Context, msg *types.MsgCreate
 	head := types.PullRequestHead{
 		RepositoryId: headRepository.Id,
 		Branch:       msg.HeadBranch,
+		CommitSha:    headBranch.Sha,
 	}
 
 	base := types.PullRequestBase{
@@ -274,6 +281,15 @@ func (k msgServer) UpdatePullRequestDescription(goCtx context.Context, msg *type
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	k.AppendEditHistory(ctx, types.EditHistoryEntry{
+		RepositoryId: pullRequest.Base.RepositoryId,
+		Parent:       types.CommentParentPullRequest,
+		ParentIid:    pullRequest.Iid,
+		Editor:       msg.Creator,
+		Body:         pullRequest.Description,
+		EditedAt:     ctx.BlockTime().Unix(),
+	})
+
 	pullRequest.Description = msg.Description
 	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
 	pullRequest.CommentsCount += 1
@@ -335,24 +351,21 @@ func (k msgServer) InvokeMergePullRequest(goCtx context.Context, msg *types.MsgI
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
 
-	id := k.AppendTask(ctx, types.Task{
-		Type:     types.TaskType(types.TypeSetPullRequestState),
-		State:    types.TaskState(types.StatePending),
-		Creator:  msg.Creator,
-		Provider: msg.Provider,
+	if reasons := k.PullRequestMergeBlockers(ctx, baseRepository, pullRequest); len(reasons) > 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("pullRequest (%d) is not mergeable: %v", pullRequest.Iid, strings.Join(reasons, "; ")))
+	}
+
+	mergeStrategy, err := ResolveMergeStrategy(baseRepository, pullRequest.Base.Branch, msg.MergeStrategy)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.AppendMergeTask(ctx, msg.Creator, msg.Provider, pullRequest, types.MergeOptions{
+		MergeStrategy: mergeStrategy,
+		CommitTitle:   msg.CommitTitle,
+		CommitMessage: msg.CommitMessage,
 	})
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(sdk.EventTypeMessage,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(sdk.AttributeKeyAction, types.InvokeMergePullRequestEventKey),
-			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
-			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
-			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
-			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
-			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(id, 10)),
-		),
-	)
 	return &types.MsgInvokeMergePullRequestResponse{}, nil
 }
 
@@ -421,6 +434,7 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 		}
 		pullRequest.ClosedAt = time.Time{}.Unix()
 		pullRequest.ClosedBy = ""
+		pullRequest.CloseReason = types.CloseReasonUnspecified
 	case types.PullRequest_CLOSED.String():
 		if pullRequest.State == types.PullRequest_CLOSED || pullRequest.State == types.PullRequest_MERGED {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't close (%v) pullRequest", pullRequest.State.String()))
@@ -444,6 +458,22 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 
 		pullRequest.ClosedAt = blockTime
 		pullRequest.ClosedBy = msg.Creator
+		pullRequest.CloseReason = msg.CloseReason
+
+		// the merge of another pull request of a linked issue may have been
+		// waiting for this one
+		for _, issueIid := range pullRequest.Issues {
+			issue, found := k.GetRepositoryIssue(ctx, baseRepository.Id, issueIid.Iid)
+			if !found || issue.State != types.Issue_OPEN {
+				continue
+			}
+			if k.hasOtherOpenPullRequest(ctx, baseRepository.Id, issue, pullRequest.Iid) {
+				continue
+			}
+			if merged, found := k.getAssigneeMergedPullRequest(ctx, baseRepository.Id, issue); found {
+				k.closeMergedIssue(ctx, issue, merged.MergedBy, merged.Creator)
+			}
+		}
 	case types.PullRequest_MERGED.String():
 		if pullRequest.State == types.PullRequest_MERGED || pullRequest.State == types.PullRequest_CLOSED {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't merge (%v) pullRequest", pullRequest.State.String()))
@@ -473,6 +503,7 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 		pullRequest.MergedAt = blockTime
 		pullRequest.MergedBy = msg.Creator
 		pullRequest.MergeCommitSha = msg.MergeCommitSha
+		pullRequest.CloseReason = types.CloseReasonMerged
 
 		// Update task state
 		if task.Creator != msg.Creator {
@@ -491,51 +522,14 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			if issue.State != types.Issue_OPEN {
 				continue
 			}
-			if len(issue.Assignees) != 1 || pullRequest.Creator != issue.Assignees[0] { // continue when pull request creator is not the only assignee
+			if _, exists := utils.AssigneeExists(issue.Assignees, pullRequest.Creator); !exists { // continue when pull request creator is not an assignee
 				continue
 			}
-
-			// close issue
-			issue.State = types.Issue_CLOSED
-			issue.ClosedBy = msg.Creator
-			issue.ClosedAt = blockTime
-			issue.UpdatedAt = blockTime
-			k.SetIssue(ctx, issue)
-
-			// reward bounties to pull request creator
-			for _, bountyId := range issue.Bounties {
-				bounty, found := k.GetBounty(ctx, bountyId)
-				if !found {
-					continue
-				}
-				if bounty.State != types.BountyStateSRCDEBITTED {
-					continue
-				}
-				rewardAccAddress, err := sdk.AccAddressFromBech32(pullRequest.Creator)
-				if err != nil {
-					continue
-				}
-
-				if err := k.bankKeeper.IsSendEnabledCoins(ctx, bounty.Amount...); err != nil {
-					continue
-				}
-				if k.bankKeeper.BlockedAddr(rewardAccAddress) {
-					continue
-				}
-				bountyAddress := GetBountyAddress(bounty.Id)
-				if err := k.bankKeeper.SendCoins(
-					ctx, bountyAddress, rewardAccAddress, bounty.Amount,
-				); err != nil {
-					continue
-				}
-
-				bounty.State = types.BountyStateDESTCREDITED
-				bounty.RewardedTo = pullRequest.Creator
-				bounty.ExpireAt = time.Time{}.Unix()
-				bounty.UpdatedAt = blockTime
-
-				k.SetBounty(ctx, bounty)
+			if k.hasOtherOpenPullRequest(ctx, baseRepository.Id, issue, pullRequest.Iid) { // wait for the remaining pull requests of the issue
+				continue
 			}
+
+			k.closeMergedIssue(ctx, issue, msg.Creator, pullRequest.Creator)
 		}
 	default:
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid state (%v)", msg.State))
@@ -544,6 +538,9 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 	pullRequest.State = types.PullRequest_State(types.PullRequest_State_value[msg.State])
 	pullRequest.UpdatedAt = blockTime
 	pullRequest.CommentsCount += 1
+	if pullRequest.State != types.PullRequest_OPEN {
+		pullRequest.AutoMerge = nil
+	}
 
 	var commentType types.CommentType
 	switch pullRequest.State {
@@ -575,6 +572,56 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 		comment,
 	)
 
+	// settle the bounties placed on the pull request
+	for _, bountyId := range pullRequest.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State != types.BountyStateSRCDEBITTED {
+			continue
+		}
+
+		amount := GetBountyRemainingAmount(bounty)
+
+		var body string
+		switch pullRequest.State {
+		case types.PullRequest_MERGED:
+			if err := k.RewardBounty(ctx, bounty, []string{pullRequest.Creator}, []sdk.Coins{amount}); err != nil {
+				continue
+			}
+			body = utils.RewardBountyCommentBody(amount, []string{pullRequest.Creator})
+		case types.PullRequest_CLOSED:
+			if err := k.RefundBounty(ctx, bounty); err != nil {
+				continue
+			}
+
+			bounty.State = types.BountyStateREVERTEDBACK
+			bounty.ExpireAt = time.Time{}.Unix()
+			bounty.UpdatedAt = blockTime
+
+			k.SetBounty(ctx, bounty)
+			body = utils.RefundBountyCommentBody(amount)
+		default:
+			continue
+		}
+
+		pullRequest.CommentsCount += 1
+
+		k.AppendComment(ctx, types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: pullRequest.Base.RepositoryId,
+			ParentIid:    pullRequest.Iid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         body,
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeClosedBounty,
+		})
+	}
+
 	baseRepository.UpdatedAt = blockTime
 	k.SetRepository(ctx, baseRepository)
 	k.SetPullRequest(ctx, pullRequest)
@@ -595,6 +642,7 @@ func (k msgServer) SetPullRequestState(goCtx context.Context, msg *types.MsgSetP
 			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
 			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
 			sdk.NewAttribute(types.EventAttributePullRequestStateKey, msg.State),
+			sdk.NewAttribute(types.EventAttributeCloseReasonKey, pullRequest.CloseReason.String()),
 			sdk.NewAttribute(types.EventAttributePullRequestMergeCommitShaKey, msg.MergeCommitSha),
 			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(msg.TaskId, 10)),
 			sdk.NewAttribute(types.EventAttributeTaskStateKey, task.State.String()),
@@ -1302,6 +1350,241 @@ func (k msgServer) RemovePullRequestLabels(goCtx context.Context, msg *types.Msg
 	return &types.MsgRemovePullRequestLabelsResponse{}, nil
 }
 
+func (k msgServer) SetPullRequestDraft(goCtx context.Context, msg *types.MsgSetPullRequestDraft) (*types.MsgSetPullRequestDraftResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if msg.Creator != pullRequest.Creator {
+		if !k.HavePermission(ctx, msg.Creator, repository, types.PullRequestDraftPermission) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+		}
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't change draft of (%v) pullRequest", pullRequest.State.String()))
+	}
+
+	if pullRequest.Draft == msg.Draft {
+		return &types.MsgSetPullRequestDraftResponse{}, nil
+	}
+
+	pullRequest.Draft = msg.Draft
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+	pullRequest.CommentsCount += 1
+
+	commentType := types.CommentTypeReadyForReview
+	if pullRequest.Draft {
+		commentType = types.CommentTypeConvertedToDraft
+	}
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.SetPullRequestDraftCommentBody(msg.Creator, pullRequest.Draft),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  commentType,
+	})
+
+	if !pullRequest.Draft {
+		k.AutoMergePullRequest(ctx, repository, &pullRequest)
+	}
+
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetPullRequestDraftEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestDraftKey, strconv.FormatBool(pullRequest.Draft)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetPullRequestDraftResponse{}, nil
+}
+
+func (k msgServer) EnableAutoMerge(goCtx context.Context, msg *types.MsgEnableAutoMerge) (*types.MsgEnableAutoMergeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.AutoMergePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't enable auto-merge on (%v) pullRequest", pullRequest.State.String()))
+	}
+
+	if _, err := ResolveMergeStrategy(repository, pullRequest.Base.Branch, msg.MergeStrategy); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	if pullRequest.AutoMerge == nil {
+		pullRequest.CommentsCount += 1
+
+		k.AppendComment(ctx, types.Comment{
+			Creator:      "GITOPIA",
+			RepositoryId: pullRequest.Base.RepositoryId,
+			ParentIid:    pullRequest.Iid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         utils.EnableAutoMergeCommentBody(msg.Creator),
+			System:       true,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeAutoMergeEnabled,
+		})
+	}
+
+	pullRequest.AutoMerge = &types.PullRequestAutoMerge{
+		EnabledBy: msg.Creator,
+		Provider:  msg.Provider,
+		MergeOptions: &types.MergeOptions{
+			MergeStrategy: msg.MergeStrategy,
+			CommitTitle:   msg.CommitTitle,
+			CommitMessage: msg.CommitMessage,
+		},
+		EnabledAt: blockTime,
+	}
+	pullRequest.UpdatedAt = blockTime
+
+	autoMergeJson, _ := json.Marshal(pullRequest.AutoMerge)
+
+	// Merge right away when nothing blocks the pullRequest
+	taskId, _ := k.AutoMergePullRequest(ctx, repository, &pullRequest)
+
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.EnableAutoMergeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeAutoMergeKey, string(autoMergeJson)),
+			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(taskId, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgEnableAutoMergeResponse{
+		TaskId: taskId,
+	}, nil
+}
+
+func (k msgServer) DisableAutoMerge(goCtx context.Context, msg *types.MsgDisableAutoMerge) (*types.MsgDisableAutoMergeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if pullRequest.AutoMerge == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("auto-merge is not enabled on pullRequest (%d)", pullRequest.Iid))
+	}
+
+	if msg.Creator != pullRequest.Creator && msg.Creator != pullRequest.AutoMerge.EnabledBy &&
+		!k.HavePermission(ctx, msg.Creator, repository, types.AutoMergePermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	pullRequest.AutoMerge = nil
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+	pullRequest.CommentsCount += 1
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.DisableAutoMergeCommentBody(msg.Creator),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  types.CommentTypeAutoMergeDisabled,
+	})
+	k.SetPullRequest(ctx, pullRequest)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DisableAutoMergeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgDisableAutoMergeResponse{}, nil
+}
+
 func (k msgServer) DeletePullRequest(goCtx context.Context, msg *types.MsgDeletePullRequest) (*types.MsgDeletePullRequestResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -1319,6 +1602,10 @@ func (k msgServer) DeletePullRequest(goCtx context.Context, msg *types.MsgDelete
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", msg.RepositoryId))
 	}
 
+	if k.HasDisputedBounty(ctx, pullRequest.Bounties) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't delete pull request having DISPUTED bounty")
+	}
+
 	DoRemovePullRequest(ctx, k, pullRequest, repository)
 
 	repository.UpdatedAt = ctx.BlockTime().Unix()
@@ -1339,6 +1626,35 @@ func (k msgServer) DeletePullRequest(goCtx context.Context, msg *types.MsgDelete
 }
 
 func DoRemovePullRequest(ctx sdk.Context, k msgServer, pullRequest types.PullRequest, repository types.Repository) {
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, bountyId := range pullRequest.Bounties {
+		bounty, found := k.GetBounty(ctx, bountyId)
+		if !found {
+			continue
+		}
+		if bounty.State == types.BountyStateDISPUTED {
+			// the parent is going away, hand the escrow back to the funders
+			if err := k.SettleBountyDispute(ctx, bounty, types.BountyDisputeResolutionRefund, 0, "GITOPIA"); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("error settling disputed bounty (%d): %v", bounty.Id, err))
+			}
+			continue
+		}
+		if bounty.State != types.BountyStateSRCDEBITTED {
+			continue
+		}
+		if err := k.RefundBounty(ctx, bounty); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("error refunding bounty (%d): %v", bounty.Id, err))
+			continue
+		}
+
+		bounty.State = types.BountyStateREVERTEDBACK
+		bounty.ExpireAt = time.Time{}.Unix()
+		bounty.UpdatedAt = blockTime
+
+		k.SetBounty(ctx, bounty)
+	}
+
 	comments := k.GetAllPullRequestComment(ctx, repository.Id, pullRequest.Iid)
 	for _, comment := range comments {
 		k.RemovePullRequestComment(ctx, repository.Id, pullRequest.Iid, comment.CommentIid)
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+func (k msgServer) SubmitPullRequestReview(goCtx context.Context, msg *types.MsgSubmitPullRequestReview) (*types.MsgSubmitPullRequestReviewResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	pullRequest, found := k.GetRepositoryPullRequest(ctx, msg.RepositoryId, msg.Iid)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("pullRequest (%d) doesn't exist in repository", msg.Iid))
+	}
+
+	repository, found := k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository id (%d) doesn't exist", pullRequest.Base.RepositoryId))
+	}
+
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository id (%d) is archived", repository.Id))
+	}
+
+	if pullRequest.State != types.PullRequest_OPEN {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("can't review (%v) pullRequest", pullRequest.State.String()))
+	}
+
+	if pullRequest.Locked && !k.HavePermission(ctx, msg.Creator, repository, types.CommentOnLockedConversationPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("pullRequest (%d) is locked", msg.Iid))
+	}
+
+	if msg.Verdict != types.PullRequestReviewVerdictComment && msg.Creator == pullRequest.Creator {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can't approve or request changes on own pullRequest")
+	}
+
+	if msg.Verdict != types.PullRequestReviewVerdictComment && !k.HavePermission(ctx, msg.Creator, repository, types.ReviewPullRequestPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	// Pull requests created before review tracking don't have a head commit yet
+	if pullRequest.Head.CommitSha == "" {
+		headBranch, found := k.GetRepositoryBranch(ctx, pullRequest.Head.RepositoryId, pullRequest.Head.Branch)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("headBranch (%v) doesn't exist", pullRequest.Head.Branch))
+		}
+		pullRequest.Head.CommitSha = headBranch.Sha
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+
+	pullRequest.CommentsCount += 1
+	reviewCommentIid := pullRequest.CommentsCount
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      msg.Creator,
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   reviewCommentIid,
+		Body:         msg.Body,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+		CommentType:  types.CommentTypeReview,
+	})
+
+	for _, c := range msg.Comments {
+		pullRequest.CommentsCount += 1
+
+		k.AppendComment(ctx, types.Comment{
+			Creator:      msg.Creator,
+			RepositoryId: pullRequest.Base.RepositoryId,
+			ParentIid:    pullRequest.Iid,
+			Parent:       types.CommentParentPullRequest,
+			CommentIid:   pullRequest.CommentsCount,
+			Body:         c.Body,
+			DiffHunk:     c.DiffHunk,
+			Path:         c.Path,
+			Position:     c.Position,
+			CreatedAt:    blockTime,
+			UpdatedAt:    blockTime,
+			CommentType:  types.CommentTypeReview,
+		})
+	}
+
+	review := types.PullRequestReview{
+		Reviewer:    msg.Creator,
+		Verdict:     msg.Verdict,
+		Body:        msg.Body,
+		CommitSha:   pullRequest.Head.CommitSha,
+		CommentIid:  reviewCommentIid,
+		SubmittedAt: blockTime,
+	}
+
+	recorded := false
+	for i, r := range pullRequest.Reviews {
+		if r.Reviewer == msg.Creator {
+			// A plain comment doesn't withdraw an earlier approval or change request
+			if msg.Verdict != types.PullRequestReviewVerdictComment || r.Verdict == types.PullRequestReviewVerdictComment {
+				pullRequest.Reviews[i] = review
+			}
+			recorded = true
+			break
+		}
+	}
+	if !recorded {
+		pullRequest.Reviews = append(pullRequest.Reviews, review)
+	}
+
+	pullRequest.UpdatedAt = blockTime
+
+	k.AutoMergePullRequest(ctx, repository, &pullRequest)
+
+	k.SetPullRequest(ctx, pullRequest)
+
+	reviewJson, _ := json.Marshal(review)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SubmitPullRequestReviewEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestReviewKey, string(reviewJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSubmitPullRequestReviewResponse{
+		CommentIid: reviewCommentIid,
+	}, nil
+}
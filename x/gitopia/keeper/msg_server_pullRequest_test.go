@@ -4,9 +4,13 @@ import (
 	"context"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	keepertest "github.com/gitopia/gitopia/testutil/keeper"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
 	"github.com/gitopia/gitopia/x/gitopia/types"
 )
 
@@ -231,6 +235,125 @@ func TestPullRequestMsgServerSetState(t *testing.T) {
 	}
 }
 
+func TestPullRequestMsgServerCloseSettlesMergedIssue(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	owner, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	k.SetUser(ctx, types.User{Creator: owner})
+	repositoryId := k.AppendRepository(ctx, types.Repository{
+		Name:  "repository",
+		Owner: &types.RepositoryOwner{Id: owner, Type: types.OwnerType_USER},
+	})
+
+	bountyId := k.AppendBounty(ctx, types.Bounty{RepositoryId: repositoryId, ParentIid: 1, Parent: types.BountyParentIssue})
+	k.AppendIssue(ctx, types.Issue{
+		RepositoryId: repositoryId,
+		Iid:          1,
+		Assignees:    []string{alice},
+		Bounties:     []uint64{bountyId},
+		PullRequests: []*types.PullRequestIid{{Iid: 1}, {Iid: 2}},
+	})
+	// the merge of alice's pull request waited for bob's
+	k.AppendPullRequest(ctx, types.PullRequest{
+		Iid:      1,
+		Creator:  alice,
+		State:    types.PullRequest_MERGED,
+		MergedBy: owner,
+		Base:     &types.PullRequestBase{RepositoryId: repositoryId},
+		Head:     &types.PullRequestHead{RepositoryId: repositoryId},
+		Issues:   []*types.IssueIid{{Iid: 1}},
+	})
+	k.AppendPullRequest(ctx, types.PullRequest{
+		Iid:     2,
+		Creator: bob,
+		State:   types.PullRequest_OPEN,
+		Base:    &types.PullRequestBase{RepositoryId: repositoryId},
+		Head:    &types.PullRequestHead{RepositoryId: repositoryId},
+		Issues:  []*types.IssueIid{{Iid: 1}},
+	})
+
+	_, err := srv.SetPullRequestState(goCtx, &types.MsgSetPullRequestState{Creator: owner, RepositoryId: repositoryId, Iid: 2, State: "CLOSED"})
+	require.NoError(t, err)
+
+	issue, _ := k.GetRepositoryIssue(ctx, repositoryId, 1)
+	require.Equal(t, types.Issue_CLOSED, issue.State)
+	require.Equal(t, types.CloseReasonMerged, issue.CloseReason)
+	require.Equal(t, owner, issue.ClosedBy)
+
+	bounty, _ := k.GetBounty(ctx, bountyId)
+	require.Equal(t, types.BountyStateDESTCREDITED, bounty.State)
+}
+
+func TestPullRequestMsgServerSubmitReview(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+
+	users, repositoryId, branches := setupPrePullRequest(ctx, t, srv)
+	_, err := srv.CreatePullRequest(ctx, &types.MsgCreatePullRequest{Creator: users[0], HeadRepositoryId: repositoryId, HeadBranch: branches[0], BaseRepositoryId: repositoryId, BaseBranch: branches[1]})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSubmitPullRequestReview
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSubmitPullRequestReview{Creator: "C", RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictComment},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "PullRequest Not Exists",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 2, Verdict: types.PullRequestReviewVerdictComment},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Approve Own PullRequest",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[0], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictApprove},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Approve Unauthorized",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictApprove},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Request Changes Unauthorized",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictRequestChanges},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Comment",
+			request: &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictComment, Body: "body"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.SubmitPullRequestReview(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = srv.UpdateRepositoryCollaborator(ctx, &types.MsgUpdateRepositoryCollaborator{Creator: users[0], RepositoryId: repositoryId, User: users[1], Role: "READ"})
+	require.NoError(t, err)
+	_, err = srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictApprove})
+	require.NoError(t, err)
+
+	// only collaborators can review a locked pullRequest
+	_, err = srv.LockPullRequest(ctx, &types.MsgLockPullRequest{Creator: users[0], RepositoryId: 0, Iid: 1})
+	require.NoError(t, err)
+	_, err = srv.CreateUser(ctx, &types.MsgCreateUser{Creator: "C", Username: "C"})
+	require.NoError(t, err)
+	_, err = srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: "C", RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictComment, Comments: []types.MsgSubmitPullRequestReview_Comment{{Body: "body", Path: "file"}}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SubmitPullRequestReview(ctx, &types.MsgSubmitPullRequestReview{Creator: users[1], RepositoryId: 0, Iid: 1, Verdict: types.PullRequestReviewVerdictComment})
+	require.NoError(t, err)
+}
+
 func TestPullRequestMsgServerAddReviewers(t *testing.T) {
 	srv, ctx := setupMsgServer(t)
 
@@ -31,6 +31,10 @@ func (k msgServer) CreateRelease(goCtx context.Context, msg *types.MsgCreateRele
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
 	}
 
+	if repository.Archived {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is archived", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
 	if !k.HavePermission(ctx, msg.Creator, repository, types.ReleasePermission) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
 	}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -812,6 +813,139 @@ func (k msgServer) ToggleRepositoryForking(goCtx context.Context, msg *types.Msg
 	return &types.MsgToggleRepositoryForkingResponse{AllowForking: repository.AllowForking}, nil
 }
 
+func (k msgServer) ToggleRepositoryArchived(goCtx context.Context, msg *types.MsgToggleRepositoryArchived) (*types.MsgToggleRepositoryArchivedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.ToggleRepositoryArchivedPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	repository.Archived = !repository.Archived
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.ToggleRepositoryArchivedEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoArchivedKey, strconv.FormatBool(repository.Archived)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgToggleRepositoryArchivedResponse{Archived: repository.Archived}, nil
+}
+
+func (k msgServer) SetRepositoryMergeRequirements(goCtx context.Context, msg *types.MsgSetRepositoryMergeRequirements) (*types.MsgSetRepositoryMergeRequirementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MergeRequirementsPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	repository.MergeRequirements = msg.MergeRequirements
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	mergeRequirementsJson, _ := json.Marshal(repository.MergeRequirements)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetRepositoryMergeRequirementsEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMergeRequirementsKey, string(mergeRequirementsJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetRepositoryMergeRequirementsResponse{}, nil
+}
+
+func (k msgServer) SetRepositoryMergeStrategies(goCtx context.Context, msg *types.MsgSetRepositoryMergeStrategies) (*types.MsgSetRepositoryMergeStrategiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if !k.HavePermission(ctx, msg.Creator, repository, types.MergeStrategiesPermission) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("user (%v) doesn't have permission to perform this operation", msg.Creator))
+	}
+
+	for _, rule := range repository.BranchProtectionRules {
+		if !isMergeStrategyAllowed(msg.AllowedMergeStrategies, rule.DefaultMergeStrategy) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("merge strategy (%v) is the default of branch protection rule (%v)", rule.DefaultMergeStrategy.String(), rule.Pattern))
+		}
+	}
+
+	repository.AllowedMergeStrategies = msg.AllowedMergeStrategies
+	repository.UpdatedAt = ctx.BlockTime().Unix()
+	k.SetRepository(ctx, repository)
+
+	mergeStrategiesJson, _ := json.Marshal(repository.AllowedMergeStrategies)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.SetRepositoryMergeStrategiesEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeMergeStrategiesKey, string(mergeStrategiesJson)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(repository.UpdatedAt, 10)),
+		),
+	)
+
+	return &types.MsgSetRepositoryMergeStrategiesResponse{}, nil
+}
+
 func (k msgServer) ToggleArweaveBackup(goCtx context.Context, msg *types.MsgToggleArweaveBackup) (*types.MsgToggleArweaveBackupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -876,6 +1010,97 @@ func (k msgServer) ToggleArweaveBackup(goCtx context.Context, msg *types.MsgTogg
 	return &types.MsgToggleArweaveBackupResponse{EnableArweaveBackup: repository.EnableArweaveBackup}, nil
 }
 
+func (k msgServer) StarRepository(goCtx context.Context, msg *types.MsgStarRepository) (*types.MsgStarRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	user, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	if _, exists := ElementExists(repository.Stargazers, user.Id); exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is already starred", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	repository.Stargazers = append(repository.Stargazers, user.Id)
+	k.SetRepository(ctx, repository)
+
+	if _, exists := ElementExists(user.StarredRepos, repository.Id); !exists {
+		user.StarredRepos = append(user.StarredRepos, repository.Id)
+		k.SetUser(ctx, user)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.StarRepositoryEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeUserIdKey, strconv.FormatUint(user.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoStargazersCountKey, strconv.Itoa(len(repository.Stargazers))),
+		),
+	)
+
+	return &types.MsgStarRepositoryResponse{StargazersCount: uint64(len(repository.Stargazers))}, nil
+}
+
+func (k msgServer) UnstarRepository(goCtx context.Context, msg *types.MsgUnstarRepository) (*types.MsgUnstarRepositoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	user, found := k.GetUser(ctx, msg.Creator)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("creator (%v) doesn't exist", msg.Creator))
+	}
+
+	address, err := k.ResolveAddress(ctx, msg.RepositoryId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, found := k.GetAddressRepository(ctx, address.Address, msg.RepositoryId.Name)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("repository (%v/%v) doesn't exist", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	i, exists := ElementExists(repository.Stargazers, user.Id)
+	if !exists {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("repository (%v/%v) is not starred", msg.RepositoryId.Id, msg.RepositoryId.Name))
+	}
+
+	repository.Stargazers = append(repository.Stargazers[:i], repository.Stargazers[i+1:]...)
+	k.SetRepository(ctx, repository)
+
+	if i, exists := ElementExists(user.StarredRepos, repository.Id); exists {
+		user.StarredRepos = append(user.StarredRepos[:i], user.StarredRepos[i+1:]...)
+		k.SetUser(ctx, user)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.UnstarRepositoryEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, msg.Creator),
+			sdk.NewAttribute(types.EventAttributeUserIdKey, strconv.FormatUint(user.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(repository.Id, 10)),
+			sdk.NewAttribute(types.EventAttributeRepoNameKey, repository.Name),
+			sdk.NewAttribute(types.EventAttributeRepoStargazersCountKey, strconv.Itoa(len(repository.Stargazers))),
+		),
+	)
+
+	return &types.MsgUnstarRepositoryResponse{StargazersCount: uint64(len(repository.Stargazers))}, nil
+}
+
 func (k msgServer) DeleteRepository(goCtx context.Context, msg *types.MsgDeleteRepository) (*types.MsgDeleteRepositoryResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -931,11 +1156,26 @@ func DoRemoveRepository(ctx sdk.Context, k msgServer, repository types.Repositor
 		DoRemovePullRequest(ctx, k, pr, repository)
 	}
 
+	for _, milestone := range k.GetAllRepositoryMilestone(ctx, repository.Id) {
+		k.RemoveRepositoryMilestone(ctx, repository.Id, milestone.Iid)
+	}
+
+	k.RemoveRepositoryIssueRedirects(ctx, repository.Id)
+
 	for _, r := range repository.Releases {
 		release, _ := k.GetRelease(ctx, r.Id)
 		DoRemoveRelease(ctx, k, release, repository)
 	}
 
+	for _, userId := range repository.Stargazers {
+		if user, found := k.GetUserById(ctx, userId); found {
+			if i, exists := ElementExists(user.StarredRepos, repository.Id); exists {
+				user.StarredRepos = append(user.StarredRepos[:i], user.StarredRepos[i+1:]...)
+				k.SetUser(ctx, user)
+			}
+		}
+	}
+
 	k.RemoveAddressRepository(ctx, repository.Owner.Id, repository.Name)
 }
 
@@ -538,6 +538,62 @@ func TestRepositoryMsgToggleRepositoryForking(t *testing.T) {
 	}
 }
 
+func TestRepositoryMsgServerSetMergeStrategies(t *testing.T) {
+	srv, ctx := setupMsgServer(t)
+	users := setupPreRepository(ctx, t, srv)
+	repositoryId := types.RepositoryId{
+		Id:   users[0],
+		Name: "repository",
+	}
+	_, err := srv.CreateRepository(ctx, &types.MsgCreateRepository{Creator: repositoryId.Id, Name: repositoryId.Name, Owner: repositoryId.Id})
+	require.NoError(t, err)
+	_, err = srv.SetBranchProtectionRule(ctx, &types.MsgSetBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "master", DefaultMergeStrategy: types.MergeStrategySquash})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.MsgSetRepositoryMergeStrategies
+		err     error
+	}{
+		{
+			desc:    "Creator Not Exists",
+			request: &types.MsgSetRepositoryMergeStrategies{Creator: "X", RepositoryId: repositoryId},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Repository Not Exists",
+			request: &types.MsgSetRepositoryMergeStrategies{Creator: users[0], RepositoryId: types.RepositoryId{Id: users[0], Name: "name"}},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "Unauthorized",
+			request: &types.MsgSetRepositoryMergeStrategies{Creator: users[1], RepositoryId: repositoryId, AllowedMergeStrategies: []types.MergeStrategy{types.MergeStrategySquash}},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "Branch Protection Rule Default Not Allowed",
+			request: &types.MsgSetRepositoryMergeStrategies{Creator: users[0], RepositoryId: repositoryId, AllowedMergeStrategies: []types.MergeStrategy{types.MergeStrategyRebase}},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "Completed",
+			request: &types.MsgSetRepositoryMergeStrategies{Creator: users[0], RepositoryId: repositoryId, AllowedMergeStrategies: []types.MergeStrategy{types.MergeStrategyRebase, types.MergeStrategySquash}},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.SetRepositoryMergeStrategies(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, err = srv.SetBranchProtectionRule(ctx, &types.MsgSetBranchProtectionRule{Creator: users[0], RepositoryId: repositoryId, Pattern: "release/*", DefaultMergeStrategy: types.MergeStrategyMerge})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
 func TestRepositoryMsgServerDelete(t *testing.T) {
 	srv, ctx := setupMsgServer(t)
 	users := setupPreRepository(ctx, t, srv)
@@ -263,6 +263,17 @@ func DoRemoveUser(ctx sdk.Context, k msgServer, user types.User) {
 		DoRemoveRepository(ctx, k, repository)
 	}
 
+	for _, repositoryId := range user.StarredRepos {
+		if repository, found := k.GetRepositoryById(ctx, repositoryId); found {
+			if i, exists := ElementExists(repository.Stargazers, user.Id); exists {
+				repository.Stargazers = append(repository.Stargazers[:i], repository.Stargazers[i+1:]...)
+				k.SetRepository(ctx, repository)
+			}
+		}
+	}
+
+	k.RemoveFollowReferences(ctx, WhoisAddress{Address: user.Creator, OwnerType: types.OwnerType_USER})
+
 	k.RemoveUser(ctx, user.Creator)
 }
 
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 )
 
 // GetPullRequestCount get the total number of pullRequest
@@ -50,20 +54,28 @@ func (k Keeper) AppendPullRequest(
 	appendedValue := k.cdc.MustMarshal(&pullRequest)
 	store.Set(GetPullRequestIDBytes(pullRequest.Iid), appendedValue)
 
+	k.setPullRequestIndexes(ctx, pullRequest)
+
 	// Update pullRequest count
 	k.SetPullRequestCount(ctx, count+1)
 
 	return count
 }
 
-// SetPullRequest set a specific pullRequest in the store
+// SetPullRequest set a specific pullRequest in the store and keeps its indexes in sync
 func (k Keeper) SetPullRequest(ctx sdk.Context, pullRequest types.PullRequest) {
+	if old, found := k.GetRepositoryPullRequest(ctx, pullRequest.Base.RepositoryId, pullRequest.Iid); found {
+		k.removePullRequestIndexes(ctx, old)
+	}
+
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
 		types.KeyPrefix(types.GetPullRequestKeyForRepositoryId(pullRequest.Base.RepositoryId)),
 	)
 	b := k.cdc.MustMarshal(&pullRequest)
 	store.Set(GetPullRequestIDBytes(pullRequest.Iid), b)
+
+	k.setPullRequestIndexes(ctx, pullRequest)
 }
 
 // GetRepositoryPullRequest returns a pullRequest from its id
@@ -82,6 +94,10 @@ func (k Keeper) GetRepositoryPullRequest(ctx sdk.Context, repositoryId uint64, i
 
 // RemoveRepositoryPullRequest removes a pullRequest from the store
 func (k Keeper) RemoveRepositoryPullRequest(ctx sdk.Context, repositoryId uint64, iid uint64) {
+	if pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, iid); found {
+		k.removePullRequestIndexes(ctx, pullRequest)
+	}
+
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
 		types.KeyPrefix(types.GetPullRequestKeyForRepositoryId(repositoryId)),
@@ -124,6 +140,154 @@ func (k Keeper) GetAllPullRequest(ctx sdk.Context) (list []types.PullRequest) {
 	return
 }
 
+// setPullRequestIndexes indexes the milestone of a pullRequest, the head branch
+// and head commit of an open pullRequest and the merge commit of a merged pullRequest
+func (k Keeper) setPullRequestIndexes(ctx sdk.Context, pullRequest types.PullRequest) {
+	if pullRequest.Milestone != 0 {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetMilestonePullRequestKey(pullRequest.Base.RepositoryId, pullRequest.Milestone)),
+		)
+		store.Set(GetPullRequestIDBytes(pullRequest.Iid), []byte{})
+	}
+	if pullRequest.State == types.PullRequest_OPEN && pullRequest.Head != nil {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestHeadBranchKey(pullRequest.Head.RepositoryId, pullRequest.Head.Branch)),
+		)
+		store.Set(getPullRequestIndexKey(pullRequest.Base.RepositoryId, pullRequest.Iid), []byte{})
+
+		store = prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestHeadCommitKey(pullRequest.Base.RepositoryId, pullRequest.Head.CommitSha)),
+		)
+		store.Set(GetPullRequestIDBytes(pullRequest.Iid), []byte{})
+	}
+	if pullRequest.State == types.PullRequest_MERGED && pullRequest.MergeCommitSha != "" {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestMergeCommitKey(pullRequest.Base.RepositoryId, pullRequest.Base.Branch)),
+		)
+		store.Set([]byte(pullRequest.MergeCommitSha), GetPullRequestIDBytes(pullRequest.Iid))
+	}
+}
+
+// removePullRequestIndexes removes the index entries of a pullRequest
+func (k Keeper) removePullRequestIndexes(ctx sdk.Context, pullRequest types.PullRequest) {
+	if pullRequest.Milestone != 0 {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetMilestonePullRequestKey(pullRequest.Base.RepositoryId, pullRequest.Milestone)),
+		)
+		store.Delete(GetPullRequestIDBytes(pullRequest.Iid))
+	}
+	if pullRequest.State == types.PullRequest_OPEN && pullRequest.Head != nil {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestHeadBranchKey(pullRequest.Head.RepositoryId, pullRequest.Head.Branch)),
+		)
+		store.Delete(getPullRequestIndexKey(pullRequest.Base.RepositoryId, pullRequest.Iid))
+
+		store = prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestHeadCommitKey(pullRequest.Base.RepositoryId, pullRequest.Head.CommitSha)),
+		)
+		store.Delete(GetPullRequestIDBytes(pullRequest.Iid))
+	}
+	if pullRequest.State == types.PullRequest_MERGED && pullRequest.MergeCommitSha != "" {
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			types.KeyPrefix(types.GetPullRequestMergeCommitKey(pullRequest.Base.RepositoryId, pullRequest.Base.Branch)),
+		)
+		store.Delete([]byte(pullRequest.MergeCommitSha))
+	}
+}
+
+// GetOpenHeadBranchPullRequests returns the open pullRequests whose head is
+// the branch of the repository
+func (k Keeper) GetOpenHeadBranchPullRequests(ctx sdk.Context, repositoryId uint64, branch string) (list []types.PullRequest) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestHeadBranchKey(repositoryId, branch)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// longer keys belong to branches sharing the prefix of this one
+		if len(iterator.Key()) != 16 {
+			continue
+		}
+		baseRepositoryId, iid := GetPullRequestIDFromBytes(iterator.Key()[:8]), GetPullRequestIDFromBytes(iterator.Key()[8:])
+		if pullRequest, found := k.GetRepositoryPullRequest(ctx, baseRepositoryId, iid); found {
+			list = append(list, pullRequest)
+		}
+	}
+
+	return
+}
+
+// GetOpenHeadCommitPullRequests returns the open pullRequests of the repository
+// whose head is at the commit sha
+func (k Keeper) GetOpenHeadCommitPullRequests(ctx sdk.Context, repositoryId uint64, sha string) (list []types.PullRequest) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestHeadCommitKey(repositoryId, sha)),
+	)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// longer keys belong to commits sharing the prefix of this one
+		if len(iterator.Key()) != 8 {
+			continue
+		}
+		if pullRequest, found := k.GetRepositoryPullRequest(ctx, repositoryId, GetPullRequestIDFromBytes(iterator.Key())); found {
+			list = append(list, pullRequest)
+		}
+	}
+
+	return
+}
+
+// IsPullRequestMergeCommit reports whether sha is the merge commit of a
+// pullRequest merged into the branch of the repository
+func (k Keeper) IsPullRequestMergeCommit(ctx sdk.Context, repositoryId uint64, branch string, sha string) bool {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.GetPullRequestMergeCommitKey(repositoryId, branch)),
+	)
+	return store.Has([]byte(sha))
+}
+
+// getPullRequestIndexKey returns the index key of a pullRequest from its base repository-id and iid
+func getPullRequestIndexKey(repositoryId uint64, iid uint64) []byte {
+	return append(GetPullRequestIDBytes(repositoryId), GetPullRequestIDBytes(iid)...)
+}
+
+// appendPullRequestSystemComment adds a system comment to the pullRequest
+func (k Keeper) appendPullRequestSystemComment(ctx sdk.Context, pullRequest *types.PullRequest, body string, commentType types.CommentType) {
+	blockTime := ctx.BlockTime().Unix()
+
+	pullRequest.CommentsCount += 1
+	pullRequest.UpdatedAt = blockTime
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         body,
+		System:       true,
+		CreatedAt:    blockTime,
+		UpdatedAt:    blockTime,
+		CommentType:  commentType,
+	})
+}
+
 // GetPullRequestIDBytes returns the byte representation of the ID
 func GetPullRequestIDBytes(id uint64) []byte {
 	bz := make([]byte, 8)
@@ -135,3 +299,312 @@ func GetPullRequestIDBytes(id uint64) []byte {
 func GetPullRequestIDFromBytes(bz []byte) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
+
+// UpdatePullRequestHeads records the new head commit of every open pullRequest
+// whose head is the given branch and marks reviews of older commits as stale.
+// Auto-merge is cancelled when the commits are pushed by a non-maintainer.
+func (k Keeper) UpdatePullRequestHeads(ctx sdk.Context, creator string, repository types.Repository, branchName string, sha string) {
+	for _, pullRequest := range k.GetOpenHeadBranchPullRequests(ctx, repository.Id, branchName) {
+		if pullRequest.Head.CommitSha == sha {
+			continue
+		}
+
+		baseRepository := repository
+		if pullRequest.Base.RepositoryId != repository.Id {
+			var found bool
+			baseRepository, found = k.GetRepositoryById(ctx, pullRequest.Base.RepositoryId)
+			if !found {
+				continue
+			}
+		}
+
+		pullRequest.Head.CommitSha = sha
+		for i := range pullRequest.Reviews {
+			if pullRequest.Reviews[i].CommitSha != sha {
+				pullRequest.Reviews[i].Stale = true
+			}
+		}
+
+		if pullRequest.AutoMerge != nil && !k.HavePermission(ctx, creator, baseRepository, types.KeepAutoMergeOnPushPermission) {
+			k.cancelAutoMerge(ctx, &pullRequest, creator)
+		} else {
+			k.AutoMergePullRequest(ctx, baseRepository, &pullRequest)
+		}
+
+		k.SetPullRequest(ctx, pullRequest)
+	}
+}
+
+// AutoMergeCommitPullRequests triggers auto-merge of the open pullRequests of the
+// repository whose head is at the commit sha. Only statuses of the base repository
+// gate the merge, so statuses set on a fork never trigger it.
+func (k Keeper) AutoMergeCommitPullRequests(ctx sdk.Context, repository types.Repository, sha string) {
+	for _, pullRequest := range k.GetOpenHeadCommitPullRequests(ctx, repository.Id, sha) {
+		if pullRequest.AutoMerge == nil {
+			continue
+		}
+
+		if _, merging := k.AutoMergePullRequest(ctx, repository, &pullRequest); merging {
+			k.SetPullRequest(ctx, pullRequest)
+		}
+	}
+}
+
+// AutoMergePullRequest creates the merge task of the pullRequest when auto-merge is
+// enabled and nothing blocks the merge anymore. Auto-merge is cleared once the task
+// is created, the caller is responsible for storing the pullRequest.
+func (k Keeper) AutoMergePullRequest(ctx sdk.Context, repository types.Repository, pullRequest *types.PullRequest) (taskId uint64, merging bool) {
+	autoMerge := pullRequest.AutoMerge
+	if autoMerge == nil {
+		return 0, false
+	}
+
+	if len(k.PullRequestMergeBlockers(ctx, repository, *pullRequest)) > 0 {
+		return 0, false
+	}
+
+	// Wait for someone allowed to merge to enable auto-merge again
+	if !k.HavePermission(ctx, autoMerge.EnabledBy, repository, types.AutoMergePermission) {
+		return 0, false
+	}
+
+	var mergeOptions types.MergeOptions
+	if autoMerge.MergeOptions != nil {
+		mergeOptions = *autoMerge.MergeOptions
+	}
+
+	mergeStrategy, err := ResolveMergeStrategy(repository, pullRequest.Base.Branch, mergeOptions.MergeStrategy)
+	if err != nil {
+		return 0, false
+	}
+	mergeOptions.MergeStrategy = mergeStrategy
+
+	taskId = k.AppendMergeTask(ctx, autoMerge.EnabledBy, autoMerge.Provider, *pullRequest, mergeOptions)
+	pullRequest.AutoMerge = nil
+
+	return taskId, true
+}
+
+// cancelAutoMerge clears auto-merge of the pullRequest and records it with a system
+// comment, the caller is responsible for storing the pullRequest
+func (k Keeper) cancelAutoMerge(ctx sdk.Context, pullRequest *types.PullRequest, pusher string) {
+	pullRequest.AutoMerge = nil
+	pullRequest.UpdatedAt = ctx.BlockTime().Unix()
+	pullRequest.CommentsCount += 1
+
+	k.AppendComment(ctx, types.Comment{
+		Creator:      "GITOPIA",
+		RepositoryId: pullRequest.Base.RepositoryId,
+		ParentIid:    pullRequest.Iid,
+		Parent:       types.CommentParentPullRequest,
+		CommentIid:   pullRequest.CommentsCount,
+		Body:         utils.CancelAutoMergeCommentBody(pusher),
+		System:       true,
+		CreatedAt:    pullRequest.UpdatedAt,
+		UpdatedAt:    pullRequest.UpdatedAt,
+		CommentType:  types.CommentTypeAutoMergeDisabled,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.DisableAutoMergeEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, pusher),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeUpdatedAtKey, strconv.FormatInt(pullRequest.UpdatedAt, 10)),
+		),
+	)
+}
+
+// AppendMergeTask creates the task asking the git server to merge the pullRequest
+// on behalf of creator and emits the event it listens to
+func (k Keeper) AppendMergeTask(ctx sdk.Context, creator string, provider string, pullRequest types.PullRequest, mergeOptions types.MergeOptions) uint64 {
+	id := k.AppendTask(ctx, types.Task{
+		Type:         types.TaskType(types.TypeSetPullRequestState),
+		State:        types.TaskState(types.StatePending),
+		Creator:      creator,
+		Provider:     provider,
+		MergeOptions: &mergeOptions,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.InvokeMergePullRequestEventKey),
+			sdk.NewAttribute(types.EventAttributeCreatorKey, creator),
+			sdk.NewAttribute(types.EventAttributeRepoIdKey, strconv.FormatUint(pullRequest.Base.RepositoryId, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIdKey, strconv.FormatUint(pullRequest.Id, 10)),
+			sdk.NewAttribute(types.EventAttributePullRequestIidKey, strconv.FormatUint(pullRequest.Iid, 10)),
+			sdk.NewAttribute(types.EventAttributeTaskIdKey, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.EventAttributeMergeStrategyKey, mergeOptions.MergeStrategy.String()),
+			sdk.NewAttribute(types.EventAttributeMergeCommitTitleKey, mergeOptions.CommitTitle),
+			sdk.NewAttribute(types.EventAttributeMergeCommitMessageKey, mergeOptions.CommitMessage),
+		),
+	)
+
+	return id
+}
+
+// GetPullRequestReviewSummary returns the current review state of a pullRequest.
+// Stale approvals are not counted while change requests stand until the
+// reviewer submits a new review.
+func GetPullRequestReviewSummary(pullRequest types.PullRequest) types.PullRequestReviewSummary {
+	summary := types.PullRequestReviewSummary{
+		HeadCommitSha: pullRequest.Head.CommitSha,
+		Reviews:       pullRequest.Reviews,
+	}
+
+	reviewed := make(map[string]bool, len(pullRequest.Reviews))
+	for _, review := range pullRequest.Reviews {
+		switch review.Verdict {
+		case types.PullRequestReviewVerdictApprove:
+			if !review.Stale {
+				summary.ApprovedBy = append(summary.ApprovedBy, review.Reviewer)
+			}
+		case types.PullRequestReviewVerdictRequestChanges:
+			summary.ChangesRequestedBy = append(summary.ChangesRequestedBy, review.Reviewer)
+		}
+		if !review.Stale {
+			reviewed[review.Reviewer] = true
+		}
+	}
+
+	for _, reviewer := range pullRequest.Reviewers {
+		if !reviewed[reviewer] {
+			summary.PendingReviewers = append(summary.PendingReviewers, reviewer)
+		}
+	}
+
+	return summary
+}
+
+// GetMergeRequirements returns the requirements for merging into the branch by
+// combining the repository requirements with those of the matching branch protection rules
+func GetMergeRequirements(repository types.Repository, branchName string) (requirements types.MergeRequirements) {
+	all := []*types.MergeRequirements{repository.MergeRequirements}
+	for _, rule := range GetBranchProtectionRules(repository, branchName) {
+		all = append(all, rule.MergeRequirements)
+	}
+
+	for _, r := range all {
+		if r == nil {
+			continue
+		}
+		if r.RequiredApprovals > requirements.RequiredApprovals {
+			requirements.RequiredApprovals = r.RequiredApprovals
+		}
+		requirements.BlockOnChangesRequested = requirements.BlockOnChangesRequested || r.BlockOnChangesRequested
+		for _, reviewer := range r.RequiredReviewers {
+			if _, exists := utils.ReviewerExists(requirements.RequiredReviewers, reviewer); !exists {
+				requirements.RequiredReviewers = append(requirements.RequiredReviewers, reviewer)
+			}
+		}
+		for _, check := range r.RequiredChecks {
+			if _, exists := utils.AssigneeExists(requirements.RequiredChecks, check); !exists {
+				requirements.RequiredChecks = append(requirements.RequiredChecks, check)
+			}
+		}
+	}
+
+	return requirements
+}
+
+// ResolveMergeStrategy returns the strategy to merge into the branch with. When none
+// is requested the default of the first matching branch protection rule is used,
+// falling back to the first strategy allowed by the repository.
+func ResolveMergeStrategy(repository types.Repository, branchName string, requested types.MergeStrategy) (types.MergeStrategy, error) {
+	strategy := requested
+	if strategy == types.MergeStrategyUnspecified {
+		for _, rule := range GetBranchProtectionRules(repository, branchName) {
+			if rule.DefaultMergeStrategy != types.MergeStrategyUnspecified {
+				strategy = rule.DefaultMergeStrategy
+				break
+			}
+		}
+	}
+
+	// Repositories without allowed strategies accept all of them
+	if len(repository.AllowedMergeStrategies) == 0 {
+		if strategy == types.MergeStrategyUnspecified {
+			strategy = types.MergeStrategyMerge
+		}
+		return strategy, nil
+	}
+
+	if strategy == types.MergeStrategyUnspecified {
+		return repository.AllowedMergeStrategies[0], nil
+	}
+
+	if isMergeStrategyAllowed(repository.AllowedMergeStrategies, strategy) {
+		return strategy, nil
+	}
+
+	return strategy, fmt.Errorf("merge strategy (%v) is not allowed in repository", strategy.String())
+}
+
+// isMergeStrategyAllowed reports whether the repository accepts merging with strategy
+func isMergeStrategyAllowed(allowedMergeStrategies []types.MergeStrategy, strategy types.MergeStrategy) bool {
+	if len(allowedMergeStrategies) == 0 || strategy == types.MergeStrategyUnspecified {
+		return true
+	}
+	for _, allowed := range allowedMergeStrategies {
+		if allowed == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// PullRequestMergeBlockers returns the reasons why the pullRequest can't be merged,
+// an empty list means the pullRequest is mergeable
+func (k Keeper) PullRequestMergeBlockers(ctx sdk.Context, repository types.Repository, pullRequest types.PullRequest) (reasons []string) {
+	if pullRequest.State != types.PullRequest_OPEN {
+		reasons = append(reasons, fmt.Sprintf("pullRequest is %v", strings.ToLower(pullRequest.State.String())))
+	}
+
+	if pullRequest.Draft {
+		reasons = append(reasons, "pullRequest is a draft")
+	}
+
+	requirements := GetMergeRequirements(repository, pullRequest.Base.Branch)
+	summary := GetPullRequestReviewSummary(pullRequest)
+
+	// Only approvals of reviewers allowed to merge count towards the required approvals
+	approvals := 0
+	for _, reviewer := range summary.ApprovedBy {
+		if k.HavePermission(ctx, reviewer, repository, types.PullRequestMergePermission) {
+			approvals += 1
+		}
+	}
+	if uint64(approvals) < requirements.RequiredApprovals {
+		reasons = append(reasons, fmt.Sprintf("requires %d approvals, has %d", requirements.RequiredApprovals, approvals))
+	}
+
+	if requirements.BlockOnChangesRequested && len(summary.ChangesRequestedBy) > 0 {
+		reasons = append(reasons, fmt.Sprintf("changes requested by %v", strings.Join(summary.ChangesRequestedBy, ", ")))
+	}
+
+	for _, reviewer := range requirements.RequiredReviewers {
+		if _, exists := utils.ReviewerExists(summary.ApprovedBy, reviewer); !exists {
+			reasons = append(reasons, fmt.Sprintf("approval required from %v", reviewer))
+		}
+	}
+
+	if len(requirements.RequiredChecks) > 0 {
+		states := make(map[string]types.CommitStatusState)
+		for _, commitStatus := range k.GetPullRequestCommitStatuses(ctx, pullRequest) {
+			states[commitStatus.Context] = commitStatus.State
+		}
+		for _, check := range requirements.RequiredChecks {
+			if state, found := states[check]; !found {
+				reasons = append(reasons, fmt.Sprintf("required check (%v) has not reported", check))
+			} else if state != types.CommitStatusStateSuccess {
+				reasons = append(reasons, fmt.Sprintf("required check (%v) is %v", check, commitStatusStateName(state)))
+			}
+		}
+	}
+
+	return reasons
+}
@@ -54,3 +54,269 @@ func TestPullRequestCount(t *testing.T) {
 	count := uint64(len(items))
 	require.Equal(t, count, keeper.GetPullRequestCount(ctx))
 }
+
+func TestUpdatePullRequestHeads(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	repository := types.Repository{Id: 0}
+	pullRequest := types.PullRequest{
+		Iid:       1,
+		State:     types.PullRequest_OPEN,
+		Reviewers: []string{"reviewer1", "reviewer2", "reviewer3"},
+		Head:      &types.PullRequestHead{RepositoryId: repository.Id, Branch: "feature", CommitSha: "sha1"},
+		Base:      &types.PullRequestBase{RepositoryId: repository.Id, Branch: "master"},
+		Reviews: []types.PullRequestReview{
+			{Reviewer: "reviewer1", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha1"},
+			{Reviewer: "reviewer2", Verdict: types.PullRequestReviewVerdictRequestChanges, CommitSha: "sha1"},
+		},
+	}
+	k.AppendPullRequest(ctx, pullRequest)
+	k.AppendPullRequest(ctx, types.PullRequest{
+		Iid:   2,
+		State: types.PullRequest_OPEN,
+		Head:  &types.PullRequestHead{RepositoryId: repository.Id, Branch: "feature-x", CommitSha: "sha1"},
+		Base:  &types.PullRequestBase{RepositoryId: repository.Id, Branch: "master"},
+	})
+
+	summary := keeper.GetPullRequestReviewSummary(pullRequest)
+	require.Equal(t, []string{"reviewer1"}, summary.ApprovedBy)
+	require.Equal(t, []string{"reviewer2"}, summary.ChangesRequestedBy)
+	require.Equal(t, []string{"reviewer3"}, summary.PendingReviewers)
+
+	// Pushing another branch leaves the pull request untouched
+	k.UpdatePullRequestHeads(ctx, "owner", repository, "other", "sha2")
+	got, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.True(t, found)
+	require.Equal(t, "sha1", got.Head.CommitSha)
+
+	k.UpdatePullRequestHeads(ctx, "owner", repository, "feature", "sha2")
+	got, found = k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.True(t, found)
+	require.Equal(t, "sha2", got.Head.CommitSha)
+	other, _ := k.GetRepositoryPullRequest(ctx, repository.Id, 2)
+	require.Equal(t, "sha1", other.Head.CommitSha)
+	for _, review := range got.Reviews {
+		require.True(t, review.Stale)
+	}
+
+	summary = keeper.GetPullRequestReviewSummary(got)
+	require.Empty(t, summary.ApprovedBy)
+	require.Equal(t, []string{"reviewer2"}, summary.ChangesRequestedBy)
+	require.Equal(t, []string{"reviewer1", "reviewer2", "reviewer3"}, summary.PendingReviewers)
+}
+
+func TestPullRequestMergeBlockers(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	repository := types.Repository{
+		Owner: &types.RepositoryOwner{Id: "owner", Type: types.OwnerType_USER},
+		Collaborators: []*types.RepositoryCollaborator{
+			{Id: "reviewer1", Permission: types.RepositoryCollaborator_WRITE},
+			{Id: "reviewer2", Permission: types.RepositoryCollaborator_WRITE},
+			{Id: "triager", Permission: types.RepositoryCollaborator_TRIAGE},
+		},
+		MergeRequirements: &types.MergeRequirements{
+			RequiredApprovals: 1,
+		},
+		BranchProtectionRules: []*types.BranchProtectionRule{
+			{
+				Pattern: "master",
+				MergeRequirements: &types.MergeRequirements{
+					RequiredApprovals:       2,
+					BlockOnChangesRequested: true,
+					RequiredReviewers:       []string{"owner"},
+				},
+			},
+		},
+	}
+	pullRequest := types.PullRequest{
+		State: types.PullRequest_OPEN,
+		Head:  &types.PullRequestHead{Branch: "feature", CommitSha: "sha2"},
+		Base:  &types.PullRequestBase{Branch: "master"},
+		Reviews: []types.PullRequestReview{
+			{Reviewer: "reviewer1", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"},
+			{Reviewer: "owner", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha1", Stale: true},
+			{Reviewer: "reviewer2", Verdict: types.PullRequestReviewVerdictRequestChanges, CommitSha: "sha2"},
+		},
+	}
+
+	requirements := keeper.GetMergeRequirements(repository, "master")
+	require.Equal(t, uint64(2), requirements.RequiredApprovals)
+	require.True(t, requirements.BlockOnChangesRequested)
+	require.Equal(t, []string{"owner"}, requirements.RequiredReviewers)
+
+	require.Len(t, k.PullRequestMergeBlockers(ctx, repository, pullRequest), 3)
+
+	pullRequest.Reviews[1] = types.PullRequestReview{Reviewer: "owner", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"}
+	pullRequest.Reviews[2] = types.PullRequestReview{Reviewer: "reviewer2", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"}
+	require.Empty(t, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	// only the repository requirements apply to other branches
+	pullRequest.Base.Branch = "develop"
+	pullRequest.Reviews = pullRequest.Reviews[:1]
+	require.Empty(t, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	// approvals of reviewers who can't merge don't count
+	pullRequest.Reviews[0] = types.PullRequestReview{Reviewer: "outsider", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"}
+	require.Equal(t, []string{"requires 1 approvals, has 0"}, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+	pullRequest.Reviews[0] = types.PullRequestReview{Reviewer: "triager", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"}
+	require.Equal(t, []string{"requires 1 approvals, has 0"}, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+	pullRequest.Reviews[0] = types.PullRequestReview{Reviewer: "reviewer1", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"}
+
+	repository.MergeRequirements.RequiredChecks = []string{"ci/build"}
+	require.Equal(t, []string{"required check (ci/build) has not reported"}, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: repository.Id, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStateFailure})
+	require.Equal(t, []string{"required check (ci/build) is failure"}, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: repository.Id, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStateSuccess})
+	require.Empty(t, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	pullRequest.Draft = true
+	require.Equal(t, []string{"pullRequest is a draft"}, k.PullRequestMergeBlockers(ctx, repository, pullRequest))
+
+	pullRequest.Draft = false
+	pullRequest.State = types.PullRequest_MERGED
+	require.Len(t, k.PullRequestMergeBlockers(ctx, repository, pullRequest), 1)
+}
+
+func TestResolveMergeStrategy(t *testing.T) {
+	repository := types.Repository{
+		BranchProtectionRules: []*types.BranchProtectionRule{
+			{
+				Pattern:              "release/*",
+				DefaultMergeStrategy: types.MergeStrategySquash,
+			},
+		},
+	}
+
+	// all strategies are allowed by default
+	strategy, err := keeper.ResolveMergeStrategy(repository, "master", types.MergeStrategyUnspecified)
+	require.NoError(t, err)
+	require.Equal(t, types.MergeStrategyMerge, strategy)
+
+	strategy, err = keeper.ResolveMergeStrategy(repository, "master", types.MergeStrategyRebase)
+	require.NoError(t, err)
+	require.Equal(t, types.MergeStrategyRebase, strategy)
+
+	strategy, err = keeper.ResolveMergeStrategy(repository, "release/v1", types.MergeStrategyUnspecified)
+	require.NoError(t, err)
+	require.Equal(t, types.MergeStrategySquash, strategy)
+
+	repository.AllowedMergeStrategies = []types.MergeStrategy{types.MergeStrategyRebase, types.MergeStrategySquash}
+
+	strategy, err = keeper.ResolveMergeStrategy(repository, "master", types.MergeStrategyUnspecified)
+	require.NoError(t, err)
+	require.Equal(t, types.MergeStrategyRebase, strategy)
+
+	_, err = keeper.ResolveMergeStrategy(repository, "master", types.MergeStrategyMerge)
+	require.Error(t, err)
+
+	strategy, err = keeper.ResolveMergeStrategy(repository, "release/v1", types.MergeStrategyUnspecified)
+	require.NoError(t, err)
+	require.Equal(t, types.MergeStrategySquash, strategy)
+
+	repository.AllowedMergeStrategies = []types.MergeStrategy{types.MergeStrategyRebase}
+	_, err = keeper.ResolveMergeStrategy(repository, "release/v1", types.MergeStrategyUnspecified)
+	require.Error(t, err)
+}
+
+func TestAutoMergePullRequest(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	repository := types.Repository{
+		Id:    0,
+		Owner: &types.RepositoryOwner{Id: "owner", Type: types.OwnerType_USER},
+		Collaborators: []*types.RepositoryCollaborator{
+			{Id: "maintainer", Permission: types.RepositoryCollaborator_MAINTAIN},
+		},
+		MergeRequirements: &types.MergeRequirements{
+			RequiredApprovals: 1,
+			RequiredChecks:    []string{"ci/build"},
+		},
+	}
+	pullRequest := types.PullRequest{
+		Iid:   1,
+		State: types.PullRequest_OPEN,
+		Head:  &types.PullRequestHead{RepositoryId: repository.Id, Branch: "feature", CommitSha: "sha1"},
+		Base:  &types.PullRequestBase{RepositoryId: repository.Id, Branch: "master"},
+		AutoMerge: &types.PullRequestAutoMerge{
+			EnabledBy: "owner",
+			Provider:  "provider",
+			MergeOptions: &types.MergeOptions{
+				CommitTitle: "title",
+			},
+		},
+	}
+	k.AppendPullRequest(ctx, pullRequest)
+
+	_, merging := k.AutoMergePullRequest(ctx, repository, &pullRequest)
+	require.False(t, merging)
+
+	// A maintainer pushing keeps auto-merge enabled
+	k.UpdatePullRequestHeads(ctx, "maintainer", repository, "feature", "sha2")
+	pullRequest, _ = k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.Equal(t, "sha2", pullRequest.Head.CommitSha)
+	require.NotNil(t, pullRequest.AutoMerge)
+
+	pullRequest.Reviews = []types.PullRequestReview{
+		{Reviewer: "maintainer", Verdict: types.PullRequestReviewVerdictApprove, CommitSha: "sha2"},
+	}
+	k.SetPullRequest(ctx, pullRequest)
+
+	// The required check still blocks the merge
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: repository.Id, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStatePending})
+	k.AutoMergeCommitPullRequests(ctx, repository, "sha2")
+	pullRequest, _ = k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.NotNil(t, pullRequest.AutoMerge)
+
+	// Statuses of the head repository don't gate the merge
+	fork := types.Repository{Id: 1, Owner: repository.Owner, Fork: true, Parent: repository.Id}
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: fork.Id, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStateSuccess})
+	k.AutoMergeCommitPullRequests(ctx, fork, "sha2")
+	pullRequest, _ = k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.NotNil(t, pullRequest.AutoMerge)
+
+	require.Empty(t, k.GetOpenHeadCommitPullRequests(ctx, repository.Id, "sha1"))
+	require.Len(t, k.GetOpenHeadCommitPullRequests(ctx, repository.Id, "sha2"), 1)
+
+	k.SetRepositoryCommitStatus(ctx, types.CommitStatus{RepositoryId: repository.Id, Sha: "sha2", Context: "ci/build", State: types.CommitStatusStateSuccess})
+	k.AutoMergeCommitPullRequests(ctx, repository, "sha2")
+	pullRequest, _ = k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.Nil(t, pullRequest.AutoMerge)
+
+	tasks := k.GetAllTask(ctx)
+	require.Len(t, tasks, 1)
+	require.Equal(t, types.TypeSetPullRequestState, tasks[0].Type)
+	require.Equal(t, "owner", tasks[0].Creator)
+	require.Equal(t, "provider", tasks[0].Provider)
+	require.Equal(t, types.MergeStrategyMerge, tasks[0].MergeOptions.MergeStrategy)
+	require.Equal(t, "title", tasks[0].MergeOptions.CommitTitle)
+}
+
+func TestUpdatePullRequestHeadsCancelsAutoMerge(t *testing.T) {
+	k, ctx := keepertest.GitopiaKeeper(t)
+	repository := types.Repository{
+		Id:    0,
+		Owner: &types.RepositoryOwner{Id: "owner", Type: types.OwnerType_USER},
+		MergeRequirements: &types.MergeRequirements{
+			RequiredApprovals: 1,
+		},
+	}
+	pullRequest := types.PullRequest{
+		Iid:       1,
+		State:     types.PullRequest_OPEN,
+		Head:      &types.PullRequestHead{RepositoryId: repository.Id, Branch: "feature", CommitSha: "sha1"},
+		Base:      &types.PullRequestBase{RepositoryId: repository.Id, Branch: "master"},
+		AutoMerge: &types.PullRequestAutoMerge{EnabledBy: "owner"},
+	}
+	k.AppendPullRequest(ctx, pullRequest)
+
+	k.UpdatePullRequestHeads(ctx, "contributor", repository, "feature", "sha2")
+	got, found := k.GetRepositoryPullRequest(ctx, repository.Id, pullRequest.Iid)
+	require.True(t, found)
+	require.Nil(t, got.AutoMerge)
+	require.Equal(t, uint64(1), got.CommentsCount)
+
+	comment, found := k.GetPullRequestComment(ctx, repository.Id, pullRequest.Iid, got.CommentsCount)
+	require.True(t, found)
+	require.Equal(t, types.CommentTypeAutoMergeDisabled, comment.CommentType)
+	require.Empty(t, k.GetAllTask(ctx))
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/gitopia/gitopia/x/gitopia/types"
+)
+
+// ToggleReaction adds the emoji reaction of address or removes it if it
+// already exists, it reports whether the reaction was added
+func ToggleReaction(reactions []*types.Reaction, address string, emoji types.Emoji) ([]*types.Reaction, bool) {
+	for i, reaction := range reactions {
+		if reaction.Address != address {
+			continue
+		}
+		for j, e := range reaction.Emojis {
+			if e == emoji {
+				reaction.Emojis = append(reaction.Emojis[:j], reaction.Emojis[j+1:]...)
+				if len(reaction.Emojis) == 0 {
+					reactions = append(reactions[:i], reactions[i+1:]...)
+				}
+				return reactions, false
+			}
+		}
+		reaction.Emojis = append(reaction.Emojis, emoji)
+		return reactions, true
+	}
+
+	return append(reactions, &types.Reaction{
+		Address: address,
+		Emojis:  []types.Emoji{emoji},
+	}), true
+}
+
+// GetReactionCounts aggregates reactions per emoji in emoji order
+func GetReactionCounts(reactions []*types.Reaction) []types.ReactionCount {
+	counts := make(map[types.Emoji]uint64)
+	for _, reaction := range reactions {
+		for _, emoji := range reaction.Emojis {
+			counts[emoji]++
+		}
+	}
+
+	var reactionCounts []types.ReactionCount
+	for emoji, count := range counts {
+		reactionCounts = append(reactionCounts, types.ReactionCount{
+			Emoji: emoji,
+			Count: count,
+		})
+	}
+	sort.Slice(reactionCounts, func(i, j int) bool {
+		return reactionCounts[i].Emoji < reactionCounts[j].Emoji
+	})
+
+	return reactionCounts
+}
+
+// GetCommentReactionCounts aggregates the reactions of the comments which have any
+func GetCommentReactionCounts(comments []*types.Comment) []types.CommentReactionCounts {
+	commentReactionCounts := []types.CommentReactionCounts{}
+	for _, comment := range comments {
+		if len(comment.Reactions) == 0 {
+			continue
+		}
+		commentReactionCounts = append(commentReactionCounts, types.CommentReactionCounts{
+			CommentId:      comment.Id,
+			ReactionCounts: GetReactionCounts(comment.Reactions),
+		})
+	}
+	return commentReactionCounts
+}
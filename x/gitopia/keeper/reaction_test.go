@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/gitopia/gitopia/x/gitopia/keeper"
+	"github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToggleReaction(t *testing.T) {
+	var reactions []*types.Reaction
+	var added bool
+
+	reactions, added = keeper.ToggleReaction(reactions, "user1", types.EmojiHeart)
+	require.True(t, added)
+	reactions, added = keeper.ToggleReaction(reactions, "user1", types.EmojiThumbsUp)
+	require.True(t, added)
+	reactions, added = keeper.ToggleReaction(reactions, "user2", types.EmojiHeart)
+	require.True(t, added)
+	require.Len(t, reactions, 2)
+
+	require.Equal(t, []types.ReactionCount{
+		{Emoji: types.EmojiThumbsUp, Count: 1},
+		{Emoji: types.EmojiHeart, Count: 2},
+	}, keeper.GetReactionCounts(reactions))
+
+	reactions, added = keeper.ToggleReaction(reactions, "user2", types.EmojiHeart)
+	require.False(t, added)
+	require.Len(t, reactions, 1)
+
+	reactions, added = keeper.ToggleReaction(reactions, "user1", types.EmojiHeart)
+	require.False(t, added)
+	require.Equal(t, []types.ReactionCount{
+		{Emoji: types.EmojiThumbsUp, Count: 1},
+	}, keeper.GetReactionCounts(reactions))
+}
+
+func TestCommentReactionCounts(t *testing.T) {
+	comments := []*types.Comment{
+		{Id: 1},
+		{Id: 2, Reactions: []*types.Reaction{{Address: "user1", Emojis: []types.Emoji{types.EmojiEyes}}}},
+	}
+
+	require.Equal(t, []types.CommentReactionCounts{
+		{CommentId: 2, ReactionCounts: []types.ReactionCount{{Emoji: types.EmojiEyes, Count: 1}}},
+	}, keeper.GetCommentReactionCounts(comments))
+}
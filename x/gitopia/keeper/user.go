@@ -48,6 +48,8 @@ func (k Keeper) AppendUser(
 	key := []byte(types.UserKey + user.Creator)
 	store.Set(key, appendedValue)
 
+	k.SetUserIdKey(ctx, user)
+
 	// Update user count
 	k.SetUserCount(ctx, count+1)
 
@@ -72,6 +74,24 @@ func (k Keeper) SetUser(ctx sdk.Context, user types.User) {
 	b := k.cdc.MustMarshal(&user)
 	key := []byte(types.UserKey + user.Creator)
 	store.Set(key, b)
+
+	k.SetUserIdKey(ctx, user)
+}
+
+// SetUserIdKey maps the user id to the user address
+func (k Keeper) SetUserIdKey(ctx sdk.Context, user types.User) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserIdKey))
+	store.Set(GetUserIDBytes(user.Id), []byte(user.Creator))
+}
+
+// GetUserById returns a user from its numeric id
+func (k Keeper) GetUserById(ctx sdk.Context, id uint64) (val types.User, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserIdKey))
+	b := store.Get(GetUserIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	return k.GetUser(ctx, string(b))
 }
 
 // GetUser returns a user from its id
@@ -88,6 +108,11 @@ func (k Keeper) GetUser(ctx sdk.Context, id string) (val types.User, found bool)
 
 // RemoveUser removes a user from the store
 func (k Keeper) RemoveUser(ctx sdk.Context, id string) {
+	if user, found := k.GetUser(ctx, id); found {
+		idStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserIdKey))
+		idStore.Delete(GetUserIDBytes(user.Id))
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UserKey))
 	key := []byte(types.UserKey + id)
 	store.Delete(key)
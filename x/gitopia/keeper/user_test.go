@@ -30,6 +30,16 @@ func TestUserGet(t *testing.T) {
 	}
 }
 
+func TestUserGetById(t *testing.T) {
+	keeper, ctx := keepertest.GitopiaKeeper(t)
+	items := createNUser(keeper, ctx, 10)
+	for _, item := range items {
+		got, found := keeper.GetUserById(ctx, item.Id)
+		require.True(t, found)
+		require.Equal(t, item, got)
+	}
+}
+
 func TestUserRemove(t *testing.T) {
 	keeper, ctx := keepertest.GitopiaKeeper(t)
 	items := createNUser(keeper, ctx, 10)
@@ -37,6 +47,8 @@ func TestUserRemove(t *testing.T) {
 		keeper.RemoveUser(ctx, item.Creator)
 		_, found := keeper.GetUser(ctx, item.Creator)
 		require.False(t, found)
+		_, found = keeper.GetUserById(ctx, item.Id)
+		require.False(t, found)
 	}
 }
 
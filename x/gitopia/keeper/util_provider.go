@@ -38,6 +38,14 @@ func (k Keeper) AuthorizeProvider(
 				return sdkerrors.Wrap(sdkerrors.ErrLogic, "authz grant error")
 			}
 		}
+	case types.ProviderPermission_CI:
+		for _, t := range CITypeUrls {
+			authorization := authz.NewGenericAuthorization(t)
+			err := k.authzKeeper.SaveGrant(ctx, grantee, granter, authorization, expiry)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "authz grant error")
+			}
+		}
 	default:
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid provider (%v)", providerType))
 	}
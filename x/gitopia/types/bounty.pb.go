@@ -31,18 +31,21 @@ const (
 	BountyStateSRCDEBITTED  BountyState = 0
 	BountyStateDESTCREDITED BountyState = 1
 	BountyStateREVERTEDBACK BountyState = 2
+	BountyStateDISPUTED     BountyState = 3
 )
 
 var BountyState_name = map[int32]string{
 	0: "BOUNTY_STATE_SRCDEBITTED",
 	1: "BOUNTY_STATE_DESTCREDITED",
 	2: "BOUNTY_STATE_REVERTEDBACK",
+	3: "BOUNTY_STATE_DISPUTED",
 }
 
 var BountyState_value = map[string]int32{
 	"BOUNTY_STATE_SRCDEBITTED":  0,
 	"BOUNTY_STATE_DESTCREDITED": 1,
 	"BOUNTY_STATE_REVERTEDBACK": 2,
+	"BOUNTY_STATE_DISPUTED":     3,
 }
 
 func (x BountyState) String() string {
@@ -56,15 +59,18 @@ func (BountyState) EnumDescriptor() ([]byte, []int) {
 type BountyParent int32
 
 const (
-	BountyParentIssue BountyParent = 0
+	BountyParentIssue       BountyParent = 0
+	BountyParentPullRequest BountyParent = 1
 )
 
 var BountyParent_name = map[int32]string{
 	0: "BOUNTY_PARENT_ISSUE",
+	1: "BOUNTY_PARENT_PULL_REQUEST",
 }
 
 var BountyParent_value = map[string]int32{
-	"BOUNTY_PARENT_ISSUE": 0,
+	"BOUNTY_PARENT_ISSUE":        0,
+	"BOUNTY_PARENT_PULL_REQUEST": 1,
 }
 
 func (x BountyParent) String() string {
@@ -75,32 +81,79 @@ func (BountyParent) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_67a698d5c16076fb, []int{1}
 }
 
-type Bounty struct {
-	Id           uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	State        BountyState                              `protobuf:"varint,3,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.BountyState" json:"state,omitempty"`
-	RepositoryId uint64                                   `protobuf:"varint,4,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
-	ParentIid    uint64                                   `protobuf:"varint,5,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
-	Parent       BountyParent                             `protobuf:"varint,6,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.BountyParent" json:"parent,omitempty"`
-	ExpireAt     int64                                    `protobuf:"varint,7,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
-	RewardedTo   string                                   `protobuf:"bytes,8,opt,name=rewardedTo,proto3" json:"rewardedTo,omitempty"`
-	CreatedAt    int64                                    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt    int64                                    `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Creator      string                                   `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+type BountySplitType int32
+
+const (
+	BountySplitTypeEqual      BountySplitType = 0
+	BountySplitTypePercentage BountySplitType = 1
+)
+
+var BountySplitType_name = map[int32]string{
+	0: "BOUNTY_SPLIT_TYPE_EQUAL",
+	1: "BOUNTY_SPLIT_TYPE_PERCENTAGE",
 }
 
-func (m *Bounty) Reset()         { *m = Bounty{} }
-func (m *Bounty) String() string { return proto.CompactTextString(m) }
-func (*Bounty) ProtoMessage()    {}
-func (*Bounty) Descriptor() ([]byte, []int) {
+var BountySplitType_value = map[string]int32{
+	"BOUNTY_SPLIT_TYPE_EQUAL":      0,
+	"BOUNTY_SPLIT_TYPE_PERCENTAGE": 1,
+}
+
+func (x BountySplitType) String() string {
+	return proto.EnumName(BountySplitType_name, int32(x))
+}
+
+func (BountySplitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{2}
+}
+
+type BountyDisputeResolution int32
+
+const (
+	BountyDisputeResolutionNone   BountyDisputeResolution = 0
+	BountyDisputeResolutionPayout BountyDisputeResolution = 1
+	BountyDisputeResolutionRefund BountyDisputeResolution = 2
+	BountyDisputeResolutionSplit  BountyDisputeResolution = 3
+)
+
+var BountyDisputeResolution_name = map[int32]string{
+	0: "BOUNTY_DISPUTE_RESOLUTION_NONE",
+	1: "BOUNTY_DISPUTE_RESOLUTION_PAYOUT",
+	2: "BOUNTY_DISPUTE_RESOLUTION_REFUND",
+	3: "BOUNTY_DISPUTE_RESOLUTION_SPLIT",
+}
+
+var BountyDisputeResolution_value = map[string]int32{
+	"BOUNTY_DISPUTE_RESOLUTION_NONE":   0,
+	"BOUNTY_DISPUTE_RESOLUTION_PAYOUT": 1,
+	"BOUNTY_DISPUTE_RESOLUTION_REFUND": 2,
+	"BOUNTY_DISPUTE_RESOLUTION_SPLIT":  3,
+}
+
+func (x BountyDisputeResolution) String() string {
+	return proto.EnumName(BountyDisputeResolution_name, int32(x))
+}
+
+func (BountyDisputeResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{3}
+}
+
+type BountyShare struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Percentage uint64 `protobuf:"varint,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (m *BountyShare) Reset()         { *m = BountyShare{} }
+func (m *BountyShare) String() string { return proto.CompactTextString(m) }
+func (*BountyShare) ProtoMessage()    {}
+func (*BountyShare) Descriptor() ([]byte, []int) {
 	return fileDescriptor_67a698d5c16076fb, []int{0}
 }
-func (m *Bounty) XXX_Unmarshal(b []byte) error {
+func (m *BountyShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Bounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BountyShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Bounty.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BountyShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -110,295 +163,2269 @@ func (m *Bounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Bounty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Bounty.Merge(m, src)
+func (m *BountyShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyShare.Merge(m, src)
 }
-func (m *Bounty) XXX_Size() int {
+func (m *BountyShare) XXX_Size() int {
 	return m.Size()
 }
-func (m *Bounty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Bounty.DiscardUnknown(m)
+func (m *BountyShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Bounty proto.InternalMessageInfo
+var xxx_messageInfo_BountyShare proto.InternalMessageInfo
 
-func (m *Bounty) GetId() uint64 {
+func (m *BountyShare) GetAddress() string {
 	if m != nil {
-		return m.Id
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BountyShare) GetPercentage() uint64 {
+	if m != nil {
+		return m.Percentage
 	}
 	return 0
 }
 
-func (m *Bounty) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+type BountySplit struct {
+	Type   BountySplitType `protobuf:"varint,1,opt,name=type,proto3,enum=gitopia.gitopia.gitopia.BountySplitType" json:"type,omitempty"`
+	Shares []BountyShare   `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares"`
+}
+
+func (m *BountySplit) Reset()         { *m = BountySplit{} }
+func (m *BountySplit) String() string { return proto.CompactTextString(m) }
+func (*BountySplit) ProtoMessage()    {}
+func (*BountySplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{1}
+}
+func (m *BountySplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountySplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountySplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountySplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountySplit.Merge(m, src)
+}
+func (m *BountySplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountySplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountySplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountySplit proto.InternalMessageInfo
+
+func (m *BountySplit) GetType() BountySplitType {
 	if m != nil {
-		return m.Amount
+		return m.Type
+	}
+	return BountySplitTypeEqual
+}
+
+func (m *BountySplit) GetShares() []BountyShare {
+	if m != nil {
+		return m.Shares
 	}
 	return nil
 }
 
-func (m *Bounty) GetState() BountyState {
+type BountyDisputeVote struct {
+	Voter            string                  `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Resolution       BountyDisputeResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=gitopia.gitopia.gitopia.BountyDisputeResolution" json:"resolution,omitempty"`
+	PayoutPercentage uint64                  `protobuf:"varint,3,opt,name=payoutPercentage,proto3" json:"payoutPercentage,omitempty"`
+	VotedAt          int64                   `protobuf:"varint,4,opt,name=votedAt,proto3" json:"votedAt,omitempty"`
+}
+
+func (m *BountyDisputeVote) Reset()         { *m = BountyDisputeVote{} }
+func (m *BountyDisputeVote) String() string { return proto.CompactTextString(m) }
+func (*BountyDisputeVote) ProtoMessage()    {}
+func (*BountyDisputeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{2}
+}
+func (m *BountyDisputeVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyDisputeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyDisputeVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyDisputeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyDisputeVote.Merge(m, src)
+}
+func (m *BountyDisputeVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyDisputeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyDisputeVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyDisputeVote proto.InternalMessageInfo
+
+func (m *BountyDisputeVote) GetVoter() string {
 	if m != nil {
-		return m.State
+		return m.Voter
 	}
-	return BountyStateSRCDEBITTED
+	return ""
 }
 
-func (m *Bounty) GetRepositoryId() uint64 {
+func (m *BountyDisputeVote) GetResolution() BountyDisputeResolution {
 	if m != nil {
-		return m.RepositoryId
+		return m.Resolution
+	}
+	return BountyDisputeResolutionNone
+}
+
+func (m *BountyDisputeVote) GetPayoutPercentage() uint64 {
+	if m != nil {
+		return m.PayoutPercentage
 	}
 	return 0
 }
 
-func (m *Bounty) GetParentIid() uint64 {
+func (m *BountyDisputeVote) GetVotedAt() int64 {
 	if m != nil {
-		return m.ParentIid
+		return m.VotedAt
 	}
 	return 0
 }
 
-func (m *Bounty) GetParent() BountyParent {
+type BountyDispute struct {
+	OpenedBy         string                  `protobuf:"bytes,1,opt,name=openedBy,proto3" json:"openedBy,omitempty"`
+	Contributor      string                  `protobuf:"bytes,2,opt,name=contributor,proto3" json:"contributor,omitempty"`
+	Reason           string                  `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	OpenedAt         int64                   `protobuf:"varint,4,opt,name=openedAt,proto3" json:"openedAt,omitempty"`
+	Votes            []BountyDisputeVote     `protobuf:"bytes,5,rep,name=votes,proto3" json:"votes"`
+	Resolution       BountyDisputeResolution `protobuf:"varint,6,opt,name=resolution,proto3,enum=gitopia.gitopia.gitopia.BountyDisputeResolution" json:"resolution,omitempty"`
+	PayoutPercentage uint64                  `protobuf:"varint,7,opt,name=payoutPercentage,proto3" json:"payoutPercentage,omitempty"`
+	ResolvedBy       string                  `protobuf:"bytes,8,opt,name=resolvedBy,proto3" json:"resolvedBy,omitempty"`
+	ResolvedAt       int64                   `protobuf:"varint,9,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+}
+
+func (m *BountyDispute) Reset()         { *m = BountyDispute{} }
+func (m *BountyDispute) String() string { return proto.CompactTextString(m) }
+func (*BountyDispute) ProtoMessage()    {}
+func (*BountyDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{3}
+}
+func (m *BountyDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyDispute.Merge(m, src)
+}
+func (m *BountyDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyDispute proto.InternalMessageInfo
+
+func (m *BountyDispute) GetOpenedBy() string {
 	if m != nil {
-		return m.Parent
+		return m.OpenedBy
 	}
-	return BountyParentIssue
+	return ""
 }
 
-func (m *Bounty) GetExpireAt() int64 {
+func (m *BountyDispute) GetContributor() string {
 	if m != nil {
-		return m.ExpireAt
+		return m.Contributor
 	}
-	return 0
+	return ""
 }
 
-func (m *Bounty) GetRewardedTo() string {
+func (m *BountyDispute) GetReason() string {
 	if m != nil {
-		return m.RewardedTo
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *Bounty) GetCreatedAt() int64 {
+func (m *BountyDispute) GetOpenedAt() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.OpenedAt
 	}
 	return 0
 }
 
-func (m *Bounty) GetUpdatedAt() int64 {
+func (m *BountyDispute) GetVotes() []BountyDisputeVote {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *BountyDispute) GetResolution() BountyDisputeResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return BountyDisputeResolutionNone
+}
+
+func (m *BountyDispute) GetPayoutPercentage() uint64 {
+	if m != nil {
+		return m.PayoutPercentage
 	}
 	return 0
 }
 
-func (m *Bounty) GetCreator() string {
+func (m *BountyDispute) GetResolvedBy() string {
 	if m != nil {
-		return m.Creator
+		return m.ResolvedBy
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyState", BountyState_name, BountyState_value)
-	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyParent", BountyParent_name, BountyParent_value)
-	proto.RegisterType((*Bounty)(nil), "gitopia.gitopia.gitopia.Bounty")
+func (m *BountyDispute) GetResolvedAt() int64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("gitopia/bounty.proto", fileDescriptor_67a698d5c16076fb) }
+type BountyContribution struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
 
-var fileDescriptor_67a698d5c16076fb = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x4f, 0xda, 0xae, 0x5b, 0xdd, 0x69, 0x2a, 0x66, 0x30, 0x2f, 0xa0, 0x2c, 0x9a, 0x40, 0x8a,
-	0x2a, 0x91, 0xb0, 0x71, 0x41, 0x93, 0x38, 0x34, 0x4d, 0x0e, 0x11, 0xd2, 0x98, 0x9c, 0x0c, 0x09,
-	0x2e, 0x55, 0xda, 0x58, 0x25, 0x42, 0xad, 0xa3, 0xd8, 0x85, 0xf5, 0x0d, 0x50, 0x4f, 0xbc, 0x40,
-	0x4f, 0xdc, 0x78, 0x0f, 0xa4, 0x1d, 0x38, 0xec, 0xc8, 0x09, 0x50, 0xfb, 0x22, 0x28, 0x4e, 0xda,
-	0x79, 0x4c, 0x88, 0xd3, 0x97, 0xef, 0xf7, 0xef, 0xfb, 0x62, 0xd9, 0x60, 0x77, 0x98, 0x70, 0x9a,
-	0x26, 0x91, 0xdd, 0xa7, 0x93, 0x31, 0x9f, 0x5a, 0x69, 0x46, 0x39, 0x85, 0x7b, 0x25, 0x6a, 0xfd,
-	0x55, 0xb5, 0xdd, 0x21, 0x1d, 0x52, 0xa1, 0xb1, 0xf3, 0xaf, 0x42, 0xae, 0xe9, 0x03, 0xca, 0x46,
-	0x94, 0xd9, 0xfd, 0x88, 0x11, 0xfb, 0xc3, 0x51, 0x9f, 0xf0, 0xe8, 0xc8, 0x1e, 0xd0, 0x64, 0x5c,
-	0xf0, 0x87, 0xdf, 0xab, 0xa0, 0xee, 0x88, 0x7c, 0xb8, 0x03, 0x2a, 0x49, 0x8c, 0x54, 0x43, 0x35,
-	0x6b, 0xb8, 0x92, 0xc4, 0x70, 0x00, 0xea, 0xd1, 0x28, 0xa7, 0x50, 0xc5, 0xa8, 0x9a, 0xcd, 0xe3,
-	0x7d, 0xab, 0xc8, 0xb2, 0xf2, 0x2c, 0xab, 0xcc, 0xb2, 0xba, 0x34, 0x19, 0x3b, 0x4f, 0x2f, 0x7f,
-	0x1e, 0x28, 0x5f, 0x7f, 0x1d, 0x98, 0xc3, 0x84, 0xbf, 0x9b, 0xf4, 0xad, 0x01, 0x1d, 0xd9, 0xe5,
-	0xe0, 0xa2, 0x3c, 0x61, 0xf1, 0x7b, 0x9b, 0x4f, 0x53, 0xc2, 0x84, 0x81, 0xe1, 0x32, 0x1a, 0x9e,
-	0x80, 0x0d, 0xc6, 0x23, 0x4e, 0x50, 0xd5, 0x50, 0xcd, 0x9d, 0xe3, 0x47, 0xd6, 0x3f, 0x7e, 0xcf,
-	0x2a, 0x96, 0x0c, 0x72, 0x2d, 0x2e, 0x2c, 0xf0, 0x10, 0x6c, 0x67, 0x24, 0xa5, 0x2c, 0xe1, 0x34,
-	0x9b, 0xfa, 0x31, 0xaa, 0x89, 0xd5, 0x6f, 0x60, 0xf0, 0x21, 0x68, 0xa4, 0x51, 0x46, 0xc6, 0xdc,
-	0x4f, 0x62, 0xb4, 0x21, 0x04, 0xd7, 0x00, 0x7c, 0x01, 0xea, 0x45, 0x83, 0xea, 0x62, 0xfc, 0xe3,
-	0xff, 0x8c, 0x3f, 0x13, 0x62, 0x5c, 0x9a, 0xa0, 0x06, 0xb6, 0xc8, 0x45, 0x9a, 0x64, 0xa4, 0xc3,
-	0xd1, 0xa6, 0xa1, 0x9a, 0x55, 0xbc, 0xee, 0xa1, 0x0e, 0x40, 0x46, 0x3e, 0x46, 0x59, 0x4c, 0xe2,
-	0x90, 0xa2, 0x2d, 0x43, 0x35, 0x1b, 0x58, 0x42, 0xf2, 0xc5, 0x06, 0x19, 0x89, 0x38, 0x89, 0x3b,
-	0x1c, 0x35, 0x84, 0xf9, 0x1a, 0xc8, 0xd9, 0x49, 0x1a, 0x97, 0x2c, 0x28, 0xd8, 0x35, 0x00, 0x11,
-	0xd8, 0x14, 0x52, 0x9a, 0xa1, 0xa6, 0x08, 0x5e, 0xb5, 0xed, 0x6f, 0x2a, 0x68, 0x4a, 0x27, 0x05,
-	0x9f, 0x03, 0xe4, 0xbc, 0x3a, 0x3f, 0x0d, 0xdf, 0xf4, 0x82, 0xb0, 0x13, 0x7a, 0xbd, 0x00, 0x77,
-	0x5d, 0xcf, 0xf1, 0xc3, 0xd0, 0x73, 0x5b, 0x8a, 0xa6, 0xcd, 0xe6, 0xc6, 0x7d, 0x49, 0x2e, 0xb1,
-	0xf0, 0x04, 0xec, 0xdf, 0x70, 0xba, 0x5e, 0x10, 0x76, 0xb1, 0xe7, 0xfa, 0xb9, 0x55, 0xd5, 0x1e,
-	0xcc, 0xe6, 0xc6, 0x9e, 0x64, 0x95, 0xe9, 0x5b, 0x5e, 0xec, 0xbd, 0xf6, 0x70, 0xe8, 0xb9, 0x4e,
-	0xa7, 0xfb, 0xb2, 0x55, 0xb9, 0xe5, 0x95, 0x69, 0xad, 0xf6, 0xe9, 0x8b, 0xae, 0xb4, 0x5d, 0xb0,
-	0x2d, 0x9f, 0x38, 0xb4, 0xc0, 0xdd, 0x32, 0xf1, 0xac, 0x83, 0xbd, 0xd3, 0xb0, 0xe7, 0x07, 0xc1,
-	0xb9, 0xd7, 0x52, 0xb4, 0x7b, 0xb3, 0xb9, 0x71, 0x47, 0x96, 0xfa, 0x8c, 0x4d, 0x48, 0x91, 0xe2,
-	0xb8, 0x97, 0x0b, 0x5d, 0xbd, 0x5a, 0xe8, 0xea, 0xef, 0x85, 0xae, 0x7e, 0x5e, 0xea, 0xca, 0xd5,
-	0x52, 0x57, 0x7e, 0x2c, 0x75, 0xe5, 0x6d, 0x5b, 0xba, 0xa8, 0xab, 0x67, 0xb6, 0xaa, 0x17, 0xeb,
-	0x2f, 0x71, 0x61, 0xfb, 0x75, 0xf1, 0x52, 0x9e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x45, 0x50,
-	0x25, 0xba, 0x90, 0x03, 0x00, 0x00,
+func (m *BountyContribution) Reset()         { *m = BountyContribution{} }
+func (m *BountyContribution) String() string { return proto.CompactTextString(m) }
+func (*BountyContribution) ProtoMessage()    {}
+func (*BountyContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{4}
+}
+func (m *BountyContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BountyContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyContribution.Merge(m, src)
+}
+func (m *BountyContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyContribution.DiscardUnknown(m)
 }
 
-func (m *Bounty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BountyContribution proto.InternalMessageInfo
+
+func (m *BountyContribution) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Bounty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BountyContribution) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x5a
+type BountyMilestone struct {
+	Title      string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Amount     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Released   bool                                     `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+	ReleasedTo string                                   `protobuf:"bytes,4,opt,name=releasedTo,proto3" json:"releasedTo,omitempty"`
+	ReleasedAt int64                                    `protobuf:"varint,5,opt,name=releasedAt,proto3" json:"releasedAt,omitempty"`
+}
+
+func (m *BountyMilestone) Reset()         { *m = BountyMilestone{} }
+func (m *BountyMilestone) String() string { return proto.CompactTextString(m) }
+func (*BountyMilestone) ProtoMessage()    {}
+func (*BountyMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{5}
+}
+func (m *BountyMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BountyMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BountyMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.UpdatedAt))
-		i--
-		dAtA[i] = 0x50
+}
+func (m *BountyMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BountyMilestone.Merge(m, src)
+}
+func (m *BountyMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *BountyMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_BountyMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BountyMilestone proto.InternalMessageInfo
+
+func (m *BountyMilestone) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *BountyMilestone) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.RewardedTo) > 0 {
-		i -= len(m.RewardedTo)
-		copy(dAtA[i:], m.RewardedTo)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.RewardedTo)))
-		i--
-		dAtA[i] = 0x42
+	return nil
+}
+
+func (m *BountyMilestone) GetReleased() bool {
+	if m != nil {
+		return m.Released
 	}
-	if m.ExpireAt != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.ExpireAt))
-		i--
-		dAtA[i] = 0x38
+	return false
+}
+
+func (m *BountyMilestone) GetReleasedTo() string {
+	if m != nil {
+		return m.ReleasedTo
 	}
-	if m.Parent != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Parent))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+func (m *BountyMilestone) GetReleasedAt() int64 {
+	if m != nil {
+		return m.ReleasedAt
 	}
-	if m.ParentIid != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.ParentIid))
-		i--
-		dAtA[i] = 0x28
+	return 0
+}
+
+type Bounty struct {
+	Id            uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	State         BountyState                              `protobuf:"varint,3,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.BountyState" json:"state,omitempty"`
+	RepositoryId  uint64                                   `protobuf:"varint,4,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	ParentIid     uint64                                   `protobuf:"varint,5,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	Parent        BountyParent                             `protobuf:"varint,6,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.BountyParent" json:"parent,omitempty"`
+	ExpireAt      int64                                    `protobuf:"varint,7,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	RewardedTo    []string                                 `protobuf:"bytes,8,rep,name=rewardedTo,proto3" json:"rewardedTo,omitempty"`
+	CreatedAt     int64                                    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     int64                                    `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Creator       string                                   `protobuf:"bytes,11,opt,name=creator,proto3" json:"creator,omitempty"`
+	Contributions []BountyContribution                     `protobuf:"bytes,12,rep,name=contributions,proto3" json:"contributions"`
+	Milestones    []BountyMilestone                        `protobuf:"bytes,13,rep,name=milestones,proto3" json:"milestones"`
+	Released      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	Dispute       *BountyDispute                           `protobuf:"bytes,15,opt,name=dispute,proto3" json:"dispute,omitempty"`
+}
+
+func (m *Bounty) Reset()         { *m = Bounty{} }
+func (m *Bounty) String() string { return proto.CompactTextString(m) }
+func (*Bounty) ProtoMessage()    {}
+func (*Bounty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67a698d5c16076fb, []int{6}
+}
+func (m *Bounty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Bounty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Bounty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.RepositoryId != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.RepositoryId))
+}
+func (m *Bounty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bounty.Merge(m, src)
+}
+func (m *Bounty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Bounty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bounty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bounty proto.InternalMessageInfo
+
+func (m *Bounty) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Bounty) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Bounty) GetState() BountyState {
+	if m != nil {
+		return m.State
+	}
+	return BountyStateSRCDEBITTED
+}
+
+func (m *Bounty) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *Bounty) GetParentIid() uint64 {
+	if m != nil {
+		return m.ParentIid
+	}
+	return 0
+}
+
+func (m *Bounty) GetParent() BountyParent {
+	if m != nil {
+		return m.Parent
+	}
+	return BountyParentIssue
+}
+
+func (m *Bounty) GetExpireAt() int64 {
+	if m != nil {
+		return m.ExpireAt
+	}
+	return 0
+}
+
+func (m *Bounty) GetRewardedTo() []string {
+	if m != nil {
+		return m.RewardedTo
+	}
+	return nil
+}
+
+func (m *Bounty) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Bounty) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Bounty) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Bounty) GetContributions() []BountyContribution {
+	if m != nil {
+		return m.Contributions
+	}
+	return nil
+}
+
+func (m *Bounty) GetMilestones() []BountyMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Bounty) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func (m *Bounty) GetDispute() *BountyDispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyState", BountyState_name, BountyState_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyParent", BountyParent_name, BountyParent_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.BountySplitType", BountySplitType_name, BountySplitType_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.BountyDisputeResolution", BountyDisputeResolution_name, BountyDisputeResolution_value)
+	proto.RegisterType((*BountyShare)(nil), "gitopia.gitopia.gitopia.BountyShare")
+	proto.RegisterType((*BountySplit)(nil), "gitopia.gitopia.gitopia.BountySplit")
+	proto.RegisterType((*BountyDisputeVote)(nil), "gitopia.gitopia.gitopia.BountyDisputeVote")
+	proto.RegisterType((*BountyDispute)(nil), "gitopia.gitopia.gitopia.BountyDispute")
+	proto.RegisterType((*BountyContribution)(nil), "gitopia.gitopia.gitopia.BountyContribution")
+	proto.RegisterType((*BountyMilestone)(nil), "gitopia.gitopia.gitopia.BountyMilestone")
+	proto.RegisterType((*Bounty)(nil), "gitopia.gitopia.gitopia.Bounty")
+}
+
+func init() { proto.RegisterFile("gitopia/bounty.proto", fileDescriptor_67a698d5c16076fb) }
+
+var fileDescriptor_67a698d5c16076fb = []byte{
+	// 1160 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x9b, 0x4c, 0xda, 0xd4, 0x9d, 0xa6, 0xcd, 0x76, 0x9b, 0x3a, 0x8b, 0x05,
+	0xc8, 0x0a, 0xc2, 0x6e, 0x83, 0x90, 0x50, 0x01, 0x81, 0x7f, 0x6c, 0x23, 0x8b, 0xe0, 0x6c, 0xc7,
+	0xeb, 0xa2, 0x70, 0xb1, 0xd6, 0xde, 0xc1, 0x5d, 0xe1, 0x78, 0xb6, 0x3b, 0xb3, 0xa1, 0x3e, 0x72,
+	0x02, 0xf9, 0x52, 0x24, 0xce, 0x96, 0x90, 0xb8, 0xf1, 0x3f, 0x70, 0xe0, 0xd6, 0x63, 0x8f, 0x9c,
+	0x00, 0x25, 0x17, 0xfe, 0x05, 0x6e, 0x68, 0x67, 0x66, 0xd7, 0xeb, 0x44, 0xae, 0x7b, 0x80, 0x9e,
+	0x76, 0xdf, 0x7b, 0xf3, 0xbd, 0x79, 0xef, 0x9b, 0xf7, 0xed, 0x2c, 0xd8, 0x1c, 0xb8, 0x8c, 0x78,
+	0xae, 0x5d, 0xe9, 0x91, 0x60, 0xc4, 0xc6, 0x65, 0xcf, 0x27, 0x8c, 0xc0, 0x2d, 0xe9, 0x2d, 0x9f,
+	0x7b, 0x6a, 0x9b, 0x03, 0x32, 0x20, 0x7c, 0x4d, 0x25, 0x7c, 0x13, 0xcb, 0xb5, 0x42, 0x9f, 0xd0,
+	0x63, 0x42, 0x2b, 0x3d, 0x9b, 0xe2, 0xca, 0xc9, 0xbd, 0x1e, 0x66, 0xf6, 0xbd, 0x4a, 0x9f, 0xb8,
+	0x23, 0x11, 0x2f, 0xee, 0x83, 0xf5, 0x1a, 0x4f, 0xdf, 0x7e, 0x6c, 0xfb, 0x18, 0xaa, 0xe0, 0x92,
+	0xed, 0x38, 0x3e, 0xa6, 0x54, 0x55, 0x74, 0xa5, 0xb4, 0x86, 0x22, 0x13, 0x16, 0x00, 0xf0, 0xb0,
+	0xdf, 0xc7, 0x23, 0x66, 0x0f, 0xb0, 0x9a, 0xd6, 0x95, 0x52, 0x16, 0x25, 0x3c, 0xc5, 0x67, 0x4a,
+	0x9c, 0xc9, 0x1b, 0xba, 0x0c, 0x7e, 0x04, 0xb2, 0x6c, 0xec, 0x61, 0x9e, 0x66, 0x63, 0xaf, 0x54,
+	0x5e, 0x50, 0x76, 0x39, 0x81, 0xb1, 0xc6, 0x1e, 0x46, 0x1c, 0x05, 0x6b, 0x20, 0x47, 0xc3, 0x82,
+	0xa8, 0x9a, 0xd6, 0x33, 0xa5, 0xf5, 0xbd, 0x37, 0x97, 0xe1, 0xc3, 0xc5, 0xb5, 0xec, 0xf3, 0x3f,
+	0x76, 0x52, 0x48, 0x22, 0x8b, 0xbf, 0x29, 0xe0, 0x9a, 0x88, 0x36, 0x5c, 0xea, 0x05, 0x0c, 0x3f,
+	0x22, 0x0c, 0xc3, 0x4d, 0xb0, 0x72, 0x42, 0x18, 0xf6, 0x65, 0x7f, 0xc2, 0x80, 0x26, 0x00, 0x3e,
+	0xa6, 0x64, 0x18, 0x30, 0x97, 0x8c, 0x78, 0x77, 0x1b, 0x7b, 0x77, 0x97, 0xec, 0x29, 0xb3, 0xa2,
+	0x18, 0x87, 0x12, 0x39, 0xe0, 0x2e, 0xc8, 0x7b, 0xf6, 0x98, 0x04, 0xcc, 0x9c, 0xb1, 0x96, 0xe1,
+	0xac, 0x5d, 0xf0, 0x87, 0xac, 0x87, 0x65, 0x38, 0x55, 0xa6, 0x66, 0x75, 0xa5, 0x94, 0x41, 0x91,
+	0x59, 0xfc, 0x2e, 0x03, 0xae, 0xcc, 0xed, 0x06, 0x35, 0xb0, 0x4a, 0x3c, 0x3c, 0xc2, 0x4e, 0x6d,
+	0x2c, 0x5b, 0x88, 0x6d, 0xa8, 0x83, 0xf5, 0x3e, 0x19, 0x31, 0xdf, 0xed, 0x05, 0x8c, 0xf8, 0xbc,
+	0x8d, 0x35, 0x94, 0x74, 0xc1, 0x9b, 0x20, 0xe7, 0x63, 0x9b, 0x92, 0x11, 0xaf, 0x65, 0x0d, 0x49,
+	0x6b, 0x96, 0x35, 0x2e, 0x21, 0xb6, 0xe1, 0x03, 0xc1, 0x18, 0x55, 0x57, 0xf8, 0x51, 0xec, 0xbe,
+	0x1a, 0x2d, 0x21, 0xd9, 0xf2, 0x40, 0x04, 0xfc, 0x1c, 0xc7, 0xb9, 0xff, 0x89, 0xe3, 0x4b, 0x0b,
+	0x38, 0x2e, 0xc8, 0xdd, 0x4f, 0x38, 0x73, 0xab, 0xbc, 0xfb, 0x84, 0x27, 0x19, 0xaf, 0x32, 0x75,
+	0x8d, 0x73, 0x90, 0xf0, 0x14, 0x7f, 0x54, 0x00, 0x14, 0x35, 0xd5, 0x23, 0x3e, 0xc3, 0x12, 0x16,
+	0x0b, 0xa6, 0x0f, 0x72, 0xf6, 0x71, 0x08, 0x90, 0x23, 0x7c, 0xab, 0x2c, 0xa4, 0x58, 0x0e, 0xa5,
+	0x58, 0x96, 0x52, 0x2c, 0xd7, 0x89, 0x3b, 0xaa, 0xdd, 0x0d, 0x69, 0xfa, 0xe5, 0xcf, 0x9d, 0xd2,
+	0xc0, 0x65, 0x8f, 0x83, 0x5e, 0xb9, 0x4f, 0x8e, 0x2b, 0x52, 0xb7, 0xe2, 0xf1, 0x2e, 0x75, 0xbe,
+	0xae, 0x84, 0xc2, 0xa0, 0x1c, 0x40, 0x91, 0x4c, 0x5d, 0xfc, 0x5b, 0x01, 0x57, 0x45, 0x55, 0x9f,
+	0xbb, 0x43, 0x4c, 0x19, 0x19, 0xf1, 0x09, 0x67, 0x2e, 0x1b, 0xe2, 0x68, 0xc2, 0xb9, 0xf1, 0x5a,
+	0xca, 0x09, 0xc7, 0xc8, 0xc7, 0x43, 0x6c, 0x53, 0xec, 0xf0, 0x01, 0x5b, 0x45, 0xb1, 0x2d, 0x08,
+	0x16, 0xef, 0x16, 0x51, 0xb3, 0xd1, 0x01, 0x44, 0x9e, 0x64, 0xbc, 0xca, 0xd4, 0x95, 0xe8, 0x00,
+	0x22, 0x4f, 0xf1, 0xa7, 0x1c, 0xc8, 0x89, 0x56, 0xe1, 0x06, 0x48, 0xbb, 0x0e, 0x6f, 0x2f, 0x8b,
+	0xd2, 0xae, 0xf3, 0x7a, 0x7a, 0xbb, 0x0f, 0x56, 0x28, 0xb3, 0x99, 0x50, 0xf1, 0xc6, 0xf2, 0x2f,
+	0x52, 0xb8, 0x16, 0x09, 0x08, 0x2c, 0x82, 0xcb, 0x3e, 0xf6, 0x08, 0x75, 0x19, 0xf1, 0xc7, 0x4d,
+	0x87, 0x77, 0x9f, 0x45, 0x73, 0x3e, 0xb8, 0x0d, 0xd6, 0x3c, 0xdb, 0xc7, 0x23, 0xd6, 0x74, 0x1d,
+	0xde, 0x7e, 0x16, 0xcd, 0x1c, 0xf0, 0x63, 0x90, 0x13, 0x86, 0x14, 0xce, 0x5b, 0x4b, 0xb6, 0x37,
+	0xf9, 0x62, 0x24, 0x41, 0xe1, 0xc1, 0xe0, 0xa7, 0x9e, 0xeb, 0xe3, 0x2a, 0xe3, 0x0a, 0xc9, 0xa0,
+	0xd8, 0x16, 0xc4, 0x7f, 0x63, 0xfb, 0x0e, 0x3f, 0x98, 0x55, 0x3d, 0x23, 0x0e, 0x26, 0xf2, 0x84,
+	0x85, 0xf5, 0x7d, 0x6c, 0xb3, 0x84, 0x30, 0x66, 0x8e, 0x30, 0x1a, 0x78, 0x8e, 0x8c, 0x02, 0x11,
+	0x8d, 0x1d, 0xa1, 0x3c, 0xf8, 0x52, 0xe2, 0xab, 0xeb, 0x42, 0x1e, 0xd2, 0x84, 0x5f, 0x80, 0x2b,
+	0xfd, 0x84, 0x90, 0xa8, 0x7a, 0x99, 0x1f, 0xdd, 0x3b, 0x4b, 0xfa, 0x4a, 0x8a, 0x4f, 0x7e, 0x5e,
+	0xe6, 0xf3, 0xc0, 0x16, 0x00, 0xc7, 0x91, 0x16, 0xa8, 0x7a, 0x85, 0x67, 0x5d, 0x76, 0xfd, 0xc4,
+	0xe2, 0x91, 0x29, 0x13, 0x19, 0xe0, 0x20, 0x31, 0xd3, 0x1b, 0xff, 0xfd, 0x78, 0xcd, 0x04, 0xf2,
+	0x29, 0xb8, 0xe4, 0x88, 0xcf, 0x9d, 0x7a, 0x55, 0x57, 0x4a, 0xeb, 0x7b, 0x6f, 0xbf, 0xe2, 0xc7,
+	0x31, 0x82, 0xed, 0xfe, 0x33, 0xbb, 0x83, 0xf9, 0xd8, 0x7d, 0x00, 0xd4, 0xda, 0x61, 0xa7, 0x65,
+	0x1d, 0x75, 0xdb, 0x56, 0xd5, 0x32, 0xba, 0x6d, 0x54, 0x6f, 0x18, 0xb5, 0xa6, 0x65, 0x19, 0x8d,
+	0x7c, 0x4a, 0xd3, 0x26, 0x53, 0xfd, 0x66, 0x62, 0x79, 0x22, 0x0a, 0xef, 0x83, 0x5b, 0x73, 0xc8,
+	0x86, 0xd1, 0xb6, 0xea, 0xc8, 0x68, 0x34, 0x43, 0xa8, 0xa2, 0xdd, 0x9e, 0x4c, 0xf5, 0xad, 0x04,
+	0x34, 0x19, 0xbe, 0x80, 0x45, 0xc6, 0x23, 0x03, 0x59, 0x46, 0xa3, 0x56, 0xad, 0x7f, 0x96, 0x4f,
+	0x5f, 0xc0, 0x26, 0xc3, 0x70, 0x0f, 0xdc, 0x98, 0xdf, 0xb7, 0xd9, 0x36, 0x3b, 0xe1, 0x9e, 0x19,
+	0x6d, 0x6b, 0x32, 0xd5, 0xaf, 0x27, 0xf7, 0x94, 0x21, 0x2d, 0xfb, 0xfd, 0xcf, 0x85, 0xd4, 0xee,
+	0xb7, 0x0a, 0xb8, 0x9c, 0x1c, 0x7d, 0x58, 0x06, 0xd7, 0x65, 0x2a, 0xb3, 0x8a, 0x8c, 0x96, 0xd5,
+	0x6d, 0xb6, 0xdb, 0x1d, 0x23, 0x9f, 0xd2, 0x6e, 0x4c, 0xa6, 0xfa, 0xb5, 0xe4, 0xd2, 0x26, 0xa5,
+	0x01, 0x86, 0x1f, 0x02, 0x6d, 0x7e, 0xbd, 0xd9, 0x39, 0x38, 0xe8, 0x22, 0xe3, 0x61, 0xc7, 0x68,
+	0x5b, 0xf3, 0x3d, 0x0b, 0x98, 0x19, 0x0c, 0x87, 0x08, 0x3f, 0x09, 0x30, 0x65, 0xb2, 0x86, 0x67,
+	0xf1, 0xd7, 0x38, 0xfe, 0x9f, 0x81, 0xef, 0x83, 0xad, 0xa8, 0x23, 0xf3, 0xa0, 0x69, 0x75, 0xad,
+	0x23, 0xd3, 0xe8, 0x1a, 0x0f, 0x3b, 0xd5, 0x83, 0x7c, 0x4a, 0x53, 0x27, 0x53, 0x7d, 0xf3, 0x1c,
+	0xc2, 0x78, 0x12, 0xd8, 0x43, 0xf8, 0x09, 0xd8, 0xbe, 0x08, 0x33, 0x0d, 0x54, 0x37, 0x5a, 0x56,
+	0x75, 0xdf, 0xc8, 0x2b, 0xda, 0x9d, 0xc9, 0x54, 0xbf, 0x75, 0x0e, 0x3b, 0xbb, 0xef, 0x64, 0x45,
+	0xbf, 0xa6, 0xc1, 0xd6, 0x82, 0x9b, 0x14, 0xd6, 0x41, 0x41, 0x6e, 0x21, 0xa9, 0xec, 0x22, 0xa3,
+	0x7d, 0x78, 0xd0, 0xb1, 0x9a, 0x87, 0xad, 0x6e, 0xeb, 0xb0, 0x15, 0x72, 0xb5, 0x33, 0x99, 0xea,
+	0xb7, 0x17, 0x24, 0x68, 0x85, 0x97, 0xcd, 0x3e, 0xd0, 0x17, 0x27, 0x31, 0xab, 0x47, 0x87, 0x9d,
+	0x90, 0xbb, 0x37, 0x26, 0x53, 0xfd, 0xce, 0x82, 0x34, 0x26, 0xbf, 0xa9, 0x5f, 0x9e, 0x08, 0x19,
+	0x0f, 0x3a, 0xad, 0x46, 0x3e, 0xfd, 0xd2, 0x44, 0x08, 0x7f, 0x15, 0x8c, 0x1c, 0x68, 0x80, 0x9d,
+	0xc5, 0x89, 0x38, 0x99, 0xf9, 0x8c, 0xa6, 0x4f, 0xa6, 0xfa, 0xf6, 0x82, 0x3c, 0x9c, 0x4d, 0xc1,
+	0x5f, 0xad, 0xf1, 0xfc, 0xb4, 0xa0, 0xbc, 0x38, 0x2d, 0x28, 0x7f, 0x9d, 0x16, 0x94, 0x1f, 0xce,
+	0x0a, 0xa9, 0x17, 0x67, 0x85, 0xd4, 0xef, 0x67, 0x85, 0xd4, 0x97, 0xbb, 0x09, 0x85, 0x47, 0x3f,
+	0xea, 0xd1, 0xf3, 0x69, 0xfc, 0xc6, 0x95, 0xde, 0xcb, 0xf1, 0x7f, 0xed, 0xf7, 0xfe, 0x1d, 0x00,
+	0xcc, 0xcd, 0x83, 0xd4, 0xd2, 0x0b, 0x00, 0x00,
+}
+
+func (m *BountyShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentage != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Percentage))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 	}
-	if m.State != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.State))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *BountySplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountySplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountySplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyDisputeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyDisputeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyDisputeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.VotedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PayoutPercentage != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.PayoutPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Resolution != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResolvedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PayoutPercentage != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.PayoutPercentage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Resolution != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.OpenedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.OpenedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contributor) > 0 {
+		i -= len(m.Contributor)
+		copy(dAtA[i:], m.Contributor)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Contributor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OpenedBy) > 0 {
+		i -= len(m.OpenedBy)
+		copy(dAtA[i:], m.OpenedBy)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.OpenedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BountyMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BountyMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BountyMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleasedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ReleasedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReleasedTo) > 0 {
+		i -= len(m.ReleasedTo)
+		copy(dAtA[i:], m.ReleasedTo)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ReleasedTo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bounty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bounty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bounty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dispute != nil {
+		{
+			size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBounty(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Contributions) > 0 {
+		for iNdEx := len(m.Contributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RewardedTo) > 0 {
+		for iNdEx := len(m.RewardedTo) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RewardedTo[iNdEx])
+			copy(dAtA[i:], m.RewardedTo[iNdEx])
+			i = encodeVarintBounty(dAtA, i, uint64(len(m.RewardedTo[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ExpireAt != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ExpireAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Parent != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ParentIid != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ParentIid))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBounty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BountyShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Percentage != 0 {
+		n += 1 + sovBounty(uint64(m.Percentage))
+	}
+	return n
+}
+
+func (m *BountySplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBounty(uint64(m.Type))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BountyDisputeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovBounty(uint64(m.Resolution))
+	}
+	if m.PayoutPercentage != 0 {
+		n += 1 + sovBounty(uint64(m.PayoutPercentage))
+	}
+	if m.VotedAt != 0 {
+		n += 1 + sovBounty(uint64(m.VotedAt))
+	}
+	return n
+}
+
+func (m *BountyDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OpenedBy)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Contributor)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.OpenedAt != 0 {
+		n += 1 + sovBounty(uint64(m.OpenedAt))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovBounty(uint64(m.Resolution))
+	}
+	if m.PayoutPercentage != 0 {
+		n += 1 + sovBounty(uint64(m.PayoutPercentage))
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ResolvedAt != 0 {
+		n += 1 + sovBounty(uint64(m.ResolvedAt))
+	}
+	return n
+}
+
+func (m *BountyContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BountyMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.Released {
+		n += 2
+	}
+	l = len(m.ReleasedTo)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ReleasedAt != 0 {
+		n += 1 + sovBounty(uint64(m.ReleasedAt))
+	}
+	return n
+}
+
+func (m *Bounty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBounty(uint64(m.Id))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.State != 0 {
+		n += 1 + sovBounty(uint64(m.State))
+	}
+	if m.RepositoryId != 0 {
+		n += 1 + sovBounty(uint64(m.RepositoryId))
+	}
+	if m.ParentIid != 0 {
+		n += 1 + sovBounty(uint64(m.ParentIid))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovBounty(uint64(m.Parent))
+	}
+	if m.ExpireAt != 0 {
+		n += 1 + sovBounty(uint64(m.ExpireAt))
+	}
+	if len(m.RewardedTo) > 0 {
+		for _, s := range m.RewardedTo {
+			l = len(s)
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovBounty(uint64(m.UpdatedAt))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Contributions) > 0 {
+		for _, e := range m.Contributions {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.Dispute != nil {
+		l = m.Dispute.Size()
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func sovBounty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBounty(x uint64) (n int) {
+	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BountyShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			m.Percentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountySplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountySplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountySplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BountySplitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, BountyShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyDisputeVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyDisputeVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyDisputeVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= BountyDisputeResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutPercentage", wireType)
+			}
+			m.PayoutPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedAt", wireType)
+			}
+			m.VotedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			m.OpenedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, BountyDisputeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= BountyDisputeResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutPercentage", wireType)
+			}
+			m.PayoutPercentage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutPercentage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BountyMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BountyMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BountyMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x12
+			m.Released = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAt", wireType)
+			}
+			m.ReleasedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Id != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBounty(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Bounty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBounty(uint64(m.Id))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
-		}
-	}
-	if m.State != 0 {
-		n += 1 + sovBounty(uint64(m.State))
-	}
-	if m.RepositoryId != 0 {
-		n += 1 + sovBounty(uint64(m.RepositoryId))
-	}
-	if m.ParentIid != 0 {
-		n += 1 + sovBounty(uint64(m.ParentIid))
-	}
-	if m.Parent != 0 {
-		n += 1 + sovBounty(uint64(m.Parent))
-	}
-	if m.ExpireAt != 0 {
-		n += 1 + sovBounty(uint64(m.ExpireAt))
-	}
-	l = len(m.RewardedTo)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovBounty(uint64(m.CreatedAt))
-	}
-	if m.UpdatedAt != 0 {
-		n += 1 + sovBounty(uint64(m.UpdatedAt))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBounty(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBounty(x uint64) (n int) {
-	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 func (m *Bounty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -607,7 +2634,7 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardedTo = string(dAtA[iNdEx:postIndex])
+			m.RewardedTo = append(m.RewardedTo, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 9:
 			if wireType != 0 {
@@ -679,6 +2706,144 @@ func (m *Bounty) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributions = append(m.Contributions, BountyContribution{})
+			if err := m.Contributions[len(m.Contributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, BountyMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispute == nil {
+				m.Dispute = &BountyDispute{}
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBounty(dAtA[iNdEx:])
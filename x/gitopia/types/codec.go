@@ -34,10 +34,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddRepositoryBackupRef{}, "gitopia/AddRepositoryBackupRef", nil)
 
 	cdc.RegisterConcrete(&MsgCreateBounty{}, "gitopia/CreateBounty", nil)
+	cdc.RegisterConcrete(&MsgFundBounty{}, "gitopia/FundBounty", nil)
+	cdc.RegisterConcrete(&MsgReleaseBountyMilestone{}, "gitopia/ReleaseBountyMilestone", nil)
+	cdc.RegisterConcrete(&MsgOpenBountyDispute{}, "gitopia/OpenBountyDispute", nil)
+	cdc.RegisterConcrete(&MsgVoteBountyDispute{}, "gitopia/VoteBountyDispute", nil)
+	cdc.RegisterConcrete(&MsgResolveBountyDispute{}, "gitopia/ResolveBountyDispute", nil)
 	cdc.RegisterConcrete(&MsgUpdateBountyExpiry{}, "gitopia/UpdateBountyExpiry", nil)
 	cdc.RegisterConcrete(&MsgCloseBounty{}, "gitopia/CloseBounty", nil)
 	cdc.RegisterConcrete(&MsgDeleteBounty{}, "gitopia/DeleteBounty", nil)
 	cdc.RegisterConcrete(&MsgToggleForcePush{}, "gitopia/ToggleForcePush", nil)
+	cdc.RegisterConcrete(&MsgSetBranchProtectionRule{}, "gitopia/SetBranchProtectionRule", nil)
+	cdc.RegisterConcrete(&MsgDeleteBranchProtectionRule{}, "gitopia/DeleteBranchProtectionRule", nil)
+	cdc.RegisterConcrete(&MsgSetCommitStatus{}, "gitopia/SetCommitStatus", nil)
 	cdc.RegisterConcrete(&MsgExercise{}, "gitopia/Exercise", nil)
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&MsgCreateRelease{}, "gitopia/CreateRelease", nil)
@@ -51,6 +59,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetPullRequestState{}, "gitopia/SetPullRequestState", nil)
 	cdc.RegisterConcrete(&MsgAddPullRequestReviewers{}, "gitopia/AddPullRequestReviewers", nil)
 	cdc.RegisterConcrete(&MsgRemovePullRequestReviewers{}, "gitopia/RemovePullRequestReviewers", nil)
+	cdc.RegisterConcrete(&MsgSubmitPullRequestReview{}, "gitopia/SubmitPullRequestReview", nil)
 	cdc.RegisterConcrete(&MsgLinkPullRequestIssueByIid{}, "gitopia/LinkPullRequestIssueByIid", nil)
 	cdc.RegisterConcrete(&MsgUnlinkPullRequestIssueByIid{}, "gitopia/UnlinkPullRequestIssueByIid", nil)
 	cdc.RegisterConcrete(&MsgAddPullRequestAssignees{}, "gitopia/AddPullRequestAssignees", nil)
@@ -58,6 +67,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddPullRequestLabels{}, "gitopia/AddPullRequestLabels", nil)
 	cdc.RegisterConcrete(&MsgRemovePullRequestLabels{}, "gitopia/RemovePullRequestLabels", nil)
 	cdc.RegisterConcrete(&MsgDeletePullRequest{}, "gitopia/DeletePullRequest", nil)
+	cdc.RegisterConcrete(&MsgSetPullRequestDraft{}, "gitopia/SetPullRequestDraft", nil)
+	cdc.RegisterConcrete(&MsgEnableAutoMerge{}, "gitopia/EnableAutoMerge", nil)
+	cdc.RegisterConcrete(&MsgDisableAutoMerge{}, "gitopia/DisableAutoMerge", nil)
+	cdc.RegisterConcrete(&MsgLockPullRequest{}, "gitopia/LockPullRequest", nil)
+	cdc.RegisterConcrete(&MsgUnlockPullRequest{}, "gitopia/UnlockPullRequest", nil)
 
 	cdc.RegisterConcrete(&MsgCreateDao{}, "gitopia/CreateDao", nil)
 	cdc.RegisterConcrete(&MsgRenameDao{}, "gitopia/RenameDao", nil)
@@ -70,6 +84,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateComment{}, "gitopia/CreateComment", nil)
 	cdc.RegisterConcrete(&MsgUpdateComment{}, "gitopia/UpdateComment", nil)
 	cdc.RegisterConcrete(&MsgDeleteComment{}, "gitopia/DeleteComment", nil)
+	cdc.RegisterConcrete(&MsgResolveCommentThread{}, "gitopia/ResolveCommentThread", nil)
+	cdc.RegisterConcrete(&MsgUnresolveCommentThread{}, "gitopia/UnresolveCommentThread", nil)
+	cdc.RegisterConcrete(&MsgToggleCommentReaction{}, "gitopia/ToggleCommentReaction", nil)
+	cdc.RegisterConcrete(&MsgHideComment{}, "gitopia/HideComment", nil)
+	cdc.RegisterConcrete(&MsgUnhideComment{}, "gitopia/UnhideComment", nil)
 
 	cdc.RegisterConcrete(&MsgCreateIssue{}, "gitopia/CreateIssue", nil)
 	cdc.RegisterConcrete(&MsgUpdateIssueTitle{}, "gitopia/UpdateIssueTitle", nil)
@@ -77,9 +96,18 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgToggleIssueState{}, "gitopia/ToggleIssueState", nil)
 	cdc.RegisterConcrete(&MsgAddIssueAssignees{}, "gitopia/AddIssueAssignees", nil)
 	cdc.RegisterConcrete(&MsgRemoveIssueAssignees{}, "gitopia/RemoveIssueAssignees", nil)
+	cdc.RegisterConcrete(&MsgSetIssueBountySplit{}, "gitopia/SetIssueBountySplit", nil)
 	cdc.RegisterConcrete(&MsgAddIssueLabels{}, "gitopia/AddIssueLabels", nil)
 	cdc.RegisterConcrete(&MsgRemoveIssueLabels{}, "gitopia/RemoveIssueLabels", nil)
 	cdc.RegisterConcrete(&MsgDeleteIssue{}, "gitopia/DeleteIssue", nil)
+	cdc.RegisterConcrete(&MsgLockIssue{}, "gitopia/LockIssue", nil)
+	cdc.RegisterConcrete(&MsgUnlockIssue{}, "gitopia/UnlockIssue", nil)
+	cdc.RegisterConcrete(&MsgLinkIssue{}, "gitopia/LinkIssue", nil)
+	cdc.RegisterConcrete(&MsgUnlinkIssue{}, "gitopia/UnlinkIssue", nil)
+	cdc.RegisterConcrete(&MsgCloseIssueAsDuplicate{}, "gitopia/CloseIssueAsDuplicate", nil)
+	cdc.RegisterConcrete(&MsgTransferIssue{}, "gitopia/TransferIssue", nil)
+	cdc.RegisterConcrete(&MsgSetIssueWeight{}, "gitopia/SetIssueWeight", nil)
+	cdc.RegisterConcrete(&MsgSetIssueDueDate{}, "gitopia/SetIssueDueDate", nil)
 
 	cdc.RegisterConcrete(&MsgCreateRepository{}, "gitopia/CreateRepository", nil)
 	cdc.RegisterConcrete(&MsgInvokeForkRepository{}, "gitopia/InvokeForkRepository", nil)
@@ -93,8 +121,19 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRepositoryLabel{}, "gitopia/CreateRepositoryLabel", nil)
 	cdc.RegisterConcrete(&MsgUpdateRepositoryLabel{}, "gitopia/UpdateRepositoryLabel", nil)
 	cdc.RegisterConcrete(&MsgDeleteRepositoryLabel{}, "gitopia/DeleteRepositoryLabel", nil)
+	cdc.RegisterConcrete(&MsgCreateMilestone{}, "gitopia/CreateMilestone", nil)
+	cdc.RegisterConcrete(&MsgUpdateMilestone{}, "gitopia/UpdateMilestone", nil)
+	cdc.RegisterConcrete(&MsgToggleMilestoneState{}, "gitopia/ToggleMilestoneState", nil)
+	cdc.RegisterConcrete(&MsgDeleteMilestone{}, "gitopia/DeleteMilestone", nil)
+	cdc.RegisterConcrete(&MsgSetIssueMilestone{}, "gitopia/SetIssueMilestone", nil)
+	cdc.RegisterConcrete(&MsgSetPullRequestMilestone{}, "gitopia/SetPullRequestMilestone", nil)
 	cdc.RegisterConcrete(&MsgToggleRepositoryForking{}, "gitopia/ToggleRepositoryForking", nil)
+	cdc.RegisterConcrete(&MsgToggleRepositoryArchived{}, "gitopia/ToggleRepositoryArchived", nil)
+	cdc.RegisterConcrete(&MsgSetRepositoryMergeRequirements{}, "gitopia/SetRepositoryMergeRequirements", nil)
+	cdc.RegisterConcrete(&MsgSetRepositoryMergeStrategies{}, "gitopia/SetRepositoryMergeStrategies", nil)
 	cdc.RegisterConcrete(&MsgToggleArweaveBackup{}, "gitopia/ToggleArweaveBackup", nil)
+	cdc.RegisterConcrete(&MsgStarRepository{}, "gitopia/StarRepository", nil)
+	cdc.RegisterConcrete(&MsgUnstarRepository{}, "gitopia/UnstarRepository", nil)
 	cdc.RegisterConcrete(&MsgDeleteRepository{}, "gitopia/DeleteRepository", nil)
 
 	cdc.RegisterConcrete(&MsgCreateUser{}, "gitopia/CreateUser", nil)
@@ -103,6 +142,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateUserBio{}, "gitopia/UpdateUserBio", nil)
 	cdc.RegisterConcrete(&MsgUpdateUserAvatar{}, "gitopia/UpdateUserAvatar", nil)
 	cdc.RegisterConcrete(&MsgDeleteUser{}, "gitopia/DeleteUser", nil)
+	cdc.RegisterConcrete(&MsgFollow{}, "gitopia/Follow", nil)
+	cdc.RegisterConcrete(&MsgUnfollow{}, "gitopia/Unfollow", nil)
 	// cdc.RegisterConcrete(&MsgTransferUser{}, "gitopia/TransferUser", nil)
 
 }
@@ -141,12 +182,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateBounty{},
+		&MsgFundBounty{},
+		&MsgReleaseBountyMilestone{},
+		&MsgOpenBountyDispute{},
+		&MsgVoteBountyDispute{},
+		&MsgResolveBountyDispute{},
 		&MsgUpdateBountyExpiry{},
 		&MsgCloseBounty{},
 		&MsgDeleteBounty{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgToggleForcePush{},
+		&MsgSetBranchProtectionRule{},
+		&MsgDeleteBranchProtectionRule{},
+		&MsgSetCommitStatus{},
 		&MsgExercise{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil))
@@ -167,6 +216,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetPullRequestState{},
 		&MsgAddPullRequestReviewers{},
 		&MsgRemovePullRequestReviewers{},
+		&MsgSubmitPullRequestReview{},
 		&MsgAddPullRequestAssignees{},
 		&MsgRemovePullRequestAssignees{},
 		&MsgLinkPullRequestIssueByIid{},
@@ -174,6 +224,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddPullRequestLabels{},
 		&MsgRemovePullRequestLabels{},
 		&MsgDeletePullRequest{},
+		&MsgSetPullRequestDraft{},
+		&MsgEnableAutoMerge{},
+		&MsgDisableAutoMerge{},
+		&MsgLockPullRequest{},
+		&MsgUnlockPullRequest{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDao{},
@@ -188,6 +243,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateComment{},
 		&MsgUpdateComment{},
 		&MsgDeleteComment{},
+		&MsgResolveCommentThread{},
+		&MsgUnresolveCommentThread{},
+		&MsgToggleCommentReaction{},
+		&MsgHideComment{},
+		&MsgUnhideComment{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateIssue{},
@@ -196,9 +256,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgToggleIssueState{},
 		&MsgAddIssueAssignees{},
 		&MsgRemoveIssueAssignees{},
+		&MsgSetIssueBountySplit{},
 		&MsgAddIssueLabels{},
 		&MsgRemoveIssueLabels{},
 		&MsgDeleteIssue{},
+		&MsgLockIssue{},
+		&MsgUnlockIssue{},
+		&MsgLinkIssue{},
+		&MsgUnlinkIssue{},
+		&MsgCloseIssueAsDuplicate{},
+		&MsgTransferIssue{},
+		&MsgSetIssueWeight{},
+		&MsgSetIssueDueDate{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateRepository{},
@@ -213,8 +282,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateRepositoryLabel{},
 		&MsgUpdateRepositoryLabel{},
 		&MsgDeleteRepositoryLabel{},
+		&MsgCreateMilestone{},
+		&MsgUpdateMilestone{},
+		&MsgToggleMilestoneState{},
+		&MsgDeleteMilestone{},
+		&MsgSetIssueMilestone{},
+		&MsgSetPullRequestMilestone{},
 		&MsgToggleRepositoryForking{},
+		&MsgToggleRepositoryArchived{},
+		&MsgSetRepositoryMergeRequirements{},
+		&MsgSetRepositoryMergeStrategies{},
 		&MsgToggleArweaveBackup{},
+		&MsgStarRepository{},
+		&MsgUnstarRepository{},
 		&MsgDeleteRepository{},
 	)
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -224,6 +304,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateUserBio{},
 		&MsgUpdateUserAvatar{},
 		&MsgDeleteUser{},
+		&MsgFollow{},
+		&MsgUnfollow{},
 		// &MsgTransferUser{},
 	)
 
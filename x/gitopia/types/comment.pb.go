@@ -45,6 +45,22 @@ const (
 	CommentTypeAddBounty           CommentType = 16
 	CommentTypeModifiedBounty      CommentType = 17
 	CommentTypeClosedBounty        CommentType = 18
+	CommentTypeBountyDispute       CommentType = 19
+	CommentTypeCommentHidden       CommentType = 20
+	CommentTypeCommentUnhidden     CommentType = 21
+	CommentTypeConvertedToDraft    CommentType = 22
+	CommentTypeReadyForReview      CommentType = 23
+	CommentTypeLocked              CommentType = 24
+	CommentTypeUnlocked            CommentType = 25
+	CommentTypeAutoMergeEnabled    CommentType = 26
+	CommentTypeAutoMergeDisabled   CommentType = 27
+	CommentTypeMilestoneAdded      CommentType = 28
+	CommentTypeMilestoneRemoved    CommentType = 29
+	CommentTypeIssueLinked         CommentType = 30
+	CommentTypeIssueUnlinked       CommentType = 31
+	CommentTypeIssueTransferred    CommentType = 32
+	CommentTypeWeightChanged       CommentType = 33
+	CommentTypeDueDateChanged      CommentType = 34
 )
 
 var CommentType_name = map[int32]string{
@@ -67,6 +83,22 @@ var CommentType_name = map[int32]string{
 	16: "COMMENT_TYPE_ADD_BOUNTY",
 	17: "COMMENT_TYPE_MODIFIED_BOUNTY",
 	18: "COMMENT_TYPE_CLOSED_BOUNTY",
+	19: "COMMENT_TYPE_BOUNTY_DISPUTE",
+	20: "COMMENT_TYPE_COMMENT_HIDDEN",
+	21: "COMMENT_TYPE_COMMENT_UNHIDDEN",
+	22: "COMMENT_TYPE_CONVERTED_TO_DRAFT",
+	23: "COMMENT_TYPE_READY_FOR_REVIEW",
+	24: "COMMENT_TYPE_LOCKED",
+	25: "COMMENT_TYPE_UNLOCKED",
+	26: "COMMENT_TYPE_AUTO_MERGE_ENABLED",
+	27: "COMMENT_TYPE_AUTO_MERGE_DISABLED",
+	28: "COMMENT_TYPE_MILESTONE_ADDED",
+	29: "COMMENT_TYPE_MILESTONE_REMOVED",
+	30: "COMMENT_TYPE_ISSUE_LINKED",
+	31: "COMMENT_TYPE_ISSUE_UNLINKED",
+	32: "COMMENT_TYPE_ISSUE_TRANSFERRED",
+	33: "COMMENT_TYPE_WEIGHT_CHANGED",
+	34: "COMMENT_TYPE_DUE_DATE_CHANGED",
 }
 
 var CommentType_value = map[string]int32{
@@ -89,6 +121,22 @@ var CommentType_value = map[string]int32{
 	"COMMENT_TYPE_ADD_BOUNTY":           16,
 	"COMMENT_TYPE_MODIFIED_BOUNTY":      17,
 	"COMMENT_TYPE_CLOSED_BOUNTY":        18,
+	"COMMENT_TYPE_BOUNTY_DISPUTE":       19,
+	"COMMENT_TYPE_COMMENT_HIDDEN":       20,
+	"COMMENT_TYPE_COMMENT_UNHIDDEN":     21,
+	"COMMENT_TYPE_CONVERTED_TO_DRAFT":   22,
+	"COMMENT_TYPE_READY_FOR_REVIEW":     23,
+	"COMMENT_TYPE_LOCKED":               24,
+	"COMMENT_TYPE_UNLOCKED":             25,
+	"COMMENT_TYPE_AUTO_MERGE_ENABLED":   26,
+	"COMMENT_TYPE_AUTO_MERGE_DISABLED":  27,
+	"COMMENT_TYPE_MILESTONE_ADDED":      28,
+	"COMMENT_TYPE_MILESTONE_REMOVED":    29,
+	"COMMENT_TYPE_ISSUE_LINKED":         30,
+	"COMMENT_TYPE_ISSUE_UNLINKED":       31,
+	"COMMENT_TYPE_ISSUE_TRANSFERRED":    32,
+	"COMMENT_TYPE_WEIGHT_CHANGED":       33,
+	"COMMENT_TYPE_DUE_DATE_CHANGED":     34,
 }
 
 func (x CommentType) String() string {
@@ -127,27 +175,68 @@ func (CommentParent) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61a8a10ae7d09fb4, []int{1}
 }
 
+type CommentHiddenReason int32
+
+const (
+	CommentHiddenReasonNone     CommentHiddenReason = 0
+	CommentHiddenReasonSpam     CommentHiddenReason = 1
+	CommentHiddenReasonAbuse    CommentHiddenReason = 2
+	CommentHiddenReasonOffTopic CommentHiddenReason = 3
+	CommentHiddenReasonOutdated CommentHiddenReason = 4
+	CommentHiddenReasonResolved CommentHiddenReason = 5
+)
+
+var CommentHiddenReason_name = map[int32]string{
+	0: "COMMENT_HIDDEN_REASON_NONE",
+	1: "COMMENT_HIDDEN_REASON_SPAM",
+	2: "COMMENT_HIDDEN_REASON_ABUSE",
+	3: "COMMENT_HIDDEN_REASON_OFF_TOPIC",
+	4: "COMMENT_HIDDEN_REASON_OUTDATED",
+	5: "COMMENT_HIDDEN_REASON_RESOLVED",
+}
+
+var CommentHiddenReason_value = map[string]int32{
+	"COMMENT_HIDDEN_REASON_NONE":      0,
+	"COMMENT_HIDDEN_REASON_SPAM":      1,
+	"COMMENT_HIDDEN_REASON_ABUSE":     2,
+	"COMMENT_HIDDEN_REASON_OFF_TOPIC": 3,
+	"COMMENT_HIDDEN_REASON_OUTDATED":  4,
+	"COMMENT_HIDDEN_REASON_RESOLVED":  5,
+}
+
+func (x CommentHiddenReason) String() string {
+	return proto.EnumName(CommentHiddenReason_name, int32(x))
+}
+
+func (CommentHiddenReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_61a8a10ae7d09fb4, []int{2}
+}
+
 type Comment struct {
-	Creator           string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                uint64        `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	RepositoryId      uint64        `protobuf:"varint,3,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
-	ParentIid         uint64        `protobuf:"varint,4,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
-	Parent            CommentParent `protobuf:"varint,5,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
-	CommentIid        uint64        `protobuf:"varint,6,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
-	Body              string        `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
-	Attachments       []*Attachment `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	DiffHunk          string        `protobuf:"bytes,9,opt,name=diffHunk,proto3" json:"diffHunk,omitempty"`
-	Path              string        `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
-	Position          uint64        `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
-	System            bool          `protobuf:"varint,12,opt,name=system,proto3" json:"system,omitempty"`
-	AuthorAssociation string        `protobuf:"bytes,13,opt,name=authorAssociation,proto3" json:"authorAssociation,omitempty"`
-	CreatedAt         int64         `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt         int64         `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	CommentType       CommentType   `protobuf:"varint,16,opt,name=commentType,proto3,enum=gitopia.gitopia.gitopia.CommentType" json:"commentType,omitempty"`
-	Resolved          bool          `protobuf:"varint,17,opt,name=resolved,proto3" json:"resolved,omitempty"`
-	Replies           []uint64      `protobuf:"varint,18,rep,packed,name=replies,proto3" json:"replies,omitempty"`
-	Reactions         []*Reaction   `protobuf:"bytes,19,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	Hidden            bool          `protobuf:"varint,20,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	Creator           string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                uint64              `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	RepositoryId      uint64              `protobuf:"varint,3,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	ParentIid         uint64              `protobuf:"varint,4,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	Parent            CommentParent       `protobuf:"varint,5,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
+	CommentIid        uint64              `protobuf:"varint,6,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	Body              string              `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments       []*Attachment       `protobuf:"bytes,8,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	DiffHunk          string              `protobuf:"bytes,9,opt,name=diffHunk,proto3" json:"diffHunk,omitempty"`
+	Path              string              `protobuf:"bytes,10,opt,name=path,proto3" json:"path,omitempty"`
+	Position          uint64              `protobuf:"varint,11,opt,name=position,proto3" json:"position,omitempty"`
+	System            bool                `protobuf:"varint,12,opt,name=system,proto3" json:"system,omitempty"`
+	AuthorAssociation string              `protobuf:"bytes,13,opt,name=authorAssociation,proto3" json:"authorAssociation,omitempty"`
+	CreatedAt         int64               `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt         int64               `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	CommentType       CommentType         `protobuf:"varint,16,opt,name=commentType,proto3,enum=gitopia.gitopia.gitopia.CommentType" json:"commentType,omitempty"`
+	Resolved          bool                `protobuf:"varint,17,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	Replies           []uint64            `protobuf:"varint,18,rep,packed,name=replies,proto3" json:"replies,omitempty"`
+	Reactions         []*Reaction         `protobuf:"bytes,19,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Hidden            bool                `protobuf:"varint,20,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	InReplyTo         uint64              `protobuf:"varint,21,opt,name=inReplyTo,proto3" json:"inReplyTo,omitempty"`
+	HiddenReason      CommentHiddenReason `protobuf:"varint,22,opt,name=hiddenReason,proto3,enum=gitopia.gitopia.gitopia.CommentHiddenReason" json:"hiddenReason,omitempty"`
+	HiddenBy          string              `protobuf:"bytes,23,opt,name=hiddenBy,proto3" json:"hiddenBy,omitempty"`
+	HiddenAt          int64               `protobuf:"varint,24,opt,name=hiddenAt,proto3" json:"hiddenAt,omitempty"`
 }
 
 func (m *Comment) Reset()         { *m = Comment{} }
@@ -323,79 +412,140 @@ func (m *Comment) GetHidden() bool {
 	return false
 }
 
+func (m *Comment) GetInReplyTo() uint64 {
+	if m != nil {
+		return m.InReplyTo
+	}
+	return 0
+}
+
+func (m *Comment) GetHiddenReason() CommentHiddenReason {
+	if m != nil {
+		return m.HiddenReason
+	}
+	return CommentHiddenReasonNone
+}
+
+func (m *Comment) GetHiddenBy() string {
+	if m != nil {
+		return m.HiddenBy
+	}
+	return ""
+}
+
+func (m *Comment) GetHiddenAt() int64 {
+	if m != nil {
+		return m.HiddenAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentType", CommentType_name, CommentType_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentParent", CommentParent_name, CommentParent_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.CommentHiddenReason", CommentHiddenReason_name, CommentHiddenReason_value)
 	proto.RegisterType((*Comment)(nil), "gitopia.gitopia.gitopia.Comment")
 }
 
 func init() { proto.RegisterFile("gitopia/comment.proto", fileDescriptor_61a8a10ae7d09fb4) }
 
 var fileDescriptor_61a8a10ae7d09fb4 = []byte{
-	// 996 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdb, 0x6e, 0xe3, 0x44,
-	0x18, 0xae, 0xdb, 0xf4, 0x90, 0x49, 0x0f, 0xee, 0xb4, 0xdb, 0xce, 0x7a, 0xbb, 0xd1, 0x6c, 0x41,
-	0x28, 0xaa, 0x56, 0x29, 0x5a, 0xc4, 0x05, 0x42, 0xb0, 0x72, 0x9b, 0xe9, 0x62, 0x29, 0x27, 0xec,
-	0x74, 0x51, 0xb9, 0x89, 0xdc, 0xcc, 0x34, 0xb5, 0x48, 0x3d, 0xc6, 0x76, 0x0a, 0x79, 0x03, 0xe4,
-	0x2b, 0x5e, 0xc0, 0xdc, 0xf0, 0x0e, 0x3c, 0x03, 0x97, 0x7b, 0x83, 0xc4, 0x25, 0x6a, 0x5f, 0x04,
-	0x79, 0x6c, 0x27, 0x76, 0xeb, 0x14, 0xae, 0xec, 0xff, 0x9f, 0xff, 0xfb, 0xbe, 0xff, 0x34, 0x89,
-	0xc1, 0xb3, 0xa1, 0xe5, 0x73, 0xc7, 0x32, 0x8f, 0x07, 0xfc, 0xe6, 0x86, 0xd9, 0x7e, 0xdd, 0x71,
-	0xb9, 0xcf, 0xe1, 0x7e, 0xe2, 0xae, 0x3f, 0x78, 0x2a, 0xbb, 0x43, 0x3e, 0xe4, 0x22, 0xe6, 0x38,
-	0x7a, 0x8b, 0xc3, 0x95, 0xbd, 0x94, 0xc5, 0x65, 0xe6, 0xc0, 0xb7, 0xb8, 0x9d, 0xf8, 0x51, 0xea,
-	0x37, 0x7d, 0xdf, 0x1c, 0x5c, 0xcf, 0x04, 0x0e, 0xff, 0x5a, 0x06, 0xab, 0xa7, 0xb1, 0x24, 0x44,
-	0x60, 0x75, 0xe0, 0x32, 0xd3, 0xe7, 0x2e, 0x92, 0xb0, 0x54, 0x2b, 0xeb, 0xa9, 0x09, 0x37, 0xc1,
-	0xa2, 0x45, 0xd1, 0x22, 0x96, 0x6a, 0x25, 0x7d, 0xd1, 0xa2, 0xf0, 0x10, 0xac, 0xbb, 0xcc, 0xe1,
-	0x9e, 0xe5, 0x73, 0x77, 0xa2, 0x51, 0xb4, 0x24, 0x4e, 0x72, 0x3e, 0x78, 0x00, 0xca, 0x8e, 0xe9,
-	0x32, 0xdb, 0xd7, 0x2c, 0x8a, 0x4a, 0x22, 0x60, 0xe6, 0x80, 0x5f, 0x83, 0x95, 0xd8, 0x40, 0xcb,
-	0x58, 0xaa, 0x6d, 0xbe, 0xf9, 0xa4, 0x3e, 0xa7, 0xd2, 0x7a, 0x92, 0x5d, 0x57, 0x44, 0xeb, 0x09,
-	0x0a, 0x56, 0x01, 0x48, 0x3a, 0x15, 0xd1, 0xaf, 0x08, 0xfa, 0x8c, 0x07, 0x42, 0x50, 0xba, 0xe4,
-	0x74, 0x82, 0x56, 0x45, 0x21, 0xe2, 0x1d, 0x12, 0x50, 0x99, 0xd5, 0xef, 0xa1, 0x35, 0xbc, 0x54,
-	0xab, 0xbc, 0xf9, 0x68, 0xae, 0xb0, 0x3a, 0x8d, 0xd5, 0xb3, 0x38, 0xa8, 0x80, 0x35, 0x6a, 0x5d,
-	0x5d, 0x7d, 0x33, 0xb6, 0x7f, 0x40, 0x65, 0x41, 0x3f, 0xb5, 0x23, 0x59, 0xc7, 0xf4, 0xaf, 0x11,
-	0x88, 0x65, 0xa3, 0xf7, 0x28, 0x5e, 0xb4, 0xc5, 0xe2, 0x36, 0xaa, 0x88, 0x44, 0xa7, 0x36, 0xdc,
-	0x03, 0x2b, 0xde, 0xc4, 0xf3, 0xd9, 0x0d, 0x5a, 0xc7, 0x52, 0x6d, 0x4d, 0x4f, 0x2c, 0xf8, 0x1a,
-	0x6c, 0x9b, 0x63, 0xff, 0x9a, 0xbb, 0xaa, 0xe7, 0xf1, 0x81, 0x65, 0x0a, 0xf0, 0x86, 0x20, 0x7d,
-	0x7c, 0x10, 0xb5, 0x5a, 0x4c, 0x8a, 0x51, 0xd5, 0x47, 0x9b, 0x58, 0xaa, 0x2d, 0xe9, 0x33, 0x47,
-	0x74, 0x3a, 0x76, 0x68, 0x72, 0xba, 0x15, 0x9f, 0x4e, 0x1d, 0xf0, 0x0c, 0x54, 0x92, 0xb6, 0xf5,
-	0x26, 0x0e, 0x43, 0xb2, 0x98, 0xc6, 0xc7, 0xff, 0x35, 0x8d, 0x28, 0x56, 0xcf, 0x02, 0xa3, 0x2a,
-	0x5d, 0xe6, 0xf1, 0xd1, 0x2d, 0xa3, 0x68, 0x5b, 0xd4, 0x32, 0xb5, 0xa3, 0xc5, 0x72, 0x99, 0x33,
-	0xb2, 0x98, 0x87, 0x20, 0x5e, 0xaa, 0x95, 0xf4, 0xd4, 0x84, 0x6f, 0x41, 0x39, 0x5d, 0x55, 0x0f,
-	0xed, 0x88, 0x81, 0xbc, 0x9a, 0xab, 0xad, 0x27, 0x91, 0xfa, 0x0c, 0x13, 0x35, 0xf0, 0xda, 0xa2,
-	0x94, 0xd9, 0x68, 0x37, 0x6e, 0x60, 0x6c, 0x1d, 0xfd, 0x56, 0x06, 0x95, 0x4c, 0xae, 0xf0, 0x08,
-	0x6c, 0x9f, 0x76, 0x5a, 0x2d, 0xd2, 0xee, 0xf5, 0x7b, 0x17, 0x5d, 0xd2, 0x6f, 0x77, 0xda, 0x44,
-	0x5e, 0x50, 0x76, 0x82, 0x10, 0x6f, 0x65, 0xe2, 0xda, 0xdc, 0x66, 0xf0, 0x35, 0x80, 0xb9, 0x58,
-	0x9d, 0x74, 0x9b, 0x17, 0xb2, 0xa4, 0xec, 0x06, 0x21, 0x96, 0xb3, 0x0d, 0x60, 0xce, 0x68, 0x02,
-	0x3f, 0x07, 0xfb, 0xb9, 0x68, 0xb5, 0xd1, 0xe8, 0x37, 0xd5, 0x13, 0xd2, 0x34, 0xe4, 0x45, 0x05,
-	0x05, 0x21, 0xde, 0xcd, 0x40, 0x54, 0x4a, 0x9b, 0xe6, 0x25, 0x1b, 0x79, 0xf0, 0x4b, 0xa0, 0x3c,
-	0x10, 0x69, 0x75, 0xde, 0x93, 0x14, 0xb9, 0xa4, 0xbc, 0x08, 0x42, 0xbc, 0x9f, 0x13, 0xbb, 0xe1,
-	0xb7, 0x6c, 0x0e, 0x38, 0xd2, 0x54, 0x0d, 0x43, 0x7b, 0xd7, 0x26, 0xc4, 0x90, 0x4b, 0x8f, 0xc0,
-	0x2a, 0xa5, 0xaa, 0xe7, 0x59, 0x43, 0x9b, 0x31, 0x0f, 0xaa, 0xe0, 0x65, 0x91, 0xf2, 0x0c, 0xbf,
-	0xac, 0x54, 0x83, 0x10, 0x2b, 0x8f, 0xc4, 0x67, 0x14, 0x45, 0xfa, 0x3a, 0x79, 0xaf, 0x91, 0xef,
-	0x88, 0x6e, 0xc8, 0x2b, 0x45, 0xfa, 0x3a, 0xbb, 0xb5, 0xd8, 0x4f, 0xcc, 0x9d, 0xab, 0x3f, 0xc3,
-	0xaf, 0xce, 0xd1, 0x9f, 0x51, 0x7c, 0x05, 0x5e, 0xe4, 0x28, 0x5a, 0x9d, 0x86, 0x76, 0xa6, 0x91,
-	0x46, 0xbf, 0xa7, 0xf5, 0x9a, 0x44, 0x5e, 0x53, 0x0e, 0x82, 0x10, 0xa3, 0x0c, 0x41, 0x8b, 0x53,
-	0xeb, 0xca, 0x62, 0xb4, 0x67, 0xf9, 0x23, 0x06, 0x35, 0xf0, 0xaa, 0x18, 0xde, 0x20, 0xc6, 0xa9,
-	0xae, 0x75, 0x7b, 0x5a, 0xa7, 0x2d, 0x97, 0x95, 0xc3, 0x20, 0xc4, 0xd5, 0x02, 0x92, 0x06, 0xf3,
-	0x06, 0xae, 0xe5, 0x88, 0xab, 0xf7, 0x05, 0x78, 0x9e, 0xa3, 0xd2, 0x0c, 0xe3, 0x9c, 0xf4, 0x4f,
-	0x9b, 0x1d, 0x83, 0x34, 0x64, 0xa0, 0x28, 0x41, 0x88, 0xf7, 0x32, 0x14, 0x9a, 0xe7, 0x8d, 0xd9,
-	0xe9, 0x88, 0x7b, 0x8c, 0xce, 0x81, 0x76, 0xba, 0xa4, 0x4d, 0x1a, 0x72, 0xa5, 0x18, 0xda, 0x71,
-	0x98, 0xcd, 0x28, 0x3c, 0x03, 0x38, 0x07, 0xed, 0x9e, 0x37, 0x9b, 0x7d, 0x9d, 0x7c, 0x7b, 0x4e,
-	0x8c, 0x5e, 0x2a, 0xbe, 0xae, 0xe0, 0x20, 0xc4, 0x07, 0x19, 0x86, 0xee, 0x78, 0x34, 0xd2, 0xd9,
-	0x8f, 0x63, 0xe6, 0xf9, 0x49, 0x0a, 0x4f, 0xf2, 0x24, 0x99, 0x6c, 0x3c, 0xc5, 0xf3, 0x7f, 0xf2,
-	0x69, 0x11, 0xfd, 0x1d, 0x69, 0xc8, 0x9b, 0x4f, 0xf1, 0xb4, 0x98, 0x3b, 0x64, 0x14, 0xd6, 0xc1,
-	0xce, 0x83, 0xd5, 0x88, 0x76, 0x42, 0xde, 0x52, 0x9e, 0x05, 0x21, 0xde, 0xce, 0x2d, 0x44, 0xb4,
-	0x0a, 0x85, 0x77, 0xef, 0xa4, 0x73, 0xde, 0xee, 0x5d, 0xc8, 0x72, 0xd1, 0xdd, 0x3b, 0xe1, 0x63,
-	0xdb, 0x9f, 0xc0, 0xb7, 0xe0, 0xa0, 0x78, 0xfe, 0x09, 0x76, 0x5b, 0x79, 0x19, 0x84, 0xf8, 0x79,
-	0xc1, 0xe8, 0x13, 0x82, 0x87, 0xfb, 0x1f, 0xb7, 0x3c, 0x85, 0xc3, 0x47, 0xfb, 0x1f, 0xb7, 0x3b,
-	0x06, 0x2b, 0xa5, 0x5f, 0x7e, 0xaf, 0x2e, 0x1c, 0xfd, 0x21, 0x81, 0x8d, 0xdc, 0x5f, 0x5b, 0xb6,
-	0xf8, 0xae, 0xaa, 0x47, 0x8f, 0xe4, 0x47, 0x2a, 0x5b, 0x7c, 0x1c, 0x2b, 0x7e, 0xa6, 0x3e, 0x05,
-	0xbb, 0x0f, 0xe2, 0xc5, 0x06, 0xc9, 0x92, 0xb2, 0x17, 0x84, 0x18, 0xe6, 0x00, 0x62, 0x79, 0xb2,
-	0xd7, 0x26, 0x41, 0x64, 0x07, 0x25, 0x2f, 0xe6, 0xae, 0x4d, 0x0c, 0xcc, 0xcc, 0x28, 0x4e, 0xfc,
-	0xa4, 0xf1, 0xe7, 0x5d, 0x55, 0xfa, 0x70, 0x57, 0x95, 0xfe, 0xb9, 0xab, 0x4a, 0xbf, 0xde, 0x57,
-	0x17, 0x3e, 0xdc, 0x57, 0x17, 0xfe, 0xbe, 0xaf, 0x2e, 0x7c, 0x7f, 0x34, 0xb4, 0xfc, 0xeb, 0xf1,
-	0x65, 0x7d, 0xc0, 0x6f, 0x8e, 0xd3, 0x0f, 0x8e, 0xf4, 0xf9, 0xf3, 0xf4, 0xcd, 0x9f, 0x38, 0xcc,
-	0xbb, 0x5c, 0x11, 0x9f, 0x1f, 0x9f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x90, 0x2b, 0x3e, 0xf8,
-	0xf8, 0x08, 0x00, 0x00,
+	// 1507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0xdd, 0x6e, 0xdb, 0x46,
+	0x16, 0xb6, 0x6c, 0xc7, 0x3f, 0x63, 0xc7, 0xa1, 0xc7, 0x7f, 0x0c, 0xed, 0x28, 0x8c, 0x77, 0xb1,
+	0x30, 0x8c, 0xc0, 0x59, 0x64, 0xb1, 0x17, 0x8b, 0xc5, 0x6e, 0x4a, 0x6b, 0x46, 0x31, 0x51, 0x8a,
+	0x54, 0x87, 0x64, 0x02, 0xf7, 0x46, 0xa0, 0xc5, 0xb1, 0x4d, 0x44, 0xe6, 0xb0, 0x24, 0xe5, 0x56,
+	0x6f, 0x50, 0xe8, 0xaa, 0x2f, 0x20, 0xf4, 0xa2, 0x7d, 0x86, 0x3e, 0x43, 0x2f, 0x73, 0xd9, 0xcb,
+	0x22, 0x79, 0x8e, 0x02, 0x05, 0x87, 0xa4, 0x44, 0x8a, 0x52, 0xd2, 0x2b, 0xf1, 0xcc, 0x9c, 0xef,
+	0x3b, 0x73, 0xce, 0xf9, 0xe6, 0x88, 0x04, 0x7b, 0x37, 0x5e, 0xcc, 0x02, 0xcf, 0x79, 0xd1, 0x65,
+	0x77, 0x77, 0xd4, 0x8f, 0xcf, 0x82, 0x90, 0xc5, 0x0c, 0x1e, 0x64, 0xcb, 0x67, 0x53, 0xbf, 0xd2,
+	0xee, 0x0d, 0xbb, 0x61, 0xdc, 0xe7, 0x45, 0xf2, 0x94, 0xba, 0x4b, 0xfb, 0x39, 0x4b, 0x48, 0x9d,
+	0x6e, 0xec, 0x31, 0x3f, 0x5b, 0x17, 0xf3, 0x75, 0x27, 0x8e, 0x9d, 0xee, 0xed, 0x24, 0xc0, 0xf1,
+	0x1f, 0x2b, 0x60, 0xb5, 0x91, 0x86, 0x84, 0x22, 0x58, 0xed, 0x86, 0xd4, 0x89, 0x59, 0x28, 0xd6,
+	0xe4, 0xda, 0xc9, 0x3a, 0xc9, 0x4d, 0xb8, 0x05, 0x16, 0x3d, 0x57, 0x5c, 0x94, 0x6b, 0x27, 0xcb,
+	0x64, 0xd1, 0x73, 0xe1, 0x31, 0xd8, 0x0c, 0x69, 0xc0, 0x22, 0x2f, 0x66, 0xe1, 0x40, 0x75, 0xc5,
+	0x25, 0xbe, 0x53, 0x5a, 0x83, 0x47, 0x60, 0x3d, 0x70, 0x42, 0xea, 0xc7, 0xaa, 0xe7, 0x8a, 0xcb,
+	0xdc, 0x61, 0xb2, 0x00, 0xff, 0x0f, 0x56, 0x52, 0x43, 0x7c, 0x20, 0xd7, 0x4e, 0xb6, 0x5e, 0xfe,
+	0xe3, 0x6c, 0x4e, 0xa6, 0x67, 0xd9, 0xe9, 0xda, 0xdc, 0x9b, 0x64, 0x28, 0x58, 0x07, 0x20, 0xab,
+	0x54, 0x42, 0xbf, 0xc2, 0xe9, 0x0b, 0x2b, 0x10, 0x82, 0xe5, 0x2b, 0xe6, 0x0e, 0xc4, 0x55, 0x9e,
+	0x08, 0x7f, 0x86, 0x18, 0x6c, 0x4c, 0xf2, 0x8f, 0xc4, 0x35, 0x79, 0xe9, 0x64, 0xe3, 0xe5, 0xdf,
+	0xe6, 0x06, 0x56, 0xc6, 0xbe, 0xa4, 0x88, 0x83, 0x12, 0x58, 0x73, 0xbd, 0xeb, 0xeb, 0x8b, 0xbe,
+	0xff, 0x4e, 0x5c, 0xe7, 0xf4, 0x63, 0x3b, 0x09, 0x1b, 0x38, 0xf1, 0xad, 0x08, 0xd2, 0xb0, 0xc9,
+	0x73, 0xe2, 0xcf, 0xcb, 0xe2, 0x31, 0x5f, 0xdc, 0xe0, 0x07, 0x1d, 0xdb, 0x70, 0x1f, 0xac, 0x44,
+	0x83, 0x28, 0xa6, 0x77, 0xe2, 0xa6, 0x5c, 0x3b, 0x59, 0x23, 0x99, 0x05, 0x9f, 0x83, 0x6d, 0xa7,
+	0x1f, 0xdf, 0xb2, 0x50, 0x89, 0x22, 0xd6, 0xf5, 0x1c, 0x0e, 0x7e, 0xc8, 0x49, 0xab, 0x1b, 0x49,
+	0xa9, 0x79, 0xa7, 0xa8, 0xab, 0xc4, 0xe2, 0x96, 0x5c, 0x3b, 0x59, 0x22, 0x93, 0x85, 0x64, 0xb7,
+	0x1f, 0xb8, 0xd9, 0xee, 0xa3, 0x74, 0x77, 0xbc, 0x00, 0x9b, 0x60, 0x23, 0x2b, 0x9b, 0x35, 0x08,
+	0xa8, 0x28, 0xf0, 0x6e, 0xfc, 0xfd, 0x73, 0xdd, 0x48, 0x7c, 0x49, 0x11, 0x98, 0x64, 0x19, 0xd2,
+	0x88, 0xf5, 0xee, 0xa9, 0x2b, 0x6e, 0xf3, 0x5c, 0xc6, 0x76, 0x22, 0xac, 0x90, 0x06, 0x3d, 0x8f,
+	0x46, 0x22, 0x94, 0x97, 0x4e, 0x96, 0x49, 0x6e, 0xc2, 0x57, 0x60, 0x3d, 0x97, 0x6a, 0x24, 0xee,
+	0xf0, 0x86, 0x3c, 0x9b, 0x1b, 0x9b, 0x64, 0x9e, 0x64, 0x82, 0x49, 0x0a, 0x78, 0xeb, 0xb9, 0x2e,
+	0xf5, 0xc5, 0xdd, 0xb4, 0x80, 0xa9, 0x95, 0x24, 0xed, 0xf9, 0x84, 0x06, 0xbd, 0x81, 0xc5, 0xc4,
+	0xbd, 0x54, 0x7d, 0xe3, 0x05, 0xd8, 0x06, 0x9b, 0xa9, 0x1f, 0xa1, 0x4e, 0xc4, 0x7c, 0x71, 0x9f,
+	0x67, 0xfd, 0xfc, 0x73, 0x59, 0x5f, 0x14, 0x30, 0xa4, 0xc4, 0x90, 0xa4, 0x9f, 0xda, 0xe7, 0x03,
+	0xf1, 0x20, 0x15, 0x45, 0x6e, 0x4f, 0xf6, 0x94, 0x58, 0x14, 0x79, 0xfd, 0xc7, 0xf6, 0xe9, 0x8f,
+	0xdb, 0x60, 0xa3, 0x50, 0x53, 0x78, 0x0a, 0xb6, 0x1b, 0x46, 0xab, 0x85, 0x75, 0xab, 0x63, 0x5d,
+	0xb6, 0x71, 0x47, 0x37, 0x74, 0x2c, 0x2c, 0x48, 0x3b, 0xc3, 0x91, 0xfc, 0xa8, 0xe0, 0xa7, 0x33,
+	0x9f, 0xc2, 0xe7, 0x00, 0x96, 0x7c, 0x09, 0x6e, 0x6b, 0x97, 0x42, 0x4d, 0xda, 0x1d, 0x8e, 0x64,
+	0xa1, 0xd8, 0xa8, 0x24, 0x6b, 0xf8, 0x6f, 0x70, 0x50, 0xf2, 0x56, 0x10, 0xea, 0x68, 0xca, 0x39,
+	0xd6, 0x4c, 0x61, 0x51, 0x12, 0x87, 0x23, 0x79, 0xb7, 0x00, 0x51, 0x5c, 0x57, 0x73, 0xae, 0x68,
+	0x2f, 0x82, 0xff, 0x05, 0xd2, 0x54, 0x90, 0x96, 0xf1, 0x06, 0xe7, 0xc8, 0x25, 0xe9, 0x70, 0x38,
+	0x92, 0x0f, 0x4a, 0xc1, 0xee, 0xd8, 0x3d, 0x9d, 0x03, 0x4e, 0x62, 0x2a, 0xa6, 0xa9, 0xbe, 0xd6,
+	0x31, 0x36, 0x85, 0xe5, 0x0a, 0x58, 0x71, 0x5d, 0x25, 0x8a, 0xbc, 0x1b, 0x9f, 0xd2, 0x08, 0x2a,
+	0xe0, 0xc9, 0xac, 0xc8, 0x13, 0xfc, 0x03, 0xa9, 0x3e, 0x1c, 0xc9, 0x52, 0x25, 0xf8, 0x84, 0x62,
+	0x56, 0x7c, 0x82, 0xdf, 0xa8, 0xf8, 0x2d, 0x26, 0xa6, 0xb0, 0x32, 0x2b, 0x3e, 0xa1, 0xf7, 0x1e,
+	0xfd, 0x96, 0x86, 0x73, 0xe3, 0x4f, 0xf0, 0xab, 0x73, 0xe2, 0x4f, 0x28, 0xfe, 0x07, 0x0e, 0x4b,
+	0x14, 0x2d, 0x03, 0xa9, 0x4d, 0x15, 0xa3, 0x8e, 0xa5, 0x5a, 0x1a, 0x16, 0xd6, 0xa4, 0xa3, 0xe1,
+	0x48, 0x16, 0x0b, 0x04, 0x2d, 0xe6, 0x7a, 0xd7, 0x1e, 0x75, 0x2d, 0x2f, 0xee, 0x51, 0xa8, 0x82,
+	0x67, 0xb3, 0xe1, 0x08, 0x9b, 0x0d, 0xa2, 0xb6, 0x2d, 0xd5, 0xd0, 0x85, 0x75, 0xe9, 0x78, 0x38,
+	0x92, 0xeb, 0x33, 0x48, 0x10, 0x8d, 0xba, 0xa1, 0x17, 0xf0, 0x11, 0xf1, 0x1f, 0xf0, 0xb8, 0x44,
+	0xa5, 0x9a, 0xa6, 0x8d, 0x3b, 0x0d, 0xcd, 0x30, 0x31, 0x12, 0x80, 0x24, 0x0d, 0x47, 0xf2, 0x7e,
+	0x81, 0x42, 0x8d, 0xa2, 0x3e, 0x6d, 0xf4, 0x58, 0x44, 0xdd, 0x39, 0x50, 0xa3, 0x8d, 0x75, 0x8c,
+	0x84, 0x8d, 0xd9, 0x50, 0x23, 0xa0, 0x3e, 0x75, 0x61, 0x13, 0xc8, 0x25, 0x68, 0xdb, 0xd6, 0xb4,
+	0x0e, 0xc1, 0x5f, 0xd9, 0xd8, 0xb4, 0xf2, 0xe0, 0x9b, 0x92, 0x3c, 0x1c, 0xc9, 0x47, 0x05, 0x86,
+	0x76, 0xbf, 0xd7, 0x23, 0xf4, 0x9b, 0x3e, 0x8d, 0xe2, 0xec, 0x08, 0x9f, 0xe4, 0xc9, 0x4e, 0xf2,
+	0xf0, 0x53, 0x3c, 0x7f, 0xe5, 0x3c, 0x2d, 0x4c, 0x5e, 0x63, 0x24, 0x6c, 0x7d, 0x8a, 0xa7, 0x45,
+	0xc3, 0x1b, 0xea, 0xc2, 0x33, 0xb0, 0x33, 0x25, 0x8d, 0x44, 0x13, 0xc2, 0x23, 0x69, 0x6f, 0x38,
+	0x92, 0xb7, 0x4b, 0x82, 0x48, 0xa4, 0x30, 0xf3, 0xee, 0x9d, 0x1b, 0xb6, 0x6e, 0x5d, 0x0a, 0xc2,
+	0xac, 0xbb, 0x77, 0xce, 0xfa, 0x7e, 0x3c, 0x80, 0xaf, 0xc0, 0xd1, 0xec, 0xfe, 0x67, 0xd8, 0x6d,
+	0xe9, 0xc9, 0x70, 0x24, 0x3f, 0x9e, 0xd1, 0xfa, 0x8c, 0x60, 0x5a, 0xff, 0x69, 0xc9, 0x73, 0x38,
+	0xac, 0xe8, 0x3f, 0x2d, 0x77, 0x06, 0x9e, 0x16, 0x6f, 0x8a, 0xea, 0x20, 0xd5, 0x6c, 0xdb, 0x16,
+	0x16, 0x76, 0x2a, 0xe2, 0x4d, 0x71, 0xc8, 0x8b, 0x82, 0x7e, 0x4c, 0x2b, 0xf0, 0xdc, 0xb8, 0x50,
+	0x11, 0xc2, 0xba, 0xb0, 0x5b, 0x81, 0x97, 0x86, 0x6c, 0xe5, 0xf6, 0xe5, 0x86, 0xad, 0x67, 0x04,
+	0x7b, 0x95, 0xdb, 0x97, 0x3d, 0xda, 0x7e, 0xf6, 0x1f, 0x80, 0xc0, 0xd3, 0x29, 0x0a, 0xfd, 0x0d,
+	0x26, 0x56, 0x72, 0xfd, 0x8c, 0x0e, 0x22, 0x4a, 0xd3, 0x12, 0xf6, 0xa5, 0xa7, 0xc3, 0x91, 0x7c,
+	0x58, 0x22, 0xf1, 0xef, 0x69, 0x18, 0x53, 0xd7, 0x62, 0x28, 0x74, 0xae, 0x63, 0xf8, 0x45, 0x65,
+	0x0c, 0x28, 0xe8, 0xb2, 0xd3, 0x34, 0x48, 0xde, 0xf5, 0x83, 0x4a, 0x17, 0x08, 0x75, 0xdc, 0x41,
+	0x93, 0x85, 0x59, 0xf7, 0xa7, 0xd5, 0xa2, 0x19, 0x8d, 0x2f, 0x31, 0x12, 0xc4, 0x8a, 0x5a, 0x34,
+	0xd6, 0x7d, 0x47, 0x5d, 0xf8, 0x12, 0xec, 0x95, 0xfc, 0x6d, 0x3d, 0x43, 0x3c, 0x96, 0x0e, 0x86,
+	0x23, 0x79, 0xa7, 0x80, 0xb0, 0xfd, 0x5e, 0x8a, 0x99, 0xce, 0x55, 0xb1, 0x2d, 0x23, 0x55, 0x74,
+	0x07, 0xeb, 0xca, 0xb9, 0x86, 0x91, 0x20, 0x55, 0x72, 0x55, 0xfa, 0x31, 0xe3, 0x8a, 0xc6, 0xbe,
+	0x73, 0xd5, 0x9b, 0x71, 0x3f, 0x0a, 0x2c, 0x48, 0x35, 0x53, 0x9a, 0xc3, 0xca, 0xfd, 0x18, 0xd3,
+	0x20, 0x2f, 0x4a, 0x79, 0x2a, 0xc2, 0x55, 0x35, 0x6c, 0x5a, 0x86, 0xce, 0x95, 0x8f, 0x91, 0x70,
+	0x54, 0x15, 0xae, 0xd7, 0xa3, 0x51, 0xcc, 0xfc, 0x44, 0xfd, 0xd4, 0x85, 0x0d, 0x50, 0x9f, 0x43,
+	0x90, 0x4e, 0x61, 0x24, 0x3c, 0xa9, 0x64, 0x33, 0xa6, 0x48, 0xa7, 0xf0, 0xbc, 0xc1, 0xa5, 0xa9,
+	0x7a, 0x52, 0xcb, 0xfa, 0xec, 0xc1, 0xa5, 0x79, 0x7e, 0x52, 0xce, 0x69, 0xf1, 0xa6, 0x50, 0x5b,
+	0xcf, 0xc0, 0x4f, 0x2b, 0xe2, 0xe5, 0x60, 0xdb, 0xef, 0xa5, 0xf0, 0xe9, 0xe3, 0xa7, 0x70, 0x8b,
+	0x28, 0xba, 0xd9, 0xc4, 0x84, 0x60, 0x24, 0xc8, 0x95, 0xe3, 0x73, 0x06, 0x2b, 0x74, 0xfc, 0xe8,
+	0x9a, 0x86, 0xe1, 0x8c, 0x33, 0xbc, 0xc5, 0xea, 0xeb, 0x0b, 0xab, 0xd3, 0xb8, 0x50, 0xf4, 0x64,
+	0x4e, 0x3d, 0xab, 0x9c, 0xe1, 0x2d, 0xf5, 0x6e, 0x6e, 0xe3, 0xc6, 0xad, 0xe3, 0x27, 0x33, 0x6a,
+	0x5a, 0xb7, 0xc8, 0xc6, 0x1d, 0xa4, 0x58, 0x78, 0x4c, 0x70, 0x5c, 0x69, 0x02, 0xea, 0x53, 0xe4,
+	0xc4, 0x34, 0x63, 0x90, 0x96, 0xbf, 0xff, 0xa9, 0xbe, 0x70, 0xfa, 0x4b, 0x0d, 0x3c, 0x2c, 0xbd,
+	0x83, 0x17, 0xf5, 0xdc, 0x56, 0x48, 0xf2, 0x93, 0xbd, 0xa5, 0x14, 0xf5, 0x9c, 0xfa, 0xf2, 0xf7,
+	0x94, 0x7f, 0x82, 0xdd, 0x29, 0x7f, 0x5e, 0x0f, 0xa1, 0x26, 0xed, 0x0f, 0x47, 0x32, 0x2c, 0x01,
+	0x78, 0x15, 0x8a, 0xa9, 0x67, 0x88, 0xe2, 0xa4, 0x16, 0x16, 0x4b, 0xa9, 0xa7, 0xc0, 0xc2, 0x90,
+	0xce, 0x0e, 0xfe, 0xf3, 0x12, 0xd8, 0x99, 0xf1, 0xe2, 0x56, 0x1c, 0x8a, 0xe9, 0x28, 0x49, 0xae,
+	0xb4, 0x69, 0xe8, 0x79, 0x16, 0xc5, 0xa1, 0x58, 0x04, 0xf2, 0x5c, 0xe6, 0x82, 0xcd, 0xb6, 0xd2,
+	0x12, 0x6a, 0x73, 0xc1, 0x66, 0xe0, 0xdc, 0x15, 0xd3, 0x2a, 0x83, 0x95, 0x73, 0xdb, 0xc4, 0x53,
+	0x69, 0x15, 0xd1, 0xca, 0x55, 0x3f, 0xa2, 0xc5, 0x3b, 0x5e, 0x86, 0x1b, 0xcd, 0x66, 0xc7, 0x32,
+	0xda, 0x6a, 0x43, 0x58, 0x2a, 0xc9, 0xaa, 0x48, 0x61, 0x5c, 0x5f, 0x5b, 0x2c, 0xf0, 0xba, 0x45,
+	0x6d, 0x4e, 0xb1, 0xd8, 0x56, 0xa2, 0x0f, 0x24, 0x2c, 0xcf, 0x27, 0xe9, 0xc7, 0xfc, 0xbb, 0x61,
+	0x3e, 0x09, 0xc1, 0xa6, 0xa1, 0x25, 0xf7, 0xf3, 0xc1, 0x5c, 0x12, 0x92, 0x7d, 0x16, 0xa4, 0x6d,
+	0x3a, 0x47, 0xbf, 0x7e, 0xa8, 0xd7, 0xde, 0x7f, 0xa8, 0xd7, 0x7e, 0xff, 0x50, 0xaf, 0xfd, 0xf0,
+	0xb1, 0xbe, 0xf0, 0xfe, 0x63, 0x7d, 0xe1, 0xb7, 0x8f, 0xf5, 0x85, 0xaf, 0x4f, 0x6f, 0xbc, 0xf8,
+	0xb6, 0x7f, 0x75, 0xd6, 0x65, 0x77, 0x2f, 0xf2, 0x0f, 0xd8, 0xfc, 0xf7, 0xbb, 0xf1, 0x53, 0x3c,
+	0x08, 0x68, 0x74, 0xb5, 0xc2, 0x3f, 0x67, 0xff, 0xf5, 0xe7, 0x00, 0xb3, 0x5a, 0x83, 0xc7, 0x48,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *Comment) Marshal() (dAtA []byte, err error) {
@@ -418,6 +568,36 @@ func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HiddenAt != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.HiddenAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.HiddenBy) > 0 {
+		i -= len(m.HiddenBy)
+		copy(dAtA[i:], m.HiddenBy)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.HiddenBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.HiddenReason != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.HiddenReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.InReplyTo != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.InReplyTo))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if m.Hidden {
 		i--
 		if m.Hidden {
@@ -679,6 +859,19 @@ func (m *Comment) Size() (n int) {
 	if m.Hidden {
 		n += 3
 	}
+	if m.InReplyTo != 0 {
+		n += 2 + sovComment(uint64(m.InReplyTo))
+	}
+	if m.HiddenReason != 0 {
+		n += 2 + sovComment(uint64(m.HiddenReason))
+	}
+	l = len(m.HiddenBy)
+	if l > 0 {
+		n += 2 + l + sovComment(uint64(l))
+	}
+	if m.HiddenAt != 0 {
+		n += 2 + sovComment(uint64(m.HiddenAt))
+	}
 	return n
 }
 
@@ -1252,6 +1445,95 @@ func (m *Comment) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Hidden = bool(v != 0)
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InReplyTo", wireType)
+			}
+			m.InReplyTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InReplyTo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenReason", wireType)
+			}
+			m.HiddenReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HiddenReason |= CommentHiddenReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenAt", wireType)
+			}
+			m.HiddenAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HiddenAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipComment(dAtA[iNdEx:])
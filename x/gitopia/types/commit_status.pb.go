@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/commit_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CommitStatusState int32
+
+const (
+	CommitStatusStatePending CommitStatusState = 0
+	CommitStatusStateSuccess CommitStatusState = 1
+	CommitStatusStateFailure CommitStatusState = 2
+	CommitStatusStateError   CommitStatusState = 3
+)
+
+var CommitStatusState_name = map[int32]string{
+	0: "COMMIT_STATUS_STATE_PENDING",
+	1: "COMMIT_STATUS_STATE_SUCCESS",
+	2: "COMMIT_STATUS_STATE_FAILURE",
+	3: "COMMIT_STATUS_STATE_ERROR",
+}
+
+var CommitStatusState_value = map[string]int32{
+	"COMMIT_STATUS_STATE_PENDING": 0,
+	"COMMIT_STATUS_STATE_SUCCESS": 1,
+	"COMMIT_STATUS_STATE_FAILURE": 2,
+	"COMMIT_STATUS_STATE_ERROR":   3,
+}
+
+func (x CommitStatusState) String() string {
+	return proto.EnumName(CommitStatusState_name, int32(x))
+}
+
+func (CommitStatusState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b0ac065733fc0e0b, []int{0}
+}
+
+type CommitStatus struct {
+	RepositoryId uint64            `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Sha          string            `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	Context      string            `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	State        CommitStatusState `protobuf:"varint,4,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.CommitStatusState" json:"state,omitempty"`
+	TargetUrl    string            `protobuf:"bytes,5,opt,name=targetUrl,proto3" json:"targetUrl,omitempty"`
+	Description  string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Creator      string            `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt    int64             `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt    int64             `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+}
+
+func (m *CommitStatus) Reset()         { *m = CommitStatus{} }
+func (m *CommitStatus) String() string { return proto.CompactTextString(m) }
+func (*CommitStatus) ProtoMessage()    {}
+func (*CommitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0ac065733fc0e0b, []int{0}
+}
+func (m *CommitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitStatus.Merge(m, src)
+}
+func (m *CommitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitStatus proto.InternalMessageInfo
+
+func (m *CommitStatus) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *CommitStatus) GetSha() string {
+	if m != nil {
+		return m.Sha
+	}
+	return ""
+}
+
+func (m *CommitStatus) GetContext() string {
+	if m != nil {
+		return m.Context
+	}
+	return ""
+}
+
+func (m *CommitStatus) GetState() CommitStatusState {
+	if m != nil {
+		return m.State
+	}
+	return CommitStatusStatePending
+}
+
+func (m *CommitStatus) GetTargetUrl() string {
+	if m != nil {
+		return m.TargetUrl
+	}
+	return ""
+}
+
+func (m *CommitStatus) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CommitStatus) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CommitStatus) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *CommitStatus) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.CommitStatusState", CommitStatusState_name, CommitStatusState_value)
+	proto.RegisterType((*CommitStatus)(nil), "gitopia.gitopia.gitopia.CommitStatus")
+}
+
+func init() { proto.RegisterFile("gitopia/commit_status.proto", fileDescriptor_b0ac065733fc0e0b) }
+
+var fileDescriptor_b0ac065733fc0e0b = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xa6, 0xdb, 0xa8, 0x99, 0x50, 0xb0, 0x10, 0x98, 0x6c, 0x8a, 0xa2, 0x9d, 0xa2,
+	0x1e, 0x52, 0x09, 0x4e, 0x1c, 0x90, 0x28, 0x59, 0x86, 0x22, 0xb1, 0x3f, 0x72, 0x9a, 0x0b, 0x97,
+	0x2a, 0x4b, 0xac, 0xcc, 0xd2, 0x1a, 0x47, 0xb6, 0x23, 0x6d, 0xdf, 0x00, 0xed, 0x04, 0x1f, 0x60,
+	0x27, 0xbe, 0x03, 0x9f, 0x81, 0xe3, 0x8e, 0x1c, 0x51, 0xfb, 0x45, 0x50, 0x9c, 0x76, 0x2b, 0x74,
+	0xb9, 0xf8, 0x7d, 0xfd, 0x3c, 0xfe, 0x3d, 0x7a, 0x0e, 0x86, 0x7b, 0x05, 0x53, 0xbc, 0x62, 0xe9,
+	0x28, 0xe3, 0xb3, 0x19, 0x53, 0x53, 0xa9, 0x52, 0x55, 0x4b, 0xbf, 0x12, 0x5c, 0x71, 0xf4, 0x6a,
+	0x69, 0xfa, 0xff, 0x4d, 0xfb, 0x45, 0xc1, 0x0b, 0xae, 0xdf, 0x8c, 0x9a, 0xad, 0x7d, 0x7e, 0xf0,
+	0xb3, 0x07, 0x77, 0x03, 0x1d, 0x13, 0xeb, 0x14, 0x74, 0x00, 0x77, 0x05, 0xad, 0xb8, 0x64, 0x8a,
+	0x8b, 0xeb, 0x28, 0xc7, 0xc0, 0x05, 0x5e, 0x9f, 0xfc, 0xa3, 0x21, 0x0b, 0x9a, 0xf2, 0x22, 0xc5,
+	0x3d, 0x17, 0x78, 0x03, 0xd2, 0xac, 0x08, 0xc3, 0x9d, 0x8c, 0x97, 0x8a, 0x5e, 0x29, 0x6c, 0x6a,
+	0x75, 0x75, 0x45, 0x1f, 0xe0, 0x56, 0xd3, 0x8f, 0xe2, 0xbe, 0x0b, 0xbc, 0x67, 0x6f, 0x86, 0x7e,
+	0x47, 0x3f, 0x7f, 0xbd, 0x45, 0x73, 0x52, 0xd2, 0x82, 0x68, 0x1f, 0x0e, 0x54, 0x2a, 0x0a, 0xaa,
+	0x12, 0x71, 0x89, 0xb7, 0x74, 0xfa, 0x83, 0x80, 0x5c, 0xf8, 0x34, 0xa7, 0x32, 0x13, 0xac, 0x52,
+	0x8c, 0x97, 0x78, 0x5b, 0xfb, 0xeb, 0x92, 0xee, 0x26, 0x68, 0xaa, 0xb8, 0xc0, 0x3b, 0xcb, 0x6e,
+	0xed, 0xb5, 0x49, 0xd6, 0x2b, 0xcd, 0xc7, 0x0a, 0x3f, 0x71, 0x81, 0x67, 0x92, 0x07, 0xa1, 0x71,
+	0xeb, 0x2a, 0x5f, 0xba, 0x83, 0xd6, 0xbd, 0x17, 0x86, 0xdf, 0x7b, 0xf0, 0xf9, 0x46, 0x65, 0xf4,
+	0x1e, 0xee, 0x05, 0xa7, 0xc7, 0xc7, 0xd1, 0x64, 0x1a, 0x4f, 0xc6, 0x93, 0x24, 0xd6, 0x23, 0x9c,
+	0x9e, 0x85, 0x27, 0x87, 0xd1, 0xc9, 0x27, 0xcb, 0xb0, 0xf7, 0x6f, 0x6e, 0x5d, 0xbc, 0xc1, 0x9d,
+	0xd1, 0x32, 0x67, 0x65, 0xd1, 0x85, 0xc7, 0x49, 0x10, 0x84, 0x71, 0x6c, 0x81, 0x0e, 0x3c, 0xae,
+	0xb3, 0x8c, 0x4a, 0xd9, 0x85, 0x1f, 0x8d, 0xa3, 0xcf, 0x09, 0x09, 0xad, 0x5e, 0x07, 0x7e, 0x94,
+	0xb2, 0xcb, 0x5a, 0x50, 0xf4, 0x0e, 0xbe, 0x7e, 0x0c, 0x0f, 0x09, 0x39, 0x25, 0x96, 0x69, 0xdb,
+	0x37, 0xb7, 0xee, 0xcb, 0x0d, 0x38, 0x14, 0x82, 0x0b, 0xbb, 0xff, 0xf5, 0x87, 0x63, 0x7c, 0x3c,
+	0xfc, 0x35, 0x77, 0xc0, 0xdd, 0xdc, 0x01, 0x7f, 0xe6, 0x0e, 0xf8, 0xb6, 0x70, 0x8c, 0xbb, 0x85,
+	0x63, 0xfc, 0x5e, 0x38, 0xc6, 0x97, 0x61, 0xc1, 0xd4, 0x45, 0x7d, 0xee, 0x67, 0x7c, 0x36, 0x5a,
+	0xfd, 0xde, 0xd5, 0xbc, 0xba, 0xdf, 0xd4, 0x75, 0x45, 0xe5, 0xf9, 0xb6, 0xfe, 0x99, 0x6f, 0xff,
+	0x0e, 0x00, 0x72, 0xc8, 0x60, 0x08, 0xe7, 0x02, 0x00, 0x00,
+}
+
+func (m *CommitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintCommitStatus(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintCommitStatus(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCommitStatus(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCommitStatus(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetUrl) > 0 {
+		i -= len(m.TargetUrl)
+		copy(dAtA[i:], m.TargetUrl)
+		i = encodeVarintCommitStatus(dAtA, i, uint64(len(m.TargetUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintCommitStatus(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintCommitStatus(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sha) > 0 {
+		i -= len(m.Sha)
+		copy(dAtA[i:], m.Sha)
+		i = encodeVarintCommitStatus(dAtA, i, uint64(len(m.Sha)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintCommitStatus(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommitStatus(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommitStatus(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovCommitStatus(uint64(m.RepositoryId))
+	}
+	l = len(m.Sha)
+	if l > 0 {
+		n += 1 + l + sovCommitStatus(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovCommitStatus(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovCommitStatus(uint64(m.State))
+	}
+	l = len(m.TargetUrl)
+	if l > 0 {
+		n += 1 + l + sovCommitStatus(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCommitStatus(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCommitStatus(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCommitStatus(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovCommitStatus(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovCommitStatus(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCommitStatus(x uint64) (n int) {
+	return sovCommitStatus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CommitStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommitStatus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CommitStatusState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommitStatus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommitStatus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCommitStatus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCommitStatus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCommitStatus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCommitStatus
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCommitStatus
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCommitStatus
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCommitStatus        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCommitStatus          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCommitStatus = fmt.Errorf("proto: unexpected end of group")
+)
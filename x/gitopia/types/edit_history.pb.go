@@ -0,0 +1,585 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/edit_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EditHistoryEntry is a prior revision of a comment body or, when commentIid
+// is 0, of an issue or pullRequest description
+type EditHistoryEntry struct {
+	RepositoryId uint64        `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Parent       CommentParent `protobuf:"varint,2,opt,name=parent,proto3,enum=gitopia.gitopia.gitopia.CommentParent" json:"parent,omitempty"`
+	ParentIid    uint64        `protobuf:"varint,3,opt,name=parentIid,proto3" json:"parentIid,omitempty"`
+	CommentIid   uint64        `protobuf:"varint,4,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	Revision     uint64        `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Editor       string        `protobuf:"bytes,6,opt,name=editor,proto3" json:"editor,omitempty"`
+	Body         string        `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	EditedAt     int64         `protobuf:"varint,8,opt,name=editedAt,proto3" json:"editedAt,omitempty"`
+}
+
+func (m *EditHistoryEntry) Reset()         { *m = EditHistoryEntry{} }
+func (m *EditHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*EditHistoryEntry) ProtoMessage()    {}
+func (*EditHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fab77c07ec6fc1, []int{0}
+}
+func (m *EditHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EditHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EditHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EditHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EditHistoryEntry.Merge(m, src)
+}
+func (m *EditHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EditHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EditHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EditHistoryEntry proto.InternalMessageInfo
+
+func (m *EditHistoryEntry) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *EditHistoryEntry) GetParent() CommentParent {
+	if m != nil {
+		return m.Parent
+	}
+	return CommentParentNone
+}
+
+func (m *EditHistoryEntry) GetParentIid() uint64 {
+	if m != nil {
+		return m.ParentIid
+	}
+	return 0
+}
+
+func (m *EditHistoryEntry) GetCommentIid() uint64 {
+	if m != nil {
+		return m.CommentIid
+	}
+	return 0
+}
+
+func (m *EditHistoryEntry) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *EditHistoryEntry) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *EditHistoryEntry) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *EditHistoryEntry) GetEditedAt() int64 {
+	if m != nil {
+		return m.EditedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EditHistoryEntry)(nil), "gitopia.gitopia.gitopia.EditHistoryEntry")
+}
+
+func init() { proto.RegisterFile("gitopia/edit_history.proto", fileDescriptor_43fab77c07ec6fc1) }
+
+var fileDescriptor_43fab77c07ec6fc1 = []byte{
+	// 288 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x50, 0xcb, 0x4a, 0xc3, 0x40,
+	0x14, 0xed, 0xb4, 0x31, 0xb6, 0x83, 0x88, 0x0c, 0xa8, 0x43, 0x90, 0x21, 0x74, 0x21, 0xc1, 0x45,
+	0x0a, 0xba, 0x17, 0x7c, 0x14, 0xec, 0x4e, 0xb2, 0x74, 0x23, 0x4d, 0x67, 0x68, 0x67, 0x91, 0xdc,
+	0x30, 0xb9, 0x8a, 0xf9, 0x0b, 0x97, 0x7e, 0x92, 0xcb, 0x2e, 0x5d, 0x4a, 0xf2, 0x23, 0x92, 0xc9,
+	0xc3, 0x07, 0xb8, 0x9a, 0xf3, 0xb8, 0xf7, 0x9e, 0xe1, 0x50, 0x6f, 0xad, 0x11, 0x32, 0xbd, 0x9c,
+	0x29, 0xa9, 0xf1, 0x71, 0xa3, 0x73, 0x04, 0x53, 0x84, 0x99, 0x01, 0x04, 0x76, 0xdc, 0x7a, 0xe1,
+	0x9f, 0xd7, 0x3b, 0xec, 0x96, 0x56, 0x90, 0x24, 0x2a, 0xc5, 0x66, 0x7e, 0xfa, 0x36, 0xa4, 0x07,
+	0x73, 0xa9, 0xf1, 0xae, 0xb9, 0x32, 0x4f, 0xd1, 0x14, 0x6c, 0x4a, 0xf7, 0x8c, 0xca, 0x20, 0xd7,
+	0xb5, 0xb4, 0x90, 0x9c, 0xf8, 0x24, 0x70, 0xa2, 0x5f, 0x1a, 0xbb, 0xa4, 0x6e, 0xb6, 0x34, 0x2a,
+	0x45, 0x3e, 0xf4, 0x49, 0xb0, 0x7f, 0x7e, 0x1a, 0xfe, 0x93, 0x1c, 0xde, 0x34, 0x81, 0xf7, 0x76,
+	0x3a, 0x6a, 0xb7, 0xd8, 0x09, 0x9d, 0x34, 0x68, 0xa1, 0x25, 0x1f, 0xd9, 0x80, 0x6f, 0x81, 0x09,
+	0x4a, 0xdb, 0x7f, 0xd6, 0xb6, 0x63, 0xed, 0x1f, 0x0a, 0xf3, 0xe8, 0xd8, 0xa8, 0x67, 0x9d, 0x6b,
+	0x48, 0xf9, 0x8e, 0x75, 0x7b, 0xce, 0x8e, 0xa8, 0x5b, 0x17, 0x03, 0x86, 0xbb, 0x3e, 0x09, 0x26,
+	0x51, 0xcb, 0x18, 0xa3, 0x4e, 0x0c, 0xb2, 0xe0, 0xbb, 0x56, 0xb5, 0xb8, 0xbe, 0x53, 0xbb, 0x4a,
+	0x5e, 0x21, 0x1f, 0xfb, 0x24, 0x18, 0x45, 0x3d, 0xbf, 0xbe, 0x7d, 0x2f, 0x05, 0xd9, 0x96, 0x82,
+	0x7c, 0x96, 0x82, 0xbc, 0x56, 0x62, 0xb0, 0xad, 0xc4, 0xe0, 0xa3, 0x12, 0x83, 0x87, 0xb3, 0xb5,
+	0xc6, 0xcd, 0x53, 0x1c, 0xae, 0x20, 0x99, 0x75, 0xb5, 0x76, 0xef, 0x4b, 0x8f, 0xb0, 0xc8, 0x54,
+	0x1e, 0xbb, 0xb6, 0xe7, 0x8b, 0xaf, 0x01, 0x00, 0x1a, 0xd7, 0x69, 0xdc, 0xb5, 0x01, 0x00, 0x00,
+}
+
+func (m *EditHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EditHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EditHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EditedAt != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.EditedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintEditHistory(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintEditHistory(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Revision != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CommentIid != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.CommentIid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ParentIid != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.ParentIid))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Parent != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.Parent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintEditHistory(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEditHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEditHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EditHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovEditHistory(uint64(m.RepositoryId))
+	}
+	if m.Parent != 0 {
+		n += 1 + sovEditHistory(uint64(m.Parent))
+	}
+	if m.ParentIid != 0 {
+		n += 1 + sovEditHistory(uint64(m.ParentIid))
+	}
+	if m.CommentIid != 0 {
+		n += 1 + sovEditHistory(uint64(m.CommentIid))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovEditHistory(uint64(m.Revision))
+	}
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovEditHistory(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovEditHistory(uint64(l))
+	}
+	if m.EditedAt != 0 {
+		n += 1 + sovEditHistory(uint64(m.EditedAt))
+	}
+	return n
+}
+
+func sovEditHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEditHistory(x uint64) (n int) {
+	return sovEditHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EditHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEditHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			m.Parent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parent |= CommentParent(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentIid", wireType)
+			}
+			m.ParentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentIid", wireType)
+			}
+			m.CommentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEditHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEditHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEditHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEditHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditedAt", wireType)
+			}
+			m.EditedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EditedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEditHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEditHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEditHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEditHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEditHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEditHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEditHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEditHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEditHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEditHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEditHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,6 +19,10 @@ func DefaultGenesis() *GenesisState {
 		// this line is used by starport scaffolding # genesis/types/default
 		TaskList:              []Task{},
 		BranchList:            []Branch{},
+		CommitStatusList:      []CommitStatus{},
+		EditHistoryList:       []EditHistoryEntry{},
+		MilestoneList:         []Milestone{},
+		IssueRedirectList:     []IssueRedirect{},
 		TagList:               []Tag{},
 		MemberList:            []Member{},
 		ReleaseList:           []Release{},
@@ -70,6 +74,46 @@ func (gs GenesisState) Validate() error {
 		branchMap[k] = true
 		branchIdMap[elem.Id] = true
 	}
+
+	// Check for duplicated commit status
+	commitStatusMap := make(map[string]bool)
+	for _, elem := range gs.CommitStatusList {
+		k := fmt.Sprintf("%v-%v/%v", elem.RepositoryId, elem.Sha, elem.Context)
+		if _, ok := commitStatusMap[k]; ok {
+			return fmt.Errorf("duplicated commit status")
+		}
+		commitStatusMap[k] = true
+	}
+	// Check for duplicated edit history revision
+	editHistoryMap := make(map[string]bool)
+	for _, elem := range gs.EditHistoryList {
+		if elem.Revision == 0 {
+			return fmt.Errorf("invalid edit history revision")
+		}
+		k := fmt.Sprintf("%v-%v-%v-%v-%v", elem.RepositoryId, elem.Parent, elem.ParentIid, elem.CommentIid, elem.Revision)
+		if _, ok := editHistoryMap[k]; ok {
+			return fmt.Errorf("duplicated edit history revision")
+		}
+		editHistoryMap[k] = true
+	}
+	// Check for duplicated milestone
+	milestoneMap := make(map[string]bool)
+	for _, elem := range gs.MilestoneList {
+		k := fmt.Sprintf("%v-%v", elem.RepositoryId, elem.Iid)
+		if _, ok := milestoneMap[k]; ok {
+			return fmt.Errorf("duplicated milestone")
+		}
+		milestoneMap[k] = true
+	}
+	// Check for duplicated issue redirect
+	issueRedirectMap := make(map[string]bool)
+	for _, elem := range gs.IssueRedirectList {
+		k := fmt.Sprintf("%v-%v", elem.RepositoryId, elem.Iid)
+		if _, ok := issueRedirectMap[k]; ok {
+			return fmt.Errorf("duplicated issue redirect")
+		}
+		issueRedirectMap[k] = true
+	}
 	// Check for duplicated ID in tag
 	tagIdMap := make(map[uint64]bool)
 	tagMap := make(map[string]bool)
@@ -25,8 +25,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the gitopia module's genesis state.
 type GenesisState struct {
-	ExercisedAmountList  []ExercisedAmount `protobuf:"bytes,30,rep,name=exercisedAmountList,proto3" json:"exercisedAmountList"`
-	ExercisedAmountCount uint64            `protobuf:"varint,31,opt,name=exercisedAmountCount,proto3" json:"exercisedAmountCount,omitempty"`
+	IssueRedirectList    []IssueRedirect    `protobuf:"bytes,35,rep,name=issueRedirectList,proto3" json:"issueRedirectList"`
+	MilestoneList        []Milestone        `protobuf:"bytes,34,rep,name=milestoneList,proto3" json:"milestoneList"`
+	EditHistoryList      []EditHistoryEntry `protobuf:"bytes,33,rep,name=editHistoryList,proto3" json:"editHistoryList"`
+	CommitStatusList     []CommitStatus     `protobuf:"bytes,32,rep,name=commitStatusList,proto3" json:"commitStatusList"`
+	ExercisedAmountList  []ExercisedAmount  `protobuf:"bytes,30,rep,name=exercisedAmountList,proto3" json:"exercisedAmountList"`
+	ExercisedAmountCount uint64             `protobuf:"varint,31,opt,name=exercisedAmountCount,proto3" json:"exercisedAmountCount,omitempty"`
 	// params defines all the paramaters of the module.
 	Params                Params              `protobuf:"bytes,29,opt,name=params,proto3" json:"params"`
 	BountyList            []Bounty            `protobuf:"bytes,27,rep,name=bountyList,proto3" json:"bountyList"`
@@ -93,6 +97,34 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetIssueRedirectList() []IssueRedirect {
+	if m != nil {
+		return m.IssueRedirectList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMilestoneList() []Milestone {
+	if m != nil {
+		return m.MilestoneList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEditHistoryList() []EditHistoryEntry {
+	if m != nil {
+		return m.EditHistoryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommitStatusList() []CommitStatus {
+	if m != nil {
+		return m.CommitStatusList
+	}
+	return nil
+}
+
 func (m *GenesisState) GetExercisedAmountList() []ExercisedAmount {
 	if m != nil {
 		return m.ExercisedAmountList
@@ -317,55 +349,63 @@ func init() {
 func init() { proto.RegisterFile("gitopia/genesis.proto", fileDescriptor_fe28ed7a80acf9ab) }
 
 var fileDescriptor_fe28ed7a80acf9ab = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xc7, 0x5b, 0x41, 0x3e, 0xa6, 0x7c, 0x0e, 0x20, 0xa5, 0xc2, 0xd2, 0xa0, 0x17, 0x0d, 0x17,
-	0x25, 0xc1, 0x5b, 0x8d, 0xb1, 0x40, 0xd4, 0xa8, 0x89, 0x56, 0x8c, 0x89, 0x37, 0x3a, 0x6d, 0xc7,
-	0x65, 0x03, 0xdb, 0xa9, 0x3b, 0xb3, 0x11, 0xde, 0xc2, 0xc7, 0xe2, 0x92, 0x4b, 0xaf, 0x8c, 0x81,
-	0xf8, 0x1e, 0x66, 0xce, 0x99, 0x8f, 0x65, 0x61, 0xd9, 0x1b, 0x3a, 0xf3, 0xdf, 0x73, 0xce, 0x6f,
-	0xe6, 0x3f, 0x67, 0x67, 0x21, 0x2b, 0x61, 0xa4, 0xc4, 0x28, 0x62, 0x3b, 0x21, 0x1f, 0x72, 0x19,
-	0xc9, 0xf6, 0x28, 0x11, 0x4a, 0xd0, 0x55, 0x23, 0xb7, 0x73, 0xbf, 0x0d, 0x6a, 0xe3, 0x15, 0x93,
-	0xc7, 0x18, 0xdc, 0x58, 0xb6, 0x5a, 0x2f, 0x61, 0xc3, 0xfe, 0x91, 0x51, 0x17, 0x7d, 0x64, 0x98,
-	0x0f, 0x8c, 0x79, 0xdc, 0xe3, 0xc9, 0x8d, 0x74, 0x91, 0x0e, 0xd5, 0x99, 0x53, 0x45, 0x28, 0x60,
-	0xb8, 0xa3, 0x47, 0x46, 0x75, 0xcb, 0x4d, 0xf8, 0x09, 0x67, 0x92, 0x1b, 0x79, 0xcd, 0xca, 0xa3,
-	0xf4, 0xe4, 0xa4, 0xcb, 0x7f, 0xa4, 0x5c, 0xaa, 0xfc, 0x32, 0x06, 0xec, 0x46, 0x91, 0xbe, 0x88,
-	0x63, 0x3e, 0xb4, 0x91, 0x4b, 0x56, 0x8e, 0xa4, 0x4c, 0x6d, 0xe5, 0xba, 0x07, 0x8e, 0x84, 0x8c,
-	0x94, 0x48, 0xec, 0x02, 0x9d, 0x13, 0xa9, 0x74, 0x5b, 0x71, 0x25, 0x7e, 0x1e, 0x09, 0xeb, 0xa5,
-	0xdf, 0xdf, 0x88, 0x25, 0x2c, 0xb6, 0x6a, 0x60, 0x55, 0x7e, 0xca, 0x93, 0x7e, 0x24, 0xf9, 0xe0,
-	0x2b, 0x8b, 0xb5, 0x01, 0xf8, 0x7c, 0xeb, 0xdf, 0x2c, 0x99, 0x79, 0x89, 0x67, 0xf2, 0x51, 0x31,
-	0xc5, 0xe9, 0x37, 0xb2, 0xe4, 0x42, 0x5f, 0x40, 0xe4, 0xdb, 0x48, 0xaa, 0x7a, 0xd0, 0x1c, 0x6b,
-	0xd5, 0x76, 0x5b, 0xed, 0x82, 0x03, 0x6b, 0x1f, 0x5c, 0xcf, 0xe9, 0x8c, 0x9f, 0xff, 0xd9, 0xac,
-	0x74, 0x6f, 0x2b, 0x45, 0x77, 0xc9, 0x72, 0x4e, 0xde, 0xd3, 0x7f, 0xea, 0x9b, 0xcd, 0x6a, 0x6b,
-	0xbc, 0x7b, 0xeb, 0x33, 0xfa, 0x8c, 0x4c, 0xe0, 0xb6, 0xea, 0x1b, 0xcd, 0x6a, 0xab, 0xb6, 0xbb,
-	0x59, 0xb8, 0x90, 0xf7, 0x10, 0x66, 0xf8, 0x26, 0x89, 0x1e, 0x10, 0x82, 0xa7, 0x0e, 0x7b, 0x79,
-	0x08, 0x7b, 0x29, 0x2e, 0xd1, 0x81, 0x50, 0x53, 0x22, 0x93, 0x48, 0x9b, 0xa4, 0x86, 0x33, 0x5c,
-	0xf0, 0x3a, 0x2c, 0x38, 0x2b, 0xd1, 0x57, 0xa4, 0xa6, 0xcf, 0x69, 0x9f, 0x09, 0x20, 0xad, 0x01,
-	0xa9, 0x59, 0x48, 0xfa, 0x84, 0xb1, 0x06, 0x95, 0x4d, 0xa5, 0xdf, 0xc9, 0x4a, 0x8f, 0x49, 0xde,
-	0x75, 0xfd, 0xf0, 0x86, 0xe3, 0xea, 0x1b, 0x50, 0x73, 0xbb, 0x78, 0xf5, 0xf9, 0x2c, 0x53, 0xfd,
-	0xf6, 0x72, 0xda, 0x1a, 0x7c, 0x4d, 0xa0, 0xf8, 0x6a, 0x89, 0x35, 0xef, 0x20, 0xd4, 0x5a, 0xe3,
-	0x13, 0xb5, 0x35, 0x38, 0x43, 0x6b, 0xea, 0x68, 0x4d, 0x46, 0xa2, 0x4f, 0xc9, 0xa4, 0x62, 0x21,
-	0x50, 0x56, 0x80, 0xb2, 0x5e, 0x48, 0x39, 0x64, 0xa1, 0x41, 0xd8, 0x14, 0xda, 0x20, 0x53, 0x8a,
-	0x85, 0x58, 0xfc, 0x01, 0x14, 0x77, 0x73, 0x38, 0x5d, 0xb8, 0x12, 0xa0, 0xf8, 0x52, 0xd9, 0xe9,
-	0x42, 0xa8, 0x3b, 0x5d, 0x97, 0x08, 0xa7, 0x0b, 0x33, 0xa4, 0x2c, 0x9b, 0xd3, 0xf5, 0x12, 0x7d,
-	0xae, 0x17, 0x21, 0x8f, 0x01, 0xb3, 0x08, 0x98, 0x8d, 0x3b, 0xf6, 0x20, 0x8f, 0x0d, 0xc4, 0x25,
-	0xd1, 0x75, 0x32, 0xad, 0xc7, 0x08, 0xa0, 0x00, 0xf0, 0x82, 0x6e, 0x1e, 0x73, 0xdf, 0x00, 0x61,
-	0xbe, 0xa4, 0x79, 0xba, 0x18, 0x6b, 0x9b, 0x27, 0x93, 0x4a, 0xb7, 0xc8, 0x8c, 0x99, 0x22, 0x6a,
-	0x01, 0x50, 0xd7, 0x34, 0x7a, 0x48, 0xe6, 0x33, 0xd7, 0x18, 0x10, 0x67, 0x81, 0xf8, 0xb8, 0xf8,
-	0xdd, 0xf2, 0xf1, 0x86, 0x9a, 0x2f, 0x41, 0xb7, 0xc9, 0x42, 0x46, 0x42, 0xfa, 0x1c, 0xd0, 0x6f,
-	0xe8, 0xba, 0x23, 0x06, 0xe6, 0x45, 0xa9, 0x95, 0x74, 0x84, 0x7f, 0x49, 0x6c, 0x8a, 0xee, 0x88,
-	0x01, 0x13, 0x48, 0x98, 0xc1, 0x8e, 0xb0, 0x73, 0xed, 0xa4, 0xb9, 0x74, 0xa1, 0xfa, 0x74, 0x89,
-	0x93, 0x7b, 0x18, 0x6b, 0x9d, 0xcc, 0xa4, 0x6a, 0x27, 0xcd, 0x14, 0x49, 0x04, 0x9d, 0xcc, 0x6a,
-	0xb4, 0x43, 0xa6, 0xe1, 0x2e, 0x07, 0xd6, 0x24, 0xb0, 0x82, 0x42, 0xd6, 0x6b, 0x1d, 0x69, 0x48,
-	0x3e, 0x8d, 0x06, 0x84, 0xc0, 0x04, 0x29, 0x53, 0x40, 0xc9, 0x28, 0xf4, 0x03, 0x99, 0xf3, 0x9f,
-	0x06, 0x00, 0xdd, 0x07, 0xd0, 0xa3, 0x3b, 0xda, 0xc3, 0x86, 0x1b, 0x5a, 0xae, 0x00, 0x6d, 0x91,
-	0x79, 0xaf, 0x20, 0x77, 0x02, 0xb8, 0x79, 0x59, 0xf7, 0xbd, 0xbe, 0x9a, 0x00, 0x3b, 0x56, 0xd2,
-	0xf7, 0xfa, 0x4a, 0xb3, 0x7d, 0x6f, 0x93, 0x74, 0xdf, 0xeb, 0x31, 0x42, 0xc6, 0xb1, 0xef, 0x9d,
-	0xa0, 0xfd, 0x83, 0x0f, 0x19, 0xd4, 0xaf, 0x96, 0xf8, 0xf7, 0x59, 0x47, 0x5a, 0xff, 0x5c, 0x9a,
-	0xf6, 0x0f, 0x26, 0x88, 0xb8, 0x87, 0xfe, 0x79, 0xa5, 0xb3, 0x7f, 0x7e, 0x19, 0x54, 0x2f, 0x2e,
-	0x83, 0xea, 0xdf, 0xcb, 0xa0, 0xfa, 0xeb, 0x2a, 0xa8, 0x5c, 0x5c, 0x05, 0x95, 0xdf, 0x57, 0x41,
-	0xe5, 0xcb, 0x76, 0x18, 0xa9, 0xa3, 0xb4, 0xd7, 0xee, 0x8b, 0x78, 0xc7, 0xfd, 0x97, 0x62, 0x7e,
-	0x4f, 0xdd, 0x48, 0x9d, 0x8d, 0xb8, 0xec, 0x4d, 0xc0, 0x47, 0xf3, 0xc9, 0xff, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x72, 0x6e, 0xdb, 0x31, 0xcf, 0x08, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x5d, 0x53, 0x13, 0x31,
+	0x14, 0x6d, 0x05, 0xf9, 0x48, 0x81, 0x96, 0x00, 0x52, 0x0a, 0x2c, 0xb5, 0xa8, 0x53, 0x79, 0x28,
+	0x33, 0xf8, 0xaa, 0xe3, 0x58, 0x60, 0xc4, 0x51, 0x1c, 0x2d, 0x38, 0x8c, 0xbc, 0x60, 0xda, 0xc6,
+	0xed, 0x0e, 0x6d, 0xb7, 0x6e, 0xd2, 0x11, 0xfe, 0x85, 0x7f, 0xca, 0x19, 0x1e, 0x79, 0xf4, 0xc9,
+	0x71, 0xe0, 0x8f, 0x38, 0xb9, 0x37, 0xc9, 0x2e, 0x5b, 0xb6, 0x7d, 0x81, 0xe4, 0xf4, 0xde, 0x73,
+	0x6e, 0x4e, 0x6e, 0x92, 0x25, 0x4b, 0xae, 0x27, 0xfd, 0x9e, 0xc7, 0xb6, 0x5d, 0xde, 0xe5, 0xc2,
+	0x13, 0x95, 0x5e, 0xe0, 0x4b, 0x9f, 0x2e, 0x6b, 0xb8, 0x12, 0xfb, 0x5f, 0xa0, 0x26, 0x5e, 0x32,
+	0x71, 0x8e, 0xc1, 0x85, 0x45, 0x83, 0xd5, 0x03, 0xd6, 0x6d, 0xb4, 0x34, 0x3a, 0x1f, 0x46, 0xba,
+	0xf1, 0xc0, 0x0e, 0xef, 0xd4, 0x79, 0x30, 0x90, 0xee, 0xf7, 0xbb, 0xf2, 0xd2, 0xa2, 0xbe, 0xeb,
+	0xc3, 0x70, 0x5b, 0x8d, 0x34, 0x6a, 0xcb, 0x0d, 0x78, 0x9b, 0x33, 0xc1, 0x35, 0xbc, 0x62, 0xe0,
+	0x5e, 0xbf, 0xdd, 0xae, 0xf1, 0x1f, 0x7d, 0x2e, 0x64, 0xbc, 0x8c, 0x26, 0x1b, 0x20, 0x69, 0xf8,
+	0x9d, 0x0e, 0xef, 0x9a, 0xc8, 0x05, 0x03, 0x7b, 0x42, 0xf4, 0x0d, 0x73, 0x3e, 0x14, 0xec, 0xf9,
+	0xc2, 0x93, 0x7e, 0x60, 0x0a, 0xb4, 0x4e, 0xf4, 0x05, 0x0f, 0xe2, 0x14, 0x3f, 0x5b, 0xbe, 0x27,
+	0xe2, 0xeb, 0xeb, 0xb1, 0x80, 0x75, 0x0c, 0xea, 0x18, 0x94, 0x5f, 0xf0, 0xa0, 0xe1, 0x09, 0xde,
+	0x3c, 0x63, 0x1d, 0x65, 0x80, 0xfe, 0x7d, 0x35, 0x5a, 0xa4, 0x27, 0xcf, 0x84, 0x64, 0xb2, 0x6f,
+	0x92, 0x0b, 0x36, 0xb9, 0xe9, 0xc9, 0xb3, 0x96, 0x27, 0x22, 0x75, 0x2d, 0x5b, 0x93, 0xbd, 0x36,
+	0x17, 0xd2, 0xef, 0xea, 0xa5, 0x94, 0x7e, 0xe7, 0xc8, 0xcc, 0x5b, 0xdc, 0xe5, 0x23, 0xc9, 0x24,
+	0xa7, 0xa7, 0x64, 0x1e, 0x96, 0x5a, 0xe3, 0x4d, 0x2f, 0xe0, 0x0d, 0xf9, 0xc1, 0x13, 0x32, 0xbf,
+	0x59, 0x1c, 0x2b, 0x67, 0x76, 0x9e, 0x55, 0x12, 0x1a, 0xa0, 0xf2, 0x2e, 0x9a, 0x51, 0x1d, 0xbf,
+	0xfa, 0xbb, 0x91, 0xaa, 0x0d, 0xd2, 0xd0, 0x8f, 0x64, 0xd6, 0xea, 0x03, 0x6f, 0x09, 0x78, 0x4b,
+	0x89, 0xbc, 0x87, 0x26, 0x5a, 0x73, 0xde, 0x4d, 0xa7, 0x5f, 0x49, 0x56, 0xad, 0xf5, 0x00, 0x97,
+	0x0a, 0x8c, 0x8f, 0x81, 0xf1, 0x79, 0x22, 0xe3, 0x7e, 0x18, 0xbf, 0xdf, 0x95, 0xc1, 0xa5, 0x26,
+	0x8e, 0xf3, 0xd0, 0x13, 0x92, 0x43, 0x8f, 0x8f, 0xc0, 0x62, 0xe0, 0x2e, 0x02, 0xf7, 0xd3, 0x44,
+	0xee, 0xdd, 0x48, 0x82, 0xe6, 0x1d, 0x20, 0xa1, 0xdf, 0xc8, 0x82, 0xdd, 0xdc, 0x37, 0xb0, 0xb7,
+	0xc0, 0xed, 0x00, 0x77, 0x39, 0xb9, 0xee, 0xbb, 0x39, 0x9a, 0xfe, 0x3e, 0x2a, 0xba, 0x43, 0x16,
+	0x63, 0xf0, 0xae, 0xfa, 0x93, 0xdf, 0x28, 0xa6, 0xcb, 0xe3, 0xb5, 0x7b, 0x7f, 0xa3, 0xaf, 0xc8,
+	0x04, 0x36, 0x62, 0x7e, 0xbd, 0x98, 0x2e, 0x67, 0x76, 0x36, 0x12, 0x0b, 0xf9, 0x04, 0x61, 0x5a,
+	0x5f, 0x27, 0xd1, 0x7d, 0x42, 0xf0, 0x9c, 0xc2, 0x5a, 0x56, 0x8b, 0x63, 0x43, 0x29, 0xaa, 0x10,
+	0xaa, 0x29, 0x22, 0x89, 0xb4, 0x48, 0x32, 0x38, 0xc3, 0x82, 0xd7, 0xa0, 0xe0, 0x28, 0x44, 0x0f,
+	0x48, 0x46, 0x9d, 0xac, 0x3d, 0xe6, 0x83, 0xd2, 0x0a, 0x28, 0x15, 0x13, 0x95, 0xbe, 0x60, 0xac,
+	0x96, 0x8a, 0xa6, 0xd2, 0xef, 0x64, 0xa9, 0xce, 0x04, 0xaf, 0xd9, 0x13, 0xfc, 0x9e, 0x63, 0xf5,
+	0x05, 0xe0, 0xdc, 0x4a, 0xae, 0x3e, 0x9e, 0xa5, 0xd9, 0xef, 0xa7, 0x53, 0xd6, 0xe0, 0xc5, 0x06,
+	0xe4, 0xcb, 0x23, 0xac, 0x39, 0x84, 0x50, 0x63, 0x4d, 0x98, 0xa8, 0xac, 0xc1, 0x19, 0x5a, 0x93,
+	0x47, 0x6b, 0x22, 0x10, 0x7d, 0x49, 0x26, 0x25, 0x73, 0x41, 0x65, 0x09, 0x54, 0xd6, 0x12, 0x55,
+	0x8e, 0x99, 0xab, 0x25, 0x4c, 0x0a, 0x2d, 0x90, 0x29, 0xc9, 0x5c, 0x24, 0x7f, 0x04, 0xe4, 0x76,
+	0x0e, 0xbb, 0x0b, 0x97, 0x38, 0x90, 0x2f, 0x8c, 0xda, 0x5d, 0x08, 0xb5, 0xbb, 0x6b, 0x13, 0x61,
+	0x77, 0x61, 0x86, 0x2a, 0x8b, 0x7a, 0x77, 0x43, 0x88, 0xbe, 0x56, 0x45, 0x88, 0x73, 0x90, 0x99,
+	0x07, 0x99, 0xf5, 0x21, 0x6b, 0x10, 0xe7, 0x5a, 0xc4, 0x26, 0xd1, 0x35, 0x32, 0xad, 0xc6, 0x28,
+	0x40, 0x41, 0x20, 0x04, 0x54, 0xf3, 0xe8, 0x17, 0x02, 0x14, 0xb2, 0x23, 0x9a, 0xa7, 0x86, 0xb1,
+	0xa6, 0x79, 0x22, 0xa9, 0xb4, 0x44, 0x66, 0xf4, 0x14, 0xa5, 0x72, 0x20, 0x75, 0x07, 0xa3, 0xc7,
+	0x24, 0x1b, 0x79, 0x78, 0x40, 0x71, 0x16, 0x14, 0x9f, 0x24, 0x9f, 0xad, 0x30, 0xde, 0xdc, 0x4b,
+	0x31, 0x0a, 0xba, 0x45, 0x72, 0x11, 0x08, 0xd5, 0xe7, 0x40, 0x7d, 0x00, 0x57, 0x1d, 0xd1, 0xd4,
+	0x07, 0x25, 0x33, 0xa2, 0x23, 0xc2, 0x43, 0x62, 0x52, 0x54, 0x47, 0x34, 0x99, 0x8f, 0x0a, 0x33,
+	0xd8, 0x11, 0x66, 0xae, 0x9c, 0xd4, 0xcf, 0x24, 0xb0, 0x4f, 0x8f, 0x70, 0x72, 0x17, 0x63, 0x8d,
+	0x93, 0x91, 0x54, 0xe5, 0xa4, 0x9e, 0xa2, 0x12, 0x41, 0x27, 0xa3, 0x18, 0xad, 0x92, 0x69, 0x78,
+	0x4b, 0x40, 0x6b, 0x12, 0xb4, 0x9c, 0xe1, 0x4f, 0x91, 0x56, 0x0a, 0xd3, 0xa8, 0x43, 0x08, 0x4c,
+	0x50, 0x65, 0x0a, 0x54, 0x22, 0x08, 0xfd, 0x4c, 0xe6, 0xc2, 0xc7, 0x1c, 0x84, 0x1e, 0x82, 0xd0,
+	0xe6, 0x90, 0xf6, 0x30, 0xe1, 0x5a, 0x2d, 0x46, 0x40, 0xcb, 0x24, 0x1b, 0x22, 0xa8, 0x3b, 0x01,
+	0xba, 0x71, 0x58, 0xf5, 0xbd, 0xba, 0x9a, 0x40, 0x76, 0x6c, 0x44, 0xdf, 0xab, 0x2b, 0xcd, 0xf4,
+	0xbd, 0x49, 0x52, 0x7d, 0xaf, 0xc6, 0x28, 0x32, 0x8e, 0x7d, 0x6f, 0x01, 0xe5, 0x1f, 0x7c, 0x7a,
+	0x00, 0x7f, 0x7a, 0x84, 0x7f, 0x27, 0x2a, 0xd2, 0xf8, 0x67, 0xd3, 0x94, 0x7f, 0x30, 0x41, 0x89,
+	0x07, 0xe8, 0x5f, 0x88, 0x54, 0xf7, 0xae, 0x6e, 0x9c, 0xf4, 0xf5, 0x8d, 0x93, 0xfe, 0x77, 0xe3,
+	0xa4, 0x7f, 0xdd, 0x3a, 0xa9, 0xeb, 0x5b, 0x27, 0xf5, 0xe7, 0xd6, 0x49, 0x9d, 0x6e, 0xb9, 0x9e,
+	0x6c, 0xf5, 0xeb, 0x95, 0x86, 0xdf, 0xd9, 0xb6, 0xdf, 0x95, 0xfa, 0xff, 0x85, 0x1d, 0xc9, 0xcb,
+	0x1e, 0x17, 0xf5, 0x09, 0xf8, 0x28, 0x79, 0xf1, 0x7f, 0x00, 0x19, 0x41, 0xcc, 0x00, 0x81, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -388,6 +428,70 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IssueRedirectList) > 0 {
+		for iNdEx := len(m.IssueRedirectList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IssueRedirectList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MilestoneList) > 0 {
+		for iNdEx := len(m.MilestoneList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MilestoneList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.EditHistoryList) > 0 {
+		for iNdEx := len(m.EditHistoryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EditHistoryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.CommitStatusList) > 0 {
+		for iNdEx := len(m.CommitStatusList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitStatusList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if m.ExercisedAmountCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ExercisedAmountCount))
 		i--
@@ -884,6 +988,30 @@ func (m *GenesisState) Size() (n int) {
 	if m.ExercisedAmountCount != 0 {
 		n += 2 + sovGenesis(uint64(m.ExercisedAmountCount))
 	}
+	if len(m.CommitStatusList) > 0 {
+		for _, e := range m.CommitStatusList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EditHistoryList) > 0 {
+		for _, e := range m.EditHistoryList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MilestoneList) > 0 {
+		for _, e := range m.MilestoneList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IssueRedirectList) > 0 {
+		for _, e := range m.IssueRedirectList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1765,6 +1893,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitStatusList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitStatusList = append(m.CommitStatusList, CommitStatus{})
+			if err := m.CommitStatusList[len(m.CommitStatusList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditHistoryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EditHistoryList = append(m.EditHistoryList, EditHistoryEntry{})
+			if err := m.EditHistoryList[len(m.EditHistoryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MilestoneList = append(m.MilestoneList, Milestone{})
+			if err := m.MilestoneList[len(m.MilestoneList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssueRedirectList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssueRedirectList = append(m.IssueRedirectList, IssueRedirect{})
+			if err := m.IssueRedirectList[len(m.IssueRedirectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -629,6 +629,74 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated edit history revision",
+			genState: &types.GenesisState{
+				EditHistoryList: []types.EditHistoryEntry{
+					{
+						ParentIid:  1,
+						CommentIid: 1,
+						Revision:   1,
+					},
+					{
+						ParentIid:  1,
+						CommentIid: 1,
+						Revision:   1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid edit history revision",
+			genState: &types.GenesisState{
+				EditHistoryList: []types.EditHistoryEntry{
+					{
+						ParentIid:  1,
+						CommentIid: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated milestone",
+			genState: &types.GenesisState{
+				MilestoneList: []types.Milestone{
+					{
+						RepositoryId: 1,
+						Iid:          1,
+						Title:        "v1.0",
+					},
+					{
+						RepositoryId: 1,
+						Iid:          1,
+						Title:        "v1.1",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated issue redirect",
+			genState: &types.GenesisState{
+				IssueRedirectList: []types.IssueRedirect{
+					{
+						RepositoryId:       1,
+						Iid:                1,
+						TargetRepositoryId: 2,
+						TargetIid:          1,
+					},
+					{
+						RepositoryId:       1,
+						Iid:                1,
+						TargetRepositoryId: 3,
+						TargetIid:          1,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -23,6 +23,117 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type LockReason int32
+
+const (
+	LockReasonNone      LockReason = 0
+	LockReasonOffTopic  LockReason = 1
+	LockReasonTooHeated LockReason = 2
+	LockReasonResolved  LockReason = 3
+	LockReasonSpam      LockReason = 4
+)
+
+var LockReason_name = map[int32]string{
+	0: "LOCK_REASON_NONE",
+	1: "LOCK_REASON_OFF_TOPIC",
+	2: "LOCK_REASON_TOO_HEATED",
+	3: "LOCK_REASON_RESOLVED",
+	4: "LOCK_REASON_SPAM",
+}
+
+var LockReason_value = map[string]int32{
+	"LOCK_REASON_NONE":       0,
+	"LOCK_REASON_OFF_TOPIC":  1,
+	"LOCK_REASON_TOO_HEATED": 2,
+	"LOCK_REASON_RESOLVED":   3,
+	"LOCK_REASON_SPAM":       4,
+}
+
+func (x LockReason) String() string {
+	return proto.EnumName(LockReason_name, int32(x))
+}
+
+func (LockReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{0}
+}
+
+// CloseReason records why an issue or pull request was closed. It stays
+// CLOSE_REASON_UNSPECIFIED when closed without a reason and is reset to it
+// when reopened.
+type CloseReason int32
+
+const (
+	CloseReasonUnspecified CloseReason = 0
+	CloseReasonCompleted   CloseReason = 1
+	CloseReasonWontFix     CloseReason = 2
+	CloseReasonDuplicate   CloseReason = 3
+	CloseReasonStale       CloseReason = 4
+	CloseReasonMerged      CloseReason = 5
+)
+
+var CloseReason_name = map[int32]string{
+	0: "CLOSE_REASON_UNSPECIFIED",
+	1: "CLOSE_REASON_COMPLETED",
+	2: "CLOSE_REASON_WONT_FIX",
+	3: "CLOSE_REASON_DUPLICATE",
+	4: "CLOSE_REASON_STALE",
+	5: "CLOSE_REASON_MERGED",
+}
+
+var CloseReason_value = map[string]int32{
+	"CLOSE_REASON_UNSPECIFIED": 0,
+	"CLOSE_REASON_COMPLETED":   1,
+	"CLOSE_REASON_WONT_FIX":    2,
+	"CLOSE_REASON_DUPLICATE":   3,
+	"CLOSE_REASON_STALE":       4,
+	"CLOSE_REASON_MERGED":      5,
+}
+
+func (x CloseReason) String() string {
+	return proto.EnumName(CloseReason_name, int32(x))
+}
+
+func (CloseReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{1}
+}
+
+type IssueLinkType int32
+
+const (
+	IssueLinkTypeUnspecified  IssueLinkType = 0
+	IssueLinkTypeRelatesTo    IssueLinkType = 1
+	IssueLinkTypeBlocks       IssueLinkType = 2
+	IssueLinkTypeBlockedBy    IssueLinkType = 3
+	IssueLinkTypeDuplicateOf  IssueLinkType = 4
+	IssueLinkTypeDuplicatedBy IssueLinkType = 5
+)
+
+var IssueLinkType_name = map[int32]string{
+	0: "ISSUE_LINK_TYPE_UNSPECIFIED",
+	1: "ISSUE_LINK_TYPE_RELATES_TO",
+	2: "ISSUE_LINK_TYPE_BLOCKS",
+	3: "ISSUE_LINK_TYPE_BLOCKED_BY",
+	4: "ISSUE_LINK_TYPE_DUPLICATE_OF",
+	5: "ISSUE_LINK_TYPE_DUPLICATED_BY",
+}
+
+var IssueLinkType_value = map[string]int32{
+	"ISSUE_LINK_TYPE_UNSPECIFIED":   0,
+	"ISSUE_LINK_TYPE_RELATES_TO":    1,
+	"ISSUE_LINK_TYPE_BLOCKS":        2,
+	"ISSUE_LINK_TYPE_BLOCKED_BY":    3,
+	"ISSUE_LINK_TYPE_DUPLICATE_OF":  4,
+	"ISSUE_LINK_TYPE_DUPLICATED_BY": 5,
+}
+
+func (x IssueLinkType) String() string {
+	return proto.EnumName(IssueLinkType_name, int32(x))
+}
+
+func (IssueLinkType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{2}
+}
+
 type Issue_State int32
 
 const (
@@ -45,7 +156,168 @@ func (x Issue_State) String() string {
 }
 
 func (Issue_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4cf64e56e9098bda, []int{0, 0}
+	return fileDescriptor_4cf64e56e9098bda, []int{2, 0}
+}
+
+type IssueLink struct {
+	LinkType     IssueLinkType `protobuf:"varint,1,opt,name=linkType,proto3,enum=gitopia.gitopia.gitopia.IssueLinkType" json:"linkType,omitempty"`
+	RepositoryId uint64        `protobuf:"varint,2,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Iid          uint64        `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`
+	Creator      string        `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt    int64         `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *IssueLink) Reset()         { *m = IssueLink{} }
+func (m *IssueLink) String() string { return proto.CompactTextString(m) }
+func (*IssueLink) ProtoMessage()    {}
+func (*IssueLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{0}
+}
+func (m *IssueLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssueLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueLink.Merge(m, src)
+}
+func (m *IssueLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueLink proto.InternalMessageInfo
+
+func (m *IssueLink) GetLinkType() IssueLinkType {
+	if m != nil {
+		return m.LinkType
+	}
+	return IssueLinkTypeUnspecified
+}
+
+func (m *IssueLink) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *IssueLink) GetIid() uint64 {
+	if m != nil {
+		return m.Iid
+	}
+	return 0
+}
+
+func (m *IssueLink) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *IssueLink) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// IssueRedirect points the repository/iid of a transferred issue to its new location
+type IssueRedirect struct {
+	RepositoryId       uint64 `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Iid                uint64 `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	TargetRepositoryId uint64 `protobuf:"varint,3,opt,name=targetRepositoryId,proto3" json:"targetRepositoryId,omitempty"`
+	TargetIid          uint64 `protobuf:"varint,4,opt,name=targetIid,proto3" json:"targetIid,omitempty"`
+	Creator            string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt          int64  `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (m *IssueRedirect) Reset()         { *m = IssueRedirect{} }
+func (m *IssueRedirect) String() string { return proto.CompactTextString(m) }
+func (*IssueRedirect) ProtoMessage()    {}
+func (*IssueRedirect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{1}
+}
+func (m *IssueRedirect) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IssueRedirect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IssueRedirect.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IssueRedirect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueRedirect.Merge(m, src)
+}
+func (m *IssueRedirect) XXX_Size() int {
+	return m.Size()
+}
+func (m *IssueRedirect) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueRedirect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueRedirect proto.InternalMessageInfo
+
+func (m *IssueRedirect) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *IssueRedirect) GetIid() uint64 {
+	if m != nil {
+		return m.Iid
+	}
+	return 0
+}
+
+func (m *IssueRedirect) GetTargetRepositoryId() uint64 {
+	if m != nil {
+		return m.TargetRepositoryId
+	}
+	return 0
+}
+
+func (m *IssueRedirect) GetTargetIid() uint64 {
+	if m != nil {
+		return m.TargetIid
+	}
+	return 0
+}
+
+func (m *IssueRedirect) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *IssueRedirect) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
 type Issue struct {
@@ -66,13 +338,21 @@ type Issue struct {
 	UpdatedAt     int64             `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
 	ClosedAt      int64             `protobuf:"varint,16,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
 	ClosedBy      string            `protobuf:"bytes,17,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
+	BountySplit   BountySplit       `protobuf:"bytes,18,opt,name=bountySplit,proto3" json:"bountySplit"`
+	Reactions     []*Reaction       `protobuf:"bytes,19,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Locked        bool              `protobuf:"varint,20,opt,name=locked,proto3" json:"locked,omitempty"`
+	LockReason    LockReason        `protobuf:"varint,21,opt,name=lockReason,proto3,enum=gitopia.gitopia.gitopia.LockReason" json:"lockReason,omitempty"`
+	Milestone     uint64            `protobuf:"varint,22,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	Links         []IssueLink       `protobuf:"bytes,23,rep,name=links,proto3" json:"links"`
+	CloseReason   CloseReason       `protobuf:"varint,24,opt,name=closeReason,proto3,enum=gitopia.gitopia.gitopia.CloseReason" json:"closeReason,omitempty"`
+	DueDate       int64             `protobuf:"varint,25,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
 }
 
 func (m *Issue) Reset()         { *m = Issue{} }
 func (m *Issue) String() string { return proto.CompactTextString(m) }
 func (*Issue) ProtoMessage()    {}
 func (*Issue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4cf64e56e9098bda, []int{0}
+	return fileDescriptor_4cf64e56e9098bda, []int{2}
 }
 func (m *Issue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,47 +500,216 @@ func (m *Issue) GetClosedBy() string {
 	return ""
 }
 
+func (m *Issue) GetBountySplit() BountySplit {
+	if m != nil {
+		return m.BountySplit
+	}
+	return BountySplit{}
+}
+
+func (m *Issue) GetReactions() []*Reaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *Issue) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *Issue) GetLockReason() LockReason {
+	if m != nil {
+		return m.LockReason
+	}
+	return LockReasonNone
+}
+
+func (m *Issue) GetMilestone() uint64 {
+	if m != nil {
+		return m.Milestone
+	}
+	return 0
+}
+
+func (m *Issue) GetLinks() []IssueLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *Issue) GetCloseReason() CloseReason {
+	if m != nil {
+		return m.CloseReason
+	}
+	return CloseReasonUnspecified
+}
+
+func (m *Issue) GetDueDate() int64 {
+	if m != nil {
+		return m.DueDate
+	}
+	return 0
+}
+
+// AssigneeWeight sums the weight of the open issues assigned to an assignee
+type AssigneeWeight struct {
+	Assignee   string `protobuf:"bytes,1,opt,name=assignee,proto3" json:"assignee,omitempty"`
+	Weight     uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	OpenIssues uint64 `protobuf:"varint,3,opt,name=openIssues,proto3" json:"openIssues,omitempty"`
+}
+
+func (m *AssigneeWeight) Reset()         { *m = AssigneeWeight{} }
+func (m *AssigneeWeight) String() string { return proto.CompactTextString(m) }
+func (*AssigneeWeight) ProtoMessage()    {}
+func (*AssigneeWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cf64e56e9098bda, []int{3}
+}
+func (m *AssigneeWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssigneeWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssigneeWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssigneeWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssigneeWeight.Merge(m, src)
+}
+func (m *AssigneeWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssigneeWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssigneeWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssigneeWeight proto.InternalMessageInfo
+
+func (m *AssigneeWeight) GetAssignee() string {
+	if m != nil {
+		return m.Assignee
+	}
+	return ""
+}
+
+func (m *AssigneeWeight) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *AssigneeWeight) GetOpenIssues() uint64 {
+	if m != nil {
+		return m.OpenIssues
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.LockReason", LockReason_name, LockReason_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.CloseReason", CloseReason_name, CloseReason_value)
+	proto.RegisterEnum("gitopia.gitopia.gitopia.IssueLinkType", IssueLinkType_name, IssueLinkType_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.Issue_State", Issue_State_name, Issue_State_value)
+	proto.RegisterType((*IssueLink)(nil), "gitopia.gitopia.gitopia.IssueLink")
+	proto.RegisterType((*IssueRedirect)(nil), "gitopia.gitopia.gitopia.IssueRedirect")
 	proto.RegisterType((*Issue)(nil), "gitopia.gitopia.gitopia.Issue")
+	proto.RegisterType((*AssigneeWeight)(nil), "gitopia.gitopia.gitopia.AssigneeWeight")
 }
 
 func init() { proto.RegisterFile("gitopia/issue.proto", fileDescriptor_4cf64e56e9098bda) }
 
 var fileDescriptor_4cf64e56e9098bda = []byte{
-	// 451 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xc6, 0x76, 0x9a, 0x4c, 0xd2, 0x10, 0x96, 0x0a, 0x46, 0x11, 0x58, 0x56, 0x54, 0x09,
-	0x8b, 0x83, 0x23, 0x95, 0x1b, 0x37, 0xfa, 0x71, 0x88, 0x40, 0xb4, 0x72, 0x6f, 0xdc, 0x1c, 0x7b,
-	0xe5, 0xae, 0xe4, 0x64, 0x8d, 0x77, 0x2d, 0xc8, 0xbf, 0xe0, 0x67, 0x21, 0x71, 0xe9, 0x91, 0x23,
-	0x4a, 0xfe, 0x08, 0xda, 0x75, 0x6c, 0x93, 0x4a, 0x39, 0xed, 0xbc, 0xf7, 0xe6, 0x3d, 0x8f, 0x77,
-	0x07, 0x5e, 0xa4, 0x5c, 0x89, 0x9c, 0x47, 0x73, 0x2e, 0x65, 0xc9, 0x82, 0xbc, 0x10, 0x4a, 0xd0,
-	0x57, 0x7b, 0x32, 0x78, 0x72, 0x4e, 0xcf, 0x52, 0x91, 0x0a, 0xd3, 0x33, 0xd7, 0x55, 0xd5, 0x3e,
-	0xc5, 0x3a, 0xa3, 0x60, 0xb9, 0x90, 0x5c, 0x89, 0x62, 0x53, 0x29, 0xb3, 0xdf, 0x36, 0x38, 0x0b,
-	0x1d, 0x4c, 0x11, 0x4e, 0xe2, 0x82, 0x45, 0x4a, 0x14, 0x48, 0x3c, 0xe2, 0x0f, 0xc2, 0x1a, 0xd2,
-	0x31, 0x74, 0x79, 0x82, 0x5d, 0x8f, 0xf8, 0x76, 0xd8, 0xe5, 0x09, 0x9d, 0x80, 0xc5, 0x79, 0x82,
-	0x96, 0x21, 0x74, 0x49, 0xcf, 0xc0, 0x51, 0x5c, 0x65, 0x0c, 0x6d, 0xe3, 0xac, 0x00, 0xfd, 0x00,
-	0x8e, 0x54, 0x91, 0x62, 0xe8, 0x78, 0xc4, 0x1f, 0x5f, 0x9c, 0x07, 0x47, 0x86, 0x0e, 0xcc, 0x00,
-	0xc1, 0xbd, 0xee, 0x0d, 0x2b, 0x0b, 0xf5, 0x60, 0x98, 0x30, 0x19, 0x17, 0x3c, 0x57, 0x5c, 0xac,
-	0xb1, 0x67, 0x72, 0xff, 0xa7, 0xe8, 0x39, 0x9c, 0xc6, 0x62, 0xb5, 0x62, 0x6b, 0x25, 0xaf, 0x44,
-	0xb9, 0x56, 0x78, 0x62, 0xe6, 0x39, 0x24, 0xe9, 0x27, 0x18, 0xe5, 0x65, 0x96, 0x85, 0xec, 0x5b,
-	0xc9, 0xa4, 0x92, 0xd8, 0xf7, 0x2c, 0x7f, 0x78, 0xf1, 0xf6, 0xe8, 0x28, 0x77, 0x6d, 0xf3, 0x82,
-	0x27, 0xe1, 0x81, 0x99, 0xce, 0x60, 0xd4, 0x5e, 0xe0, 0x22, 0xc1, 0x81, 0xf9, 0xe2, 0x01, 0x47,
-	0x5f, 0x42, 0x2f, 0x8b, 0x96, 0x2c, 0x93, 0x08, 0x9e, 0xe5, 0xdb, 0xe1, 0x1e, 0x69, 0xfe, 0x3b,
-	0xe3, 0xe9, 0x83, 0xc2, 0xa1, 0x71, 0xed, 0x11, 0x7d, 0x0d, 0x83, 0x48, 0x4a, 0x9e, 0xae, 0x19,
-	0x93, 0x38, 0xf2, 0x2c, 0x7f, 0x10, 0xb6, 0x04, 0x9d, 0x42, 0x7f, 0xa9, 0xff, 0x83, 0x33, 0x89,
-	0xa7, 0x26, 0xaf, 0xc1, 0xda, 0x69, 0x5e, 0x88, 0x25, 0x1f, 0x15, 0x8e, 0x3d, 0xe2, 0x5b, 0x61,
-	0x4b, 0x68, 0xb5, 0xcc, 0x93, 0xbd, 0xfa, 0xac, 0x52, 0x1b, 0x42, 0xe7, 0xc6, 0x99, 0x90, 0x46,
-	0x9c, 0x18, 0xb1, 0xc1, 0xad, 0x76, 0xb9, 0xc1, 0xe7, 0xe6, 0xde, 0x1b, 0x3c, 0x7b, 0x03, 0x8e,
-	0x79, 0x26, 0xda, 0x07, 0xfb, 0xf6, 0xee, 0xe6, 0xcb, 0xa4, 0x43, 0x01, 0x7a, 0x57, 0x9f, 0x6f,
-	0xef, 0x6f, 0xae, 0x27, 0xe4, 0xf2, 0xfa, 0xd7, 0xd6, 0x25, 0x8f, 0x5b, 0x97, 0xfc, 0xdd, 0xba,
-	0xe4, 0xe7, 0xce, 0xed, 0x3c, 0xee, 0xdc, 0xce, 0x9f, 0x9d, 0xdb, 0xf9, 0xfa, 0x2e, 0xe5, 0xea,
-	0xa1, 0x5c, 0x06, 0xb1, 0x58, 0xcd, 0xeb, 0x65, 0xac, 0xcf, 0x1f, 0x4d, 0xa5, 0x36, 0x39, 0x93,
-	0xcb, 0x9e, 0x59, 0xcd, 0xf7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x93, 0x53, 0xb7, 0xfa,
-	0x02, 0x00, 0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xf3, 0xb1, 0xdd, 0xbc, 0x74, 0x17, 0x77, 0x36, 0x9b, 0xba, 0xa6, 0x0d, 0x66, 0xa9,
+	0x20, 0xaa, 0x50, 0x4a, 0x5b, 0x0e, 0xa8, 0x12, 0x85, 0x7c, 0x38, 0x34, 0x6a, 0x36, 0x8e, 0xc6,
+	0x5e, 0x4a, 0xb9, 0x44, 0xd9, 0x78, 0x36, 0x1d, 0xd5, 0x1b, 0x9b, 0x78, 0x02, 0xdd, 0x1b, 0x12,
+	0x17, 0x94, 0x13, 0xff, 0x40, 0x4e, 0xfc, 0x1d, 0xdc, 0x2b, 0xb8, 0xf4, 0xc8, 0x09, 0xa1, 0xf6,
+	0x8f, 0xe0, 0x8a, 0x66, 0xec, 0xf8, 0x23, 0x9b, 0xdd, 0x9e, 0x32, 0xef, 0xbd, 0xdf, 0x6f, 0xde,
+	0xf7, 0xc4, 0xb0, 0x37, 0xa1, 0xcc, 0xf5, 0xe8, 0xe8, 0x2e, 0xf5, 0xfd, 0x39, 0xa9, 0x7b, 0x33,
+	0x97, 0xb9, 0xe8, 0x7a, 0xa8, 0xac, 0xaf, 0xfd, 0xaa, 0xe5, 0x89, 0x3b, 0x71, 0x05, 0xe6, 0x2e,
+	0x3f, 0x05, 0x70, 0x55, 0x59, 0xdd, 0x31, 0x23, 0x9e, 0xeb, 0x53, 0xe6, 0xce, 0xce, 0x42, 0x4b,
+	0x79, 0x65, 0x39, 0x76, 0xe7, 0x53, 0xb6, 0xd2, 0x56, 0x62, 0xfc, 0x68, 0xcc, 0xa8, 0x3b, 0x0d,
+	0xf4, 0x07, 0x7f, 0x48, 0x50, 0xec, 0xf2, 0x30, 0x7a, 0x74, 0xfa, 0x02, 0x35, 0x61, 0xdb, 0xa1,
+	0xd3, 0x17, 0xd6, 0x99, 0x47, 0x14, 0x49, 0x93, 0x6a, 0xbb, 0xf7, 0x3f, 0xae, 0x5f, 0x10, 0x57,
+	0x3d, 0x62, 0x71, 0x34, 0x8e, 0x78, 0xe8, 0x00, 0xae, 0xc6, 0x31, 0x75, 0x6d, 0x25, 0xab, 0x49,
+	0xb5, 0x3c, 0x4e, 0xe9, 0x90, 0x0c, 0x39, 0x4a, 0x6d, 0x25, 0x27, 0x4c, 0xfc, 0x88, 0x14, 0xb8,
+	0x32, 0x9e, 0x91, 0x11, 0x73, 0x67, 0x4a, 0x5e, 0x93, 0x6a, 0x45, 0xbc, 0x12, 0xd1, 0x4d, 0x28,
+	0x8a, 0x23, 0xb1, 0x1b, 0x4c, 0x29, 0x68, 0x52, 0x2d, 0x87, 0x63, 0xc5, 0xc1, 0x5f, 0x12, 0xec,
+	0x88, 0x48, 0x30, 0xb1, 0xe9, 0x8c, 0x8c, 0xd9, 0x39, 0xff, 0xd2, 0xc5, 0xfe, 0xb3, 0xb1, 0xff,
+	0x3a, 0x20, 0x36, 0x9a, 0x4d, 0x08, 0xc3, 0x49, 0x6e, 0x10, 0xe0, 0x06, 0x0b, 0x8f, 0x2a, 0xd0,
+	0x76, 0xa9, 0x2d, 0x22, 0xce, 0xe3, 0x58, 0x91, 0xcc, 0xa6, 0x70, 0x49, 0x36, 0x5b, 0xeb, 0xd9,
+	0xfc, 0x77, 0x05, 0x0a, 0x22, 0x9b, 0xe4, 0x0d, 0x52, 0xfa, 0x86, 0x5d, 0xc8, 0x46, 0xa1, 0x67,
+	0xe9, 0xa6, 0x5a, 0x96, 0xa1, 0xc0, 0x28, 0x73, 0x48, 0x58, 0xc9, 0x40, 0x40, 0x0f, 0xa1, 0xe0,
+	0xb3, 0x11, 0x23, 0x22, 0xa2, 0xdd, 0xfb, 0xb7, 0x2f, 0x6f, 0x6c, 0xdd, 0xe4, 0x58, 0x1c, 0x50,
+	0x90, 0x06, 0x25, 0x9b, 0xf8, 0xe3, 0x19, 0xf5, 0xf8, 0xe8, 0x88, 0xb8, 0x8b, 0x38, 0xa9, 0x42,
+	0xb7, 0x61, 0x67, 0xec, 0x9e, 0x9e, 0x92, 0x29, 0xf3, 0x5b, 0x7c, 0xee, 0x94, 0x2b, 0x22, 0x9e,
+	0xb4, 0x12, 0x3d, 0x81, 0xab, 0xde, 0xdc, 0x71, 0x30, 0xf9, 0x61, 0x4e, 0x7c, 0xe6, 0x2b, 0xdb,
+	0x5a, 0xae, 0x56, 0xba, 0xff, 0xc9, 0x85, 0xa1, 0x0c, 0x62, 0x70, 0x97, 0xda, 0x38, 0x45, 0x3e,
+	0xd7, 0xe8, 0xe2, 0x86, 0x46, 0x57, 0x60, 0xcb, 0x19, 0x1d, 0x13, 0xc7, 0x57, 0x40, 0xcb, 0xd5,
+	0xf2, 0x38, 0x94, 0xb8, 0xfe, 0x27, 0x42, 0x27, 0xcf, 0x99, 0x52, 0x12, 0xac, 0x50, 0xe2, 0xed,
+	0x19, 0xf9, 0x3e, 0x9d, 0x4c, 0x09, 0xf1, 0x95, 0xab, 0x5a, 0xae, 0x56, 0xc4, 0xb1, 0x02, 0xa9,
+	0xb0, 0x2d, 0x96, 0x8a, 0x12, 0x5f, 0xd9, 0x11, 0xf7, 0x45, 0x72, 0xba, 0xb1, 0xbb, 0x6b, 0x8d,
+	0xe5, 0xd6, 0xb9, 0x67, 0x87, 0xd6, 0xf7, 0x02, 0x6b, 0xa4, 0xe0, 0xf7, 0x8e, 0x1d, 0xd7, 0x17,
+	0x46, 0x59, 0x18, 0x23, 0x39, 0xb6, 0x35, 0xcf, 0x94, 0x6b, 0xa2, 0xee, 0x91, 0x8c, 0x7a, 0x50,
+	0x0a, 0x96, 0xdc, 0xf4, 0x1c, 0xca, 0x14, 0xa4, 0x49, 0xb5, 0xd2, 0x25, 0x8d, 0x6d, 0xc6, 0xd8,
+	0x66, 0xfe, 0xd5, 0x3f, 0x1f, 0x64, 0x70, 0x92, 0x8e, 0xbe, 0x82, 0xe2, 0xea, 0x71, 0xf0, 0x95,
+	0x3d, 0xd1, 0x99, 0x0f, 0x2f, 0xbc, 0x0b, 0x87, 0x48, 0x1c, 0x73, 0x44, 0xb1, 0xdd, 0xf1, 0x0b,
+	0x62, 0x2b, 0x65, 0x4d, 0xaa, 0x6d, 0xe3, 0x50, 0x42, 0x2d, 0x00, 0x7e, 0xc2, 0x64, 0xe4, 0xbb,
+	0x53, 0x65, 0x5f, 0x8c, 0xdf, 0x47, 0x17, 0xde, 0xdc, 0x8b, 0xa0, 0x38, 0x41, 0xe3, 0x15, 0x3c,
+	0xa5, 0x0e, 0xf1, 0x99, 0x3b, 0x25, 0x4a, 0x25, 0x58, 0xb8, 0x48, 0x81, 0x1e, 0x41, 0x81, 0x3f,
+	0x40, 0xbe, 0x72, 0x5d, 0xc4, 0x7d, 0xf0, 0xee, 0x57, 0x2b, 0xac, 0x40, 0x40, 0x43, 0x1d, 0x28,
+	0x89, 0xaa, 0x86, 0x31, 0x2a, 0xef, 0x58, 0x91, 0x56, 0x8c, 0xc5, 0x49, 0x22, 0x5f, 0x5b, 0x7b,
+	0x4e, 0xda, 0x7c, 0xcd, 0x6e, 0x88, 0x46, 0xae, 0xc4, 0x83, 0x5b, 0x50, 0x10, 0x2b, 0x85, 0xb6,
+	0x21, 0x6f, 0x0c, 0xf4, 0xbe, 0x9c, 0x41, 0x00, 0x5b, 0xad, 0x9e, 0x61, 0xea, 0x6d, 0x59, 0x3a,
+	0xb0, 0x61, 0xb7, 0x11, 0xce, 0xd9, 0xd3, 0x60, 0x14, 0x55, 0xd8, 0x5e, 0x4d, 0x5e, 0xf8, 0x04,
+	0x44, 0x72, 0x62, 0x7c, 0xb3, 0xa9, 0xf1, 0xad, 0x02, 0xb8, 0x1e, 0x99, 0x8a, 0x24, 0xfd, 0xf0,
+	0x49, 0x48, 0x68, 0xee, 0xfc, 0x9c, 0x05, 0x88, 0xeb, 0x8b, 0x6a, 0x20, 0xf7, 0x8c, 0xd6, 0x93,
+	0x21, 0xd6, 0x1b, 0xa6, 0xd1, 0x1f, 0xf6, 0x8d, 0xbe, 0x2e, 0x67, 0x54, 0xb4, 0x58, 0x6a, 0xbb,
+	0x31, 0xaa, 0xcf, 0xeb, 0x7b, 0x0f, 0xf6, 0x93, 0x48, 0xa3, 0xd3, 0x19, 0x5a, 0xc6, 0xa0, 0xdb,
+	0x92, 0x25, 0xb5, 0xb2, 0x58, 0x6a, 0x28, 0x86, 0x1b, 0x27, 0x27, 0x96, 0xeb, 0xd1, 0x31, 0x7a,
+	0x00, 0x95, 0x24, 0xc5, 0x32, 0x8c, 0xe1, 0x63, 0xbd, 0x61, 0xe9, 0x6d, 0x39, 0xab, 0x5e, 0x5f,
+	0x2c, 0xb5, 0xbd, 0x98, 0x63, 0xb9, 0xee, 0x63, 0xb1, 0x29, 0xe8, 0x33, 0x28, 0x27, 0x49, 0x58,
+	0x37, 0x8d, 0xde, 0xb7, 0x7a, 0x5b, 0xce, 0xad, 0xbb, 0xc1, 0xc4, 0x77, 0x9d, 0x1f, 0x89, 0xbd,
+	0x9e, 0x83, 0x39, 0x68, 0x1c, 0xca, 0xf9, 0xf5, 0x1c, 0x4c, 0x6f, 0x74, 0xaa, 0xe6, 0x7f, 0xfd,
+	0xbd, 0x9a, 0xb9, 0xf3, 0x67, 0x16, 0x4a, 0x89, 0xf6, 0xa1, 0x2f, 0x40, 0x11, 0x4d, 0x58, 0x5d,
+	0x70, 0xd4, 0x37, 0x07, 0x7a, 0xab, 0xdb, 0xe9, 0xea, 0x6d, 0x39, 0xa3, 0xaa, 0x8b, 0xa5, 0x56,
+	0x49, 0xc0, 0x8f, 0xa6, 0xbe, 0x47, 0xc6, 0xf4, 0x84, 0x12, 0x1b, 0x7d, 0x0e, 0x95, 0x14, 0xb3,
+	0x65, 0x1c, 0x0e, 0x7a, 0x3a, 0x4f, 0x50, 0x52, 0x95, 0xc5, 0x52, 0x2b, 0x27, 0x78, 0x2d, 0xf7,
+	0xd4, 0x73, 0x08, 0xcf, 0xf0, 0x1e, 0xec, 0xa7, 0x58, 0x4f, 0x8d, 0xbe, 0x35, 0xec, 0x74, 0xbf,
+	0x93, 0xb3, 0x41, 0x8a, 0x09, 0xd2, 0x53, 0x77, 0xca, 0x3a, 0xf4, 0xe5, 0x39, 0x47, 0xed, 0xa3,
+	0x41, 0xaf, 0xdb, 0x6a, 0x58, 0xba, 0x9c, 0x3b, 0xe7, 0xa8, 0x3d, 0xf7, 0x1c, 0x3a, 0xe6, 0x73,
+	0xf6, 0x29, 0xa0, 0x14, 0xcb, 0xb4, 0x1a, 0x3d, 0x5d, 0xce, 0xab, 0xe5, 0xc5, 0x52, 0x93, 0x13,
+	0x0c, 0x93, 0x8d, 0x1c, 0x82, 0xea, 0xb0, 0x97, 0x42, 0x1f, 0xea, 0xf8, 0x1b, 0xbd, 0x2d, 0x17,
+	0xd4, 0xfd, 0xc5, 0x52, 0xbb, 0x96, 0x80, 0x1f, 0x92, 0xd9, 0x84, 0xd8, 0x61, 0x31, 0x7f, 0xc9,
+	0xc1, 0x4e, 0xb4, 0x51, 0xe2, 0xdf, 0xff, 0x4b, 0x78, 0xbf, 0x6b, 0x9a, 0x47, 0xfa, 0xb0, 0xd7,
+	0xed, 0x3f, 0x19, 0x5a, 0xcf, 0x06, 0xfa, 0x5a, 0x45, 0x6f, 0x2e, 0x96, 0x9a, 0x92, 0xe2, 0x24,
+	0x6b, 0xfa, 0x10, 0xd4, 0x75, 0x3a, 0xd6, 0x7b, 0x0d, 0x4b, 0x37, 0x87, 0x96, 0x21, 0x4b, 0x41,
+	0x3f, 0x52, 0x6c, 0x4c, 0x9c, 0x11, 0x23, 0xbe, 0xe5, 0xf2, 0x81, 0x5b, 0xe7, 0x36, 0xf9, 0x68,
+	0x98, 0xab, 0x81, 0x4b, 0xf1, 0x9a, 0xfc, 0x6d, 0xf1, 0x37, 0x39, 0x14, 0x24, 0xbd, 0x3d, 0x6c,
+	0x3e, 0x93, 0x73, 0x1b, 0x1c, 0x36, 0x83, 0x57, 0xad, 0x79, 0x86, 0x1e, 0xc1, 0xcd, 0x75, 0x6e,
+	0xd4, 0x9a, 0xa1, 0xd1, 0x91, 0xf3, 0x1b, 0x92, 0x8d, 0xfa, 0x63, 0x9c, 0xa0, 0xaf, 0xe1, 0xd6,
+	0x85, 0x7c, 0xe1, 0xbe, 0xa0, 0xde, 0x5a, 0x2c, 0xb5, 0x1b, 0x9b, 0x2f, 0xb0, 0x9b, 0x67, 0x41,
+	0x17, 0x9a, 0xed, 0x57, 0x6f, 0xaa, 0xd2, 0xeb, 0x37, 0x55, 0xe9, 0xdf, 0x37, 0x55, 0xe9, 0xb7,
+	0xb7, 0xd5, 0xcc, 0xeb, 0xb7, 0xd5, 0xcc, 0xdf, 0x6f, 0xab, 0x99, 0xef, 0xef, 0x4c, 0x28, 0x7b,
+	0x3e, 0x3f, 0xae, 0x8f, 0xdd, 0xd3, 0xbb, 0xab, 0x0f, 0xc0, 0xd5, 0xef, 0xcb, 0xe8, 0xc4, 0xce,
+	0x3c, 0xe2, 0x1f, 0x6f, 0x89, 0x0f, 0xc2, 0x07, 0xff, 0x0f, 0x00, 0xf8, 0x68, 0x3d, 0x1e, 0x9e,
+	0x0a, 0x00, 0x00,
 }
 
-func (m *Issue) Marshal() (dAtA []byte, err error) {
+func (m *IssueLink) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -270,57 +719,246 @@ func (m *Issue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Issue) MarshalTo(dAtA []byte) (int, error) {
+func (m *IssueLink) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *IssueLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClosedBy) > 0 {
-		i -= len(m.ClosedBy)
-		copy(dAtA[i:], m.ClosedBy)
-		i = encodeVarintIssue(dAtA, i, uint64(len(m.ClosedBy)))
-		i--
-		dAtA[i] = 0x1
+	if m.CreatedAt != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.CreatedAt))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x28
 	}
-	if m.ClosedAt != 0 {
-		i = encodeVarintIssue(dAtA, i, uint64(m.ClosedAt))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIssue(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x22
+	}
+	if m.Iid != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Iid))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x18
 	}
-	if m.UpdatedAt != 0 {
-		i = encodeVarintIssue(dAtA, i, uint64(m.UpdatedAt))
+	if m.RepositoryId != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.RepositoryId))
 		i--
-		dAtA[i] = 0x78
+		dAtA[i] = 0x10
 	}
-	if m.CreatedAt != 0 {
-		i = encodeVarintIssue(dAtA, i, uint64(m.CreatedAt))
+	if m.LinkType != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.LinkType))
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x8
 	}
-	if len(m.Bounties) > 0 {
-		dAtA2 := make([]byte, len(m.Bounties)*10)
-		var j1 int
-		for _, num := range m.Bounties {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+	return len(dAtA) - i, nil
+}
+
+func (m *IssueRedirect) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueRedirect) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueRedirect) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIssue(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TargetIid != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.TargetIid))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetRepositoryId != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.TargetRepositoryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Iid != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Iid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Issue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Issue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DueDate != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.DueDate))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.CloseReason != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.CloseReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.Milestone != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.LockReason != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.LockReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIssue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size, err := m.BountySplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIssue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.ClosedBy) > 0 {
+		i -= len(m.ClosedBy)
+		copy(dAtA[i:], m.ClosedBy)
+		i = encodeVarintIssue(dAtA, i, uint64(len(m.ClosedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ClosedAt != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.ClosedAt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Bounties) > 0 {
+		dAtA3 := make([]byte, len(m.Bounties)*10)
+		var j2 int
+		for _, num := range m.Bounties {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintIssue(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintIssue(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -339,20 +977,20 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x58
 	}
 	if len(m.Labels) > 0 {
-		dAtA4 := make([]byte, len(m.Labels)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Labels)*10)
+		var j4 int
 		for _, num := range m.Labels {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintIssue(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintIssue(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -419,6 +1057,46 @@ func (m *Issue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AssigneeWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssigneeWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssigneeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenIssues != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.OpenIssues))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Weight != 0 {
+		i = encodeVarintIssue(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Assignee) > 0 {
+		i -= len(m.Assignee)
+		copy(dAtA[i:], m.Assignee)
+		i = encodeVarintIssue(dAtA, i, uint64(len(m.Assignee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIssue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIssue(v)
 	base := offset
@@ -430,6 +1108,59 @@ func encodeVarintIssue(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *IssueLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkType != 0 {
+		n += 1 + sovIssue(uint64(m.LinkType))
+	}
+	if m.RepositoryId != 0 {
+		n += 1 + sovIssue(uint64(m.RepositoryId))
+	}
+	if m.Iid != 0 {
+		n += 1 + sovIssue(uint64(m.Iid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIssue(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovIssue(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *IssueRedirect) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovIssue(uint64(m.RepositoryId))
+	}
+	if m.Iid != 0 {
+		n += 1 + sovIssue(uint64(m.Iid))
+	}
+	if m.TargetRepositoryId != 0 {
+		n += 1 + sovIssue(uint64(m.TargetRepositoryId))
+	}
+	if m.TargetIid != 0 {
+		n += 1 + sovIssue(uint64(m.TargetIid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIssue(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovIssue(uint64(m.CreatedAt))
+	}
+	return n
+}
+
 func (m *Issue) Size() (n int) {
 	if m == nil {
 		return 0
@@ -505,6 +1236,54 @@ func (m *Issue) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovIssue(uint64(l))
 	}
+	l = m.BountySplit.Size()
+	n += 2 + l + sovIssue(uint64(l))
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 2 + l + sovIssue(uint64(l))
+		}
+	}
+	if m.Locked {
+		n += 3
+	}
+	if m.LockReason != 0 {
+		n += 2 + sovIssue(uint64(m.LockReason))
+	}
+	if m.Milestone != 0 {
+		n += 2 + sovIssue(uint64(m.Milestone))
+	}
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 2 + l + sovIssue(uint64(l))
+		}
+	}
+	if m.CloseReason != 0 {
+		n += 2 + sovIssue(uint64(m.CloseReason))
+	}
+	if m.DueDate != 0 {
+		n += 2 + sovIssue(uint64(m.DueDate))
+	}
+	return n
+}
+
+func (m *AssigneeWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Assignee)
+	if l > 0 {
+		n += 1 + l + sovIssue(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovIssue(uint64(m.Weight))
+	}
+	if m.OpenIssues != 0 {
+		n += 1 + sovIssue(uint64(m.OpenIssues))
+	}
 	return n
 }
 
@@ -514,7 +1293,7 @@ func sovIssue(x uint64) (n int) {
 func sozIssue(x uint64) (n int) {
 	return sovIssue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Issue) Unmarshal(dAtA []byte) error {
+func (m *IssueLink) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -537,17 +1316,17 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Issue: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueLink: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Issue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueLink: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkType", wireType)
 			}
-			var stringLen uint64
+			m.LinkType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -557,29 +1336,16 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LinkType |= IssueLinkType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIssue
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIssue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
 			}
-			m.Id = 0
+			m.RepositoryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -589,7 +1355,7 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.RepositoryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -615,7 +1381,7 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,13 +1409,13 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			m.State = 0
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -659,48 +1425,66 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Issue_State(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIssue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssue(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthIssue
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIssue
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IssueRedirect) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssue
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IssueRedirect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IssueRedirect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
 			}
-			m.CommentsCount = 0
+			m.RepositoryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -710,16 +1494,16 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommentsCount |= uint64(b&0x7F) << shift
+				m.RepositoryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequests", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
 			}
-			var msglen int
+			m.Iid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -729,31 +1513,16 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Iid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIssue
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIssue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PullRequests = append(m.PullRequests, &PullRequestIid{})
-			if err := m.PullRequests[len(m.PullRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRepositoryId", wireType)
 			}
-			m.RepositoryId = 0
+			m.TargetRepositoryId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -763,92 +1532,16 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryId |= uint64(b&0x7F) << shift
+				m.TargetRepositoryId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowIssue
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Labels = append(m.Labels, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowIssue
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthIssue
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthIssue
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Labels) == 0 {
-					m.Labels = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowIssue
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Labels = append(m.Labels, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
-			}
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIid", wireType)
 			}
-			m.Weight = 0
+			m.TargetIid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -858,14 +1551,14 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.TargetIid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,89 +1586,686 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assignees = append(m.Assignees, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowIssue
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
 				}
-				m.Bounties = append(m.Bounties, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowIssue
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Issue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Issue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Issue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			m.Iid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Issue_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentsCount", wireType)
+			}
+			m.CommentsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentsCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequests = append(m.PullRequests, &PullRequestIid{})
+			if err := m.PullRequests[len(m.PullRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIssue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Labels = append(m.Labels, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIssue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIssue
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIssue
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Labels) == 0 {
+					m.Labels = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIssue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Labels = append(m.Labels, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignees = append(m.Assignees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIssue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Bounties = append(m.Bounties, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIssue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
 					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthIssue
+				if packedLen < 0 {
+					return ErrInvalidLengthIssue
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIssue
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Bounties) == 0 {
+					m.Bounties = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIssue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Bounties = append(m.Bounties, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			m.ClosedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BountySplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthIssue
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BountySplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Bounties) == 0 {
-					m.Bounties = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, &Reaction{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowIssue
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Bounties = append(m.Bounties, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
 			}
-		case 14:
+			m.Locked = bool(v != 0)
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockReason", wireType)
 			}
-			m.CreatedAt = 0
+			m.LockReason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -985,16 +2275,16 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.LockReason |= LockReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
 			}
-			m.UpdatedAt = 0
+			m.Milestone = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -1004,16 +2294,50 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedAt |= int64(b&0x7F) << shift
+				m.Milestone |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIssue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, IssueLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseReason", wireType)
 			}
-			m.ClosedAt = 0
+			m.CloseReason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIssue
@@ -1023,14 +2347,83 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClosedAt |= int64(b&0x7F) << shift
+				m.CloseReason |= CloseReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
+			}
+			m.DueDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIssue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIssue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssigneeWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIssue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssigneeWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssigneeWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1058,8 +2451,46 @@ func (m *Issue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClosedBy = string(dAtA[iNdEx:postIndex])
+			m.Assignee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenIssues", wireType)
+			}
+			m.OpenIssues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIssue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenIssues |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIssue(dAtA[iNdEx:])
@@ -43,6 +43,7 @@ const (
 
 const (
 	UserKey      = "User-value-"
+	UserIdKey    = "User-id-value-"
 	UserDaoKey   = "User-dao-value-"
 	UserCountKey = "User-count-"
 )
@@ -63,14 +64,32 @@ const (
 	CommentCountKey = "Comment-count-"
 )
 
+const (
+	EditHistoryKey = "EditHistory-value-"
+)
+
+const (
+	MilestoneKey            = "Milestone-value-"
+	MilestoneIssueKey       = "Milestone-issue-"
+	MilestonePullRequestKey = "Milestone-pull-request-"
+)
+
+const (
+	IssueRedirectKey       = "IssueRedirect-value-"
+	IssueRedirectTargetKey = "IssueRedirect-target-"
+)
+
 const (
 	DaoKey      = "Dao-value-"
 	DaoCountKey = "Dao-count-"
 )
 
 const (
-	PullRequestKey      = "PullRequest-value-"
-	PullRequestCountKey = "PullRequest-count-"
+	PullRequestKey            = "PullRequest-value-"
+	PullRequestCountKey       = "PullRequest-count-"
+	PullRequestHeadBranchKey  = "PullRequest-head-branch-"
+	PullRequestHeadCommitKey  = "PullRequest-head-commit-"
+	PullRequestMergeCommitKey = "PullRequest-merge-commit-"
 )
 
 const (
@@ -85,6 +104,8 @@ const (
 	UpdateUserBioEventKey      = "UpdateUserBio"
 	UpdateUserAvatarEventKey   = "UpdateUserAvatar"
 	DeleteUserEventKey         = "DeleteUser"
+	FollowEventKey             = "Follow"
+	UnfollowEventKey           = "Unfollow"
 )
 
 const (
@@ -101,30 +122,44 @@ const (
 )
 
 const (
-	CreateRepositoryEventKey             = "CreateRepository"
-	ChangeOwnerEventKey                  = "ChangeOwner"
-	RenameRepositoryEventKey             = "RenameRepository"
-	UpdateRepositoryDescriptionEventKey  = "UpdateRepositoryDescription"
-	UpdateRepositoryCollaboratorEventKey = "UpdateRepositoryCollaborator"
-	RemoveRepositoryCollaboratorEventKey = "RemoveRepositoryCollaborator"
-	CreateRepositoryLabelEventKey        = "CreateRepositoryLabel"
-	UpdateRepositoryLabelEventKey        = "UpdateRepositoryLabel"
-	DeleteRepositoryLabelEventKey        = "DeleteRepositoryLabel"
-	ToggleRepositoryForkingEventKey      = "ToggleRepositoryForking"
-	ToggleArweaveBackupEventKey          = "ToggleArweaveBackup"
-	DeleteRepositoryEventKey             = "DeleteRepository"
-	InvokeForkRepositoryEventKey         = "InvokeForkRepository"
-	ForkRepositoryEventKey               = "ForkRepository"
-	SetRepositoryBranchEventKey          = "SetRepositoryBranch"
-	SetRepositoryTagEventKey             = "SetRepositoryTag"
-	MultiSetRepositoryBranchEventKey     = "MultiSetRepositoryBranch"
-	MultiSetRepositoryTagEventKey        = "MultiSetRepositoryTag"
-	SetRepositoryDefaultBranchEventKey   = "SetRepositoryDefaultBranch"
-	DeleteRepositoryBranchEventKey       = "DeleteRepositoryBranch"
-	MultiDeleteRepositoryBranchEventKey  = "MultiDeleteRepositoryBranch"
-	DeleteRepositoryTagEventKey          = "DeleteRepositoryTag"
-	MultiDeleteRepositoryTagEventKey     = "MultiDeleteRepositoryTag"
-	ToggleForcePushToBranchEventKey      = "ToggleForcePushToBranch"
+	CreateRepositoryEventKey               = "CreateRepository"
+	ChangeOwnerEventKey                    = "ChangeOwner"
+	RenameRepositoryEventKey               = "RenameRepository"
+	UpdateRepositoryDescriptionEventKey    = "UpdateRepositoryDescription"
+	UpdateRepositoryCollaboratorEventKey   = "UpdateRepositoryCollaborator"
+	RemoveRepositoryCollaboratorEventKey   = "RemoveRepositoryCollaborator"
+	CreateRepositoryLabelEventKey          = "CreateRepositoryLabel"
+	UpdateRepositoryLabelEventKey          = "UpdateRepositoryLabel"
+	DeleteRepositoryLabelEventKey          = "DeleteRepositoryLabel"
+	CreateMilestoneEventKey                = "CreateMilestone"
+	UpdateMilestoneEventKey                = "UpdateMilestone"
+	ToggleMilestoneStateEventKey           = "ToggleMilestoneState"
+	DeleteMilestoneEventKey                = "DeleteMilestone"
+	SetIssueMilestoneEventKey              = "SetIssueMilestone"
+	SetPullRequestMilestoneEventKey        = "SetPullRequestMilestone"
+	ToggleRepositoryForkingEventKey        = "ToggleRepositoryForking"
+	ToggleRepositoryArchivedEventKey       = "ToggleRepositoryArchived"
+	SetRepositoryMergeRequirementsEventKey = "SetRepositoryMergeRequirements"
+	SetRepositoryMergeStrategiesEventKey   = "SetRepositoryMergeStrategies"
+	ToggleArweaveBackupEventKey            = "ToggleArweaveBackup"
+	StarRepositoryEventKey                 = "StarRepository"
+	UnstarRepositoryEventKey               = "UnstarRepository"
+	DeleteRepositoryEventKey               = "DeleteRepository"
+	InvokeForkRepositoryEventKey           = "InvokeForkRepository"
+	ForkRepositoryEventKey                 = "ForkRepository"
+	SetRepositoryBranchEventKey            = "SetRepositoryBranch"
+	SetRepositoryTagEventKey               = "SetRepositoryTag"
+	MultiSetRepositoryBranchEventKey       = "MultiSetRepositoryBranch"
+	MultiSetRepositoryTagEventKey          = "MultiSetRepositoryTag"
+	SetRepositoryDefaultBranchEventKey     = "SetRepositoryDefaultBranch"
+	DeleteRepositoryBranchEventKey         = "DeleteRepositoryBranch"
+	MultiDeleteRepositoryBranchEventKey    = "MultiDeleteRepositoryBranch"
+	DeleteRepositoryTagEventKey            = "DeleteRepositoryTag"
+	MultiDeleteRepositoryTagEventKey       = "MultiDeleteRepositoryTag"
+	ToggleForcePushToBranchEventKey        = "ToggleForcePushToBranch"
+	SetBranchProtectionRuleEventKey        = "SetBranchProtectionRule"
+	DeleteBranchProtectionRuleEventKey     = "DeleteBranchProtectionRule"
+	SetCommitStatusEventKey                = "SetCommitStatus"
 )
 
 const (
@@ -134,9 +169,18 @@ const (
 	ToggleIssueStateEventKey       = "ToggleIssueState"
 	AddIssueAssigneesEventKey      = "AddIssueAssignees"
 	RemoveIssueAssigneesEventKey   = "RemoveIssueAssignees"
+	SetIssueBountySplitEventKey    = "SetIssueBountySplit"
 	AddIssueLabelsEventKey         = "AddIssueLabels"
 	RemoveIssueLabelsEventKey      = "RemoveIssueLabels"
 	DeleteIssueEventKey            = "DeleteIssue"
+	LockIssueEventKey              = "LockIssue"
+	UnlockIssueEventKey            = "UnlockIssue"
+	LinkIssueEventKey              = "LinkIssue"
+	UnlinkIssueEventKey            = "UnlinkIssue"
+	CloseIssueAsDuplicateEventKey  = "CloseIssueAsDuplicate"
+	TransferIssueEventKey          = "TransferIssue"
+	SetIssueWeightEventKey         = "SetIssueWeight"
+	SetIssueDueDateEventKey        = "SetIssueDueDate"
 )
 
 const (
@@ -147,11 +191,22 @@ const (
 	SetPullRequestStateEventKey          = "SetPullRequestState"
 	AddPullRequestReviewersEventKey      = "AddPullRequestReviewers"
 	RemovePullRequestReviewersEventKey   = "RemovePullRequestReviewers"
+	SubmitPullRequestReviewEventKey      = "SubmitPullRequestReview"
+	ResolveCommentThreadEventKey         = "ResolveCommentThread"
+	UnresolveCommentThreadEventKey       = "UnresolveCommentThread"
+	ToggleCommentReactionEventKey        = "ToggleCommentReaction"
+	HideCommentEventKey                  = "HideComment"
+	UnhideCommentEventKey                = "UnhideComment"
 	AddPullRequestAssigneesEventKey      = "AddPullRequestAssignees"
 	RemovePullRequestAssigneesEventKey   = "RemovePullRequestAssignees"
 	AddPullRequestLabelsEventKey         = "AddPullRequestLabels"
 	RemovePullRequestLabelsEventKey      = "RemovePullRequestLabels"
 	DeletePullRequestEventKey            = "DeletePullRequest"
+	SetPullRequestDraftEventKey          = "SetPullRequestDraft"
+	EnableAutoMergeEventKey              = "EnableAutoMerge"
+	DisableAutoMergeEventKey             = "DisableAutoMerge"
+	LockPullRequestEventKey              = "LockPullRequest"
+	UnlockPullRequestEventKey            = "UnlockPullRequest"
 	LinkPullRequestIssueByIidEventKey    = "LinkPullRequestIssueByIid"
 	UnlinkPullRequestIssueByIidEventKey  = "UnlinkPullRequestIssueByIid"
 )
@@ -163,10 +218,17 @@ const (
 )
 
 const (
-	CreateBountyEventKey       = "CreateBounty"
-	UpdateBountyExpiryEventKey = "UpdateBountyExpiry"
-	CloseBountyEventKey        = "CloseBounty"
-	DeleteBountyEventKey       = "DeleteBounty"
+	CreateBountyEventKey           = "CreateBounty"
+	FundBountyEventKey             = "FundBounty"
+	ReleaseBountyMilestoneEventKey = "ReleaseBountyMilestone"
+	OpenBountyDisputeEventKey      = "OpenBountyDispute"
+	VoteBountyDisputeEventKey      = "VoteBountyDispute"
+	ResolveBountyDisputeEventKey   = "ResolveBountyDispute"
+	UpdateBountyExpiryEventKey     = "UpdateBountyExpiry"
+	CloseBountyEventKey            = "CloseBounty"
+	DeleteBountyEventKey           = "DeleteBounty"
+	ExpireBountyEventKey           = "ExpireBounty"
+	RewardBountyEventKey           = "RewardBounty"
 )
 
 const (
@@ -185,11 +247,14 @@ const (
 )
 
 const (
-	EventAttributeUserIdKey       = "UserId"
-	EventAttributeUserUsernameKey = "UserUsername"
-	EventAttributeUserNameKey     = "UserName"
-	EventAttributeUserBio         = "UserBio"
-	EventAttributeAvatarUrl       = "AvatarUrl"
+	EventAttributeUserIdKey         = "UserId"
+	EventAttributeUserUsernameKey   = "UserUsername"
+	EventAttributeUserNameKey       = "UserName"
+	EventAttributeUserBio           = "UserBio"
+	EventAttributeAvatarUrl         = "AvatarUrl"
+	EventAttributeFollowIdKey       = "FollowId"
+	EventAttributeFollowTypeKey     = "FollowType"
+	EventAttributeFollowersCountKey = "FollowersCount"
 )
 
 const (
@@ -212,13 +277,25 @@ const (
 	EventAttributeRepoLabelIdKey             = "RepositoryLabelId"
 	EventAttributeRepoLabelNameKey           = "RepositoryLabelName"
 	EventAttributeRepoLabelColorKey          = "RepositoryLabelColor"
+	EventAttributeMilestoneKey               = "Milestone"
+	EventAttributeMilestoneIidKey            = "MilestoneIid"
+	EventAttributeMilestoneStateKey          = "MilestoneState"
 	EventAttributeRepoAllowForkingKey        = "RepositoryAllowForking"
+	EventAttributeRepoArchivedKey            = "RepositoryArchived"
 	EventAttributeRepoEnableArweaveBackupKey = "RepositoryEnableArweaveBackup"
+	EventAttributeRepoStargazersCountKey     = "RepositoryStargazersCount"
 	EventAttributeForkRepoNameKey            = "ForkRepositoryName"
 	EventAttributeForkRepoDescriptionKey     = "ForkRepositoryDescription"
 	EventAttributeForkRepoBranchKey          = "ForkRepositoryBranch"
 	EventAttributeForkRepoOwnerIdKey         = "ForkRepositoryOwnerId"
 	EventAttributeRepoBranchKey              = "RepositoryBranch"
+	EventAttributeBranchProtectionRuleKey    = "BranchProtectionRule"
+	EventAttributeCommitStatusKey            = "CommitStatus"
+	EventAttributeMergeRequirementsKey       = "MergeRequirements"
+	EventAttributeMergeStrategiesKey         = "MergeStrategies"
+	EventAttributeMergeStrategyKey           = "MergeStrategy"
+	EventAttributeMergeCommitTitleKey        = "MergeCommitTitle"
+	EventAttributeMergeCommitMessageKey      = "MergeCommitMessage"
 	EventAttributeRepoTagKey                 = "RepositoryTag"
 	EventAttributeRepoDefaultBranchKey       = "RepositoryDefaultBranch"
 )
@@ -230,6 +307,13 @@ const (
 	EventAttributeIssueStateKey       = "IssueState"
 	EventAttributeIssueDescriptionKey = "IssueDescription"
 	EventAttributeClosedByKey         = "ClosedBy"
+	EventAttributeCloseReasonKey      = "CloseReason"
+	EventAttributeIssueLinkKey        = "IssueLink"
+	EventAttributeTargetRepoIdKey     = "TargetRepositoryId"
+	EventAttributeTargetIssueIidKey   = "TargetIssueIid"
+	EventAttributeDuplicateReasonKey  = "DuplicateReason"
+	EventAttributeIssueWeightKey      = "IssueWeight"
+	EventAttributeIssueDueDateKey     = "IssueDueDate"
 )
 
 const (
@@ -239,12 +323,23 @@ const (
 	EventAttributePullRequestTitleKey          = "PullRequestTitle"
 	EventAttributePullRequestDescriptionKey    = "PullRequestDescription"
 	EventAttributePullRequestDraftKey          = "PullRequestDraft"
+	EventAttributeAutoMergeKey                 = "AutoMerge"
 	EventAttributePullRequestHeadKey           = "PullRequestHead"
 	EventAttributePullRequestBaseKey           = "PullRequestBase"
 	EventAttributePullRequestMergeCommitShaKey = "PullRequestMergeCommitSha"
 	EventAttributePullRequestMergedByKey       = "PullRequestMergedBy"
 	EventAttributePullRequestMergedAtKey       = "PullRequestMergedAt"
 	EventAttributePullRequestReviewersKey      = "PullRequestReviewers"
+	EventAttributePullRequestReviewKey         = "PullRequestReview"
+	EventAttributeCommentIidKey                = "CommentIid"
+	EventAttributeCommentParentKey             = "CommentParent"
+	EventAttributeCommentParentIidKey          = "CommentParentIid"
+	EventAttributeReactionEmojiKey             = "ReactionEmoji"
+	EventAttributeReactionAddedKey             = "ReactionAdded"
+	EventAttributeCommentHiddenKey             = "CommentHidden"
+	EventAttributeCommentHiddenReasonKey       = "CommentHiddenReason"
+	EventAttributeLockedKey                    = "Locked"
+	EventAttributeLockReasonKey                = "LockReason"
 )
 
 const (
@@ -263,6 +358,11 @@ const (
 	EventAttributeBountyParentKey    = "BountyParent"
 	EventAttributeBountyParentIidKey = "BountyParentIid"
 	EventAttributeBountyExpiry       = "BountyExpiry"
+	EventAttributeBountySplitKey     = "BountySplit"
+	EventAttributeBountyMilestoneKey = "BountyMilestone"
+	EventAttributeBountyReleasedKey  = "BountyReleased"
+	EventAttributeBountyDisputeKey   = "BountyDispute"
+	EventAttributeBountyRewardedTo   = "BountyRewardedTo"
 )
 
 const (
@@ -280,6 +380,10 @@ const (
 	BranchCountKey = "Branch-count-"
 )
 
+const (
+	CommitStatusKey = "CommitStatus-value-"
+)
+
 const (
 	TagKey      = "Tag-value-"
 	TagCountKey = "Tag-count-"
@@ -291,8 +395,20 @@ const (
 )
 
 const (
-	BountyKey      = "Bounty-value-"
-	BountyCountKey = "Bounty-count-"
+	BountyKey            = "Bounty-value-"
+	BountyCountKey       = "Bounty-count-"
+	BountyExpiryQueueKey = "Bounty-expiry-queue-"
+)
+
+const (
+	// MaxExpiredBountiesPerBlock is the maximum number of expired bounties
+	// refunded in a single EndBlock. The remaining ones are carried over to
+	// the next block.
+	MaxExpiredBountiesPerBlock = 100
+
+	// BountyExpiryPostponement is the time in seconds by which the expiry of a
+	// bounty is pushed out while pull requests are in progress on its parent.
+	BountyExpiryPostponement = 24 * 60 * 60
 )
 
 const (
@@ -310,6 +426,11 @@ func GetBranchKeyForRepositoryId(repositoryId uint64) string {
 	return BranchKey + strconv.FormatUint(repositoryId, 10) + "-"
 }
 
+// GetCommitStatusKeyForRepositoryId returns Key from repository-id
+func GetCommitStatusKeyForRepositoryId(repositoryId uint64) string {
+	return CommitStatusKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
 // GetTagKeyForRepositoryId returns Key from repository-id
 func GetTagKeyForRepositoryId(repositoryId uint64) string {
 	return TagKey + strconv.FormatUint(repositoryId, 10) + "-"
@@ -335,6 +456,56 @@ func GetPullRequestKeyForRepositoryId(repositoryId uint64) string {
 	return PullRequestKey + strconv.FormatUint(repositoryId, 10) + "-"
 }
 
+// GetPullRequestHeadBranchKey returns Key from head repository-id and head branch
+func GetPullRequestHeadBranchKey(repositoryId uint64, branch string) string {
+	return PullRequestHeadBranchKey + strconv.FormatUint(repositoryId, 10) + "-" + branch + "-"
+}
+
+// GetPullRequestHeadCommitKey returns Key from base repository-id and head commit sha
+func GetPullRequestHeadCommitKey(repositoryId uint64, sha string) string {
+	return PullRequestHeadCommitKey + strconv.FormatUint(repositoryId, 10) + "-" + sha + "-"
+}
+
+// GetPullRequestMergeCommitKey returns Key from base repository-id and base branch
+func GetPullRequestMergeCommitKey(repositoryId uint64, branch string) string {
+	return PullRequestMergeCommitKey + strconv.FormatUint(repositoryId, 10) + "-" + branch + "-"
+}
+
+// GetMilestoneKeyForRepositoryId returns Key from repository-id
+func GetMilestoneKeyForRepositoryId(repositoryId uint64) string {
+	return MilestoneKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetMilestoneIssueKey returns Key from repository-id and milestone iid
+func GetMilestoneIssueKey(repositoryId uint64, milestoneIid uint64) string {
+	return MilestoneIssueKey + strconv.FormatUint(repositoryId, 10) + "-" + strconv.FormatUint(milestoneIid, 10) + "-"
+}
+
+// GetMilestonePullRequestKey returns Key from repository-id and milestone iid
+func GetMilestonePullRequestKey(repositoryId uint64, milestoneIid uint64) string {
+	return MilestonePullRequestKey + strconv.FormatUint(repositoryId, 10) + "-" + strconv.FormatUint(milestoneIid, 10) + "-"
+}
+
+// GetIssueRedirectKeyForRepositoryId returns Key from repository-id
+func GetIssueRedirectKeyForRepositoryId(repositoryId uint64) string {
+	return IssueRedirectKey + strconv.FormatUint(repositoryId, 10) + "-"
+}
+
+// GetIssueRedirectTargetKey returns Key from target repository-id
+func GetIssueRedirectTargetKey(targetRepositoryId uint64) string {
+	return IssueRedirectTargetKey + strconv.FormatUint(targetRepositoryId, 10) + "-"
+}
+
+// GetEditHistoryKeyForIssue returns Key for the edit history of an issue comment or description
+func GetEditHistoryKeyForIssue(repositoryId uint64, issueIid uint64, commentIid uint64) string {
+	return EditHistoryKey + strconv.FormatUint(repositoryId, 10) + "-issue-" + strconv.FormatUint(issueIid, 10) + "-" + strconv.FormatUint(commentIid, 10) + "-"
+}
+
+// GetEditHistoryKeyForPullRequest returns Key for the edit history of a pull request comment or description
+func GetEditHistoryKeyForPullRequest(repositoryId uint64, pullRequestIid uint64, commentIid uint64) string {
+	return EditHistoryKey + strconv.FormatUint(repositoryId, 10) + "-pr-" + strconv.FormatUint(pullRequestIid, 10) + "-" + strconv.FormatUint(commentIid, 10) + "-"
+}
+
 // GetCommentKeyForIssue returns Key for repository issue
 func GetCommentKeyForIssue(repositoryId uint64, issueIid uint64) string {
 	return CommentKey + strconv.FormatUint(repositoryId, 10) + "-issue-" + strconv.FormatUint(issueIid, 10) + "-"
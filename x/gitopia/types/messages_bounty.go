@@ -6,10 +6,15 @@ import (
 )
 
 const (
-	TypeMsgCreateBounty = "create_bounty"
-	TypeMsgUpdateBounty = "update_bounty"
-	TypeMsgCloseBounty  = "close_bounty"
-	TypeMsgDeleteBounty = "delete_bounty"
+	TypeMsgCreateBounty           = "create_bounty"
+	TypeMsgFundBounty             = "fund_bounty"
+	TypeMsgReleaseBountyMilestone = "release_bounty_milestone"
+	TypeMsgOpenBountyDispute      = "open_bounty_dispute"
+	TypeMsgVoteBountyDispute      = "vote_bounty_dispute"
+	TypeMsgResolveBountyDispute   = "resolve_bounty_dispute"
+	TypeMsgUpdateBounty           = "update_bounty"
+	TypeMsgCloseBounty            = "close_bounty"
+	TypeMsgDeleteBounty           = "delete_bounty"
 )
 
 var _ sdk.Msg = &MsgCreateBounty{}
@@ -60,6 +65,231 @@ func (msg *MsgCreateBounty) ValidateBasic() error {
 	if msg.Expiry < 0 {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid expiry time")
 	}
+	if err := ValidateBountyMilestones(msg.Milestones, msg.Amount); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgFundBounty{}
+
+func NewMsgFundBounty(creator string, id uint64, amount sdk.Coins) *MsgFundBounty {
+	return &MsgFundBounty{
+		Creator: creator,
+		Id:      id,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgFundBounty) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFundBounty) Type() string {
+	return TypeMsgFundBounty
+}
+
+func (msg *MsgFundBounty) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgFundBounty) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFundBounty) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Amount) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty amount")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgReleaseBountyMilestone{}
+
+func NewMsgReleaseBountyMilestone(creator string, id uint64, milestone uint64, recipient string) *MsgReleaseBountyMilestone {
+	return &MsgReleaseBountyMilestone{
+		Creator:   creator,
+		Id:        id,
+		Milestone: milestone,
+		Recipient: recipient,
+	}
+}
+
+func (msg *MsgReleaseBountyMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseBountyMilestone) Type() string {
+	return TypeMsgReleaseBountyMilestone
+}
+
+func (msg *MsgReleaseBountyMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReleaseBountyMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReleaseBountyMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgOpenBountyDispute{}
+
+func NewMsgOpenBountyDispute(creator string, id uint64, contributor string, reason string) *MsgOpenBountyDispute {
+	return &MsgOpenBountyDispute{
+		Creator:     creator,
+		Id:          id,
+		Contributor: contributor,
+		Reason:      reason,
+	}
+}
+
+func (msg *MsgOpenBountyDispute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOpenBountyDispute) Type() string {
+	return TypeMsgOpenBountyDispute
+}
+
+func (msg *MsgOpenBountyDispute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgOpenBountyDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOpenBountyDispute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Contributor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contributor address (%s)", err)
+	}
+	if err := ValidateOptionalCommentBody(msg.Reason); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgVoteBountyDispute{}
+
+func NewMsgVoteBountyDispute(creator string, id uint64, resolution BountyDisputeResolution, payoutPercentage uint64) *MsgVoteBountyDispute {
+	return &MsgVoteBountyDispute{
+		Creator:          creator,
+		Id:               id,
+		Resolution:       resolution,
+		PayoutPercentage: payoutPercentage,
+	}
+}
+
+func (msg *MsgVoteBountyDispute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgVoteBountyDispute) Type() string {
+	return TypeMsgVoteBountyDispute
+}
+
+func (msg *MsgVoteBountyDispute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgVoteBountyDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgVoteBountyDispute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateBountyDisputeResolution(msg.Resolution, msg.PayoutPercentage); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgResolveBountyDispute{}
+
+func NewMsgResolveBountyDispute(creator string, id uint64, resolution BountyDisputeResolution, payoutPercentage uint64) *MsgResolveBountyDispute {
+	return &MsgResolveBountyDispute{
+		Creator:          creator,
+		Id:               id,
+		Resolution:       resolution,
+		PayoutPercentage: payoutPercentage,
+	}
+}
+
+func (msg *MsgResolveBountyDispute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveBountyDispute) Type() string {
+	return TypeMsgResolveBountyDispute
+}
+
+func (msg *MsgResolveBountyDispute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveBountyDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveBountyDispute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidateBountyDisputeResolution(msg.Resolution, msg.PayoutPercentage); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
 	return nil
 }
 
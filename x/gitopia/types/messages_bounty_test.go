@@ -39,6 +39,93 @@ func TestMsgCreateBounty_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "valid milestones",
+			msg: MsgCreateBounty{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1000)),
+				Milestones: []BountyMilestone{
+					{Title: "design", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 400))},
+					{Title: "implementation", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 600))},
+				},
+			},
+		},
+		{
+			name: "milestones exceed amount",
+			msg: MsgCreateBounty{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1000)),
+				Milestones: []BountyMilestone{
+					{Title: "design", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 400))},
+					{Title: "implementation", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 700))},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "milestone without title",
+			msg: MsgCreateBounty{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1000)),
+				Milestones: []BountyMilestone{
+					{Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 400))},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "released milestone",
+			msg: MsgCreateBounty{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 1000)),
+				Milestones: []BountyMilestone{
+					{Title: "design", Amount: sdk.NewCoins(sdk.NewInt64Coin(params.BaseCoinUnit, 400)), Released: true},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFundBounty_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFundBounty
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFundBounty{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid address",
+			msg: MsgFundBounty{
+				Creator: sample.AccAddress(),
+				Amount: []sdk.Coin{
+					{Denom: params.BaseCoinUnit, Amount: sdk.NewInt(1000)},
+				},
+			},
+		},
+		{
+			name: "empty amount",
+			msg: MsgFundBounty{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -113,3 +200,155 @@ func TestMsgDeleteBounty_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgReleaseBountyMilestone_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReleaseBountyMilestone
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReleaseBountyMilestone{
+				Creator:   "invalid_address",
+				Recipient: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid recipient",
+			msg: MsgReleaseBountyMilestone{
+				Creator:   sample.AccAddress(),
+				Recipient: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg: MsgReleaseBountyMilestone{
+				Creator:   sample.AccAddress(),
+				Milestone: 1,
+				Recipient: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgOpenBountyDispute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgOpenBountyDispute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgOpenBountyDispute{
+				Creator:     "invalid_address",
+				Contributor: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid contributor",
+			msg: MsgOpenBountyDispute{
+				Creator:     sample.AccAddress(),
+				Contributor: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "valid",
+			msg: MsgOpenBountyDispute{
+				Creator:     sample.AccAddress(),
+				Contributor: sample.AccAddress(),
+				Reason:      "work is incomplete",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgVoteBountyDispute_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgVoteBountyDispute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgVoteBountyDispute{
+				Creator:    "invalid_address",
+				Resolution: BountyDisputeResolutionPayout,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "payout",
+			msg: MsgVoteBountyDispute{
+				Creator:    sample.AccAddress(),
+				Resolution: BountyDisputeResolutionPayout,
+			},
+		},
+		{
+			name: "refund with payout percentage",
+			msg: MsgVoteBountyDispute{
+				Creator:          sample.AccAddress(),
+				Resolution:       BountyDisputeResolutionRefund,
+				PayoutPercentage: 50,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "split",
+			msg: MsgVoteBountyDispute{
+				Creator:          sample.AccAddress(),
+				Resolution:       BountyDisputeResolutionSplit,
+				PayoutPercentage: 50,
+			},
+		},
+		{
+			name: "split without payout percentage",
+			msg: MsgVoteBountyDispute{
+				Creator:    sample.AccAddress(),
+				Resolution: BountyDisputeResolutionSplit,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "no resolution",
+			msg: MsgVoteBountyDispute{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
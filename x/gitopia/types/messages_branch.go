@@ -325,3 +325,123 @@ func (msg *MsgToggleForcePush) ValidateBasic() error {
 
 	return nil
 }
+
+const (
+	TypeMsgSetBranchProtectionRule    = "set_branch_protection_rule"
+	TypeMsgDeleteBranchProtectionRule = "delete_branch_protection_rule"
+)
+
+var _ sdk.Msg = &MsgSetBranchProtectionRule{}
+
+func NewMsgSetBranchProtectionRule(creator string, repositoryId RepositoryId, pattern string, minPushPermission RepositoryCollaborator_Permission, requirePullRequest bool, allowDeletion bool, mergeRequirements *MergeRequirements, defaultMergeStrategy MergeStrategy) *MsgSetBranchProtectionRule {
+	return &MsgSetBranchProtectionRule{
+		Creator:              creator,
+		RepositoryId:         repositoryId,
+		Pattern:              pattern,
+		MinPushPermission:    minPushPermission,
+		RequirePullRequest:   requirePullRequest,
+		AllowDeletion:        allowDeletion,
+		MergeRequirements:    mergeRequirements,
+		DefaultMergeStrategy: defaultMergeStrategy,
+	}
+}
+
+func (msg *MsgSetBranchProtectionRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetBranchProtectionRule) Type() string {
+	return TypeMsgSetBranchProtectionRule
+}
+
+func (msg *MsgSetBranchProtectionRule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetBranchProtectionRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetBranchProtectionRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	err = ValidateRepositoryId(msg.RepositoryId)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%v (%v)", err, msg.RepositoryId.Id)
+	}
+
+	if err := ValidateBranchProtectionPattern(msg.Pattern); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if _, ok := RepositoryCollaborator_Permission_name[int32(msg.MinPushPermission)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permission (%v)", msg.MinPushPermission)
+	}
+
+	if err := ValidateMergeRequirements(msg.MergeRequirements); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMergeStrategy(msg.DefaultMergeStrategy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteBranchProtectionRule{}
+
+func NewMsgDeleteBranchProtectionRule(creator string, repositoryId RepositoryId, pattern string) *MsgDeleteBranchProtectionRule {
+	return &MsgDeleteBranchProtectionRule{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Pattern:      pattern,
+	}
+}
+
+func (msg *MsgDeleteBranchProtectionRule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteBranchProtectionRule) Type() string {
+	return TypeMsgDeleteBranchProtectionRule
+}
+
+func (msg *MsgDeleteBranchProtectionRule) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteBranchProtectionRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteBranchProtectionRule) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	err = ValidateRepositoryId(msg.RepositoryId)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%v (%v)", err, msg.RepositoryId.Id)
+	}
+
+	if err := ValidateBranchProtectionPattern(msg.Pattern); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -237,3 +237,139 @@ func TestMsgToggleForcePush_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetBranchProtectionRule_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSetBranchProtectionRule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetBranchProtectionRule{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty pattern",
+			msg: MsgSetBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "malformed pattern",
+			msg: MsgSetBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      "release/[",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid permission",
+			msg: MsgSetBranchProtectionRule{
+				Creator:           sample.AccAddress(),
+				RepositoryId:      repositoryId,
+				Pattern:           "main",
+				MinPushPermission: RepositoryCollaborator_Permission(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid glob pattern",
+			msg: MsgSetBranchProtectionRule{
+				Creator:            sample.AccAddress(),
+				RepositoryId:       repositoryId,
+				Pattern:            "release/*",
+				MinPushPermission:  RepositoryCollaborator_MAINTAIN,
+				RequirePullRequest: true,
+			},
+		}, {
+			name: "invalid required reviewer",
+			msg: MsgSetBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+				MergeRequirements: &MergeRequirements{
+					RequiredReviewers: []string{"invalid_address"},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid merge requirements",
+			msg: MsgSetBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+				MergeRequirements: &MergeRequirements{
+					RequiredApprovals:       2,
+					BlockOnChangesRequested: true,
+					RequiredReviewers:       []string{sample.AccAddress()},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteBranchProtectionRule_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgDeleteBranchProtectionRule
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeleteBranchProtectionRule{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty pattern",
+			msg: MsgDeleteBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgDeleteBranchProtectionRule{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Pattern:      "main",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
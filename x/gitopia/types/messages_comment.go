@@ -7,7 +7,7 @@ import (
 
 var _ sdk.Msg = &MsgCreateComment{}
 
-func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64, parent CommentParent, body string, attachments []*Attachment, diffHunk string, path string, position uint64) *MsgCreateComment {
+func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64, parent CommentParent, body string, attachments []*Attachment, diffHunk string, path string, position uint64, replyTo uint64) *MsgCreateComment {
 	return &MsgCreateComment{
 		Creator:      creator,
 		RepositoryId: repositoryid,
@@ -18,6 +18,7 @@ func NewMsgCreateComment(creator string, repositoryid uint64, parentIid uint64,
 		DiffHunk:     diffHunk,
 		Path:         path,
 		Position:     position,
+		ReplyTo:      replyTo,
 	}
 }
 
@@ -62,10 +63,18 @@ func (msg *MsgCreateComment) ValidateBasic() error {
 		if len(msg.DiffHunk) > 255 {
 			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "DiffHunk exceeds limit: 255")
 		}
+		if len(msg.Path) == 0 && (len(msg.DiffHunk) > 0 || msg.Position > 0) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Path is required with DiffHunk and Position")
+		}
 	default:
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
 	}
 
+	// replies belong to the thread of the comment they reply to
+	if msg.ReplyTo > 0 && (len(msg.Path) > 0 || len(msg.DiffHunk) > 0 || msg.Position > 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Cannot provide Path, DiffHunk or Position with a reply")
+	}
+
 	if err := ValidateCommentBody(msg.Body); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -227,3 +236,244 @@ func (msg *MsgDeleteComment) ValidateBasic() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgResolveCommentThread{}
+
+func NewMsgResolveCommentThread(creator string, repositoryId uint64, pullIid uint64, commentIid uint64) *MsgResolveCommentThread {
+	return &MsgResolveCommentThread{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		PullIid:      pullIid,
+		CommentIid:   commentIid,
+	}
+}
+
+func (msg *MsgResolveCommentThread) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveCommentThread) Type() string {
+	return "ResolveCommentThread"
+}
+
+func (msg *MsgResolveCommentThread) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveCommentThread) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveCommentThread) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnresolveCommentThread{}
+
+func NewMsgUnresolveCommentThread(creator string, repositoryId uint64, pullIid uint64, commentIid uint64) *MsgUnresolveCommentThread {
+	return &MsgUnresolveCommentThread{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		PullIid:      pullIid,
+		CommentIid:   commentIid,
+	}
+}
+
+func (msg *MsgUnresolveCommentThread) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnresolveCommentThread) Type() string {
+	return "UnresolveCommentThread"
+}
+
+func (msg *MsgUnresolveCommentThread) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnresolveCommentThread) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnresolveCommentThread) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgToggleCommentReaction{}
+
+func NewMsgToggleCommentReaction(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64, emoji Emoji) *MsgToggleCommentReaction {
+	return &MsgToggleCommentReaction{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+		Emoji:        emoji,
+	}
+}
+
+func (msg *MsgToggleCommentReaction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgToggleCommentReaction) Type() string {
+	return "ToggleCommentReaction"
+}
+
+func (msg *MsgToggleCommentReaction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgToggleCommentReaction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleCommentReaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if _, ok := Emoji_name[int32(msg.Emoji)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid emoji (%v)", msg.Emoji)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgHideComment{}
+
+func NewMsgHideComment(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64, reason CommentHiddenReason) *MsgHideComment {
+	return &MsgHideComment{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+		Reason:       reason,
+	}
+}
+
+func (msg *MsgHideComment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgHideComment) Type() string {
+	return "HideComment"
+}
+
+func (msg *MsgHideComment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgHideComment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgHideComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid")
+	}
+	if _, ok := CommentHiddenReason_name[int32(msg.Reason)]; !ok || msg.Reason == CommentHiddenReasonNone {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason (%v)", msg.Reason)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnhideComment{}
+
+func NewMsgUnhideComment(creator string, repositoryId uint64, parentIid uint64, parent CommentParent, commentIid uint64) *MsgUnhideComment {
+	return &MsgUnhideComment{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		ParentIid:    parentIid,
+		Parent:       parent,
+		CommentIid:   commentIid,
+	}
+}
+
+func (msg *MsgUnhideComment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnhideComment) Type() string {
+	return "UnhideComment"
+}
+
+func (msg *MsgUnhideComment) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnhideComment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnhideComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	switch msg.Parent {
+	case CommentParentIssue:
+	case CommentParentPullRequest:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parent (%s)", msg.Parent)
+	}
+	if msg.CommentIid == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid comment iid")
+	}
+	return nil
+}
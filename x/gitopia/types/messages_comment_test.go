@@ -35,6 +35,44 @@ func TestMsgCreateComment_ValidateBasic(t *testing.T) {
 				Parent:  9,
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "review comment",
+			msg: MsgCreateComment{
+				Creator:  sample.AccAddress(),
+				Parent:   CommentParentPullRequest,
+				Body:     "comment",
+				DiffHunk: "@@ -1,3 +1,4 @@",
+				Path:     "main.go",
+				Position: 3,
+			},
+		}, {
+			name: "review comment without path",
+			msg: MsgCreateComment{
+				Creator:  sample.AccAddress(),
+				Parent:   CommentParentPullRequest,
+				Body:     "comment",
+				DiffHunk: "@@ -1,3 +1,4 @@",
+				Position: 3,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "reply",
+			msg: MsgCreateComment{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+				Body:    "comment",
+				ReplyTo: 1,
+			},
+		}, {
+			name: "reply with path",
+			msg: MsgCreateComment{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+				Body:    "comment",
+				Path:    "main.go",
+				ReplyTo: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -113,3 +151,223 @@ func TestMsgDeleteComment_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgResolveCommentThread_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgResolveCommentThread
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgResolveCommentThread{
+				Creator:    "invalid_address",
+				CommentIid: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgResolveCommentThread{
+				Creator:    sample.AccAddress(),
+				CommentIid: 1,
+			},
+		}, {
+			name: "invalid comment iid",
+			msg: MsgResolveCommentThread{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnresolveCommentThread_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnresolveCommentThread
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnresolveCommentThread{
+				Creator:    "invalid_address",
+				CommentIid: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUnresolveCommentThread{
+				Creator:    sample.AccAddress(),
+				CommentIid: 1,
+			},
+		}, {
+			name: "invalid comment iid",
+			msg: MsgUnresolveCommentThread{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgToggleCommentReaction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgToggleCommentReaction
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgToggleCommentReaction{
+				Creator: "invalid_address",
+				Parent:  CommentParentIssue,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgToggleCommentReaction{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+				Emoji:      EmojiRocket,
+			},
+		}, {
+			name: "invalid parent",
+			msg: MsgToggleCommentReaction{
+				Creator: sample.AccAddress(),
+				Parent:  9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid emoji",
+			msg: MsgToggleCommentReaction{
+				Creator: sample.AccAddress(),
+				Parent:  CommentParentPullRequest,
+				Emoji:   99,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgHideComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgHideComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgHideComment{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+				Reason:     CommentHiddenReasonOffTopic,
+			},
+		}, {
+			name: "missing reason",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid reason",
+			msg: MsgHideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentPullRequest,
+				CommentIid: 1,
+				Reason:     9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnhideComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnhideComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnhideComment{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUnhideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     CommentParentIssue,
+				CommentIid: 1,
+			},
+		}, {
+			name: "invalid parent",
+			msg: MsgUnhideComment{
+				Creator:    sample.AccAddress(),
+				Parent:     9,
+				CommentIid: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetCommitStatus = "set_commit_status"
+)
+
+var _ sdk.Msg = &MsgSetCommitStatus{}
+
+func NewMsgSetCommitStatus(creator string, repositoryId uint64, sha string, context string, state CommitStatusState, targetUrl string, description string) *MsgSetCommitStatus {
+	return &MsgSetCommitStatus{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Sha:          sha,
+		Context:      context,
+		State:        state,
+		TargetUrl:    targetUrl,
+		Description:  description,
+	}
+}
+
+func (msg *MsgSetCommitStatus) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCommitStatus) Type() string {
+	return TypeMsgSetCommitStatus
+}
+
+func (msg *MsgSetCommitStatus) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetCommitStatus) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetCommitStatus) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	isShaValid, _ := regexp.MatchString("^([0-9a-f]{40}|[0-9a-f]{64})$", msg.Sha)
+	if !isShaValid {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sha")
+	}
+
+	if err := ValidateCommitStatusContext(msg.Context); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if _, ok := CommitStatusState_name[int32(msg.State)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid state (%v)", msg.State)
+	}
+
+	if len(msg.TargetUrl) > 2048 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "target url exceeds limit: 2048")
+	}
+	if msg.TargetUrl != "" {
+		url, err := url.ParseRequestURI(msg.TargetUrl)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid url (%s)", msg.TargetUrl)
+		}
+		if url.Scheme != "https" && url.Scheme != "http" {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "only https and http URL scheme is allowed in TargetUrl")
+		}
+	}
+
+	if len(msg.Description) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "description exceeds limit: 255")
+	}
+
+	return nil
+}
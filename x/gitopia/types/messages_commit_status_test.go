@@ -0,0 +1,92 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetCommitStatus_ValidateBasic(t *testing.T) {
+	sha := strings.Repeat("a", 40)
+
+	tests := []struct {
+		name string
+		msg  MsgSetCommitStatus
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetCommitStatus{
+				Creator: "invalid_address",
+				Sha:     sha,
+				Context: "ci/build",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgSetCommitStatus{
+				Creator:     sample.AccAddress(),
+				Sha:         sha,
+				Context:     "ci/build",
+				State:       CommitStatusStateSuccess,
+				TargetUrl:   "https://ci.example.com/builds/1",
+				Description: "build passed",
+			},
+		}, {
+			name: "invalid sha",
+			msg: MsgSetCommitStatus{
+				Creator: sample.AccAddress(),
+				Sha:     "abc",
+				Context: "ci/build",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty context",
+			msg: MsgSetCommitStatus{
+				Creator: sample.AccAddress(),
+				Sha:     sha,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid state",
+			msg: MsgSetCommitStatus{
+				Creator: sample.AccAddress(),
+				Sha:     sha,
+				Context: "ci/build",
+				State:   9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid target url scheme",
+			msg: MsgSetCommitStatus{
+				Creator:   sample.AccAddress(),
+				Sha:       sha,
+				Context:   "ci/build",
+				TargetUrl: "ftp://ci.example.com",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "description exceeds limit",
+			msg: MsgSetCommitStatus{
+				Creator:     sample.AccAddress(),
+				Sha:         sha,
+				Context:     "ci/build",
+				Description: strings.Repeat("a", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -196,11 +196,12 @@ func (msg *MsgUpdateIssueDescription) ValidateBasic() error {
 
 var _ sdk.Msg = &MsgToggleIssueState{}
 
-func NewMsgToggleIssueState(creator string, repositoryId uint64, iid uint64) *MsgToggleIssueState {
+func NewMsgToggleIssueState(creator string, repositoryId uint64, iid uint64, closeReason CloseReason) *MsgToggleIssueState {
 	return &MsgToggleIssueState{
 		Creator:      creator,
 		RepositoryId: repositoryId,
 		Iid:          iid,
+		CloseReason:  closeReason,
 	}
 }
 
@@ -235,6 +236,10 @@ func (msg *MsgToggleIssueState) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if err := ValidateCloseReason(msg.CloseReason); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -356,6 +361,50 @@ func (msg *MsgRemoveIssueAssignees) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSetIssueBountySplit{}
+
+func NewMsgSetIssueBountySplit(creator string, repositoryId uint64, iid uint64, split BountySplit) *MsgSetIssueBountySplit {
+	return &MsgSetIssueBountySplit{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Split:        split,
+	}
+}
+
+func (msg *MsgSetIssueBountySplit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIssueBountySplit) Type() string {
+	return "SetIssueBountySplit"
+}
+
+func (msg *MsgSetIssueBountySplit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetIssueBountySplit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssueBountySplit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateBountySplit(msg.Split); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgAddIssueLabels{}
 
 func NewMsgAddIssueLabels(creator string, repositoryId uint64, iid uint64, labelIds []uint64) *MsgAddIssueLabels {
@@ -499,3 +548,85 @@ func (msg *MsgDeleteIssue) GetSignBytes() []byte {
 func (msg *MsgDeleteIssue) ValidateBasic() error {
 	return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx WIP")
 }
+
+var _ sdk.Msg = &MsgLockIssue{}
+
+func NewMsgLockIssue(creator string, repositoryId uint64, iid uint64, reason LockReason) *MsgLockIssue {
+	return &MsgLockIssue{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Reason:       reason,
+	}
+}
+
+func (msg *MsgLockIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLockIssue) Type() string {
+	return "LockIssue"
+}
+
+func (msg *MsgLockIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLockIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLockIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, ok := LockReason_name[int32(msg.Reason)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason (%v)", msg.Reason)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnlockIssue{}
+
+func NewMsgUnlockIssue(creator string, repositoryId uint64, iid uint64) *MsgUnlockIssue {
+	return &MsgUnlockIssue{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgUnlockIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnlockIssue) Type() string {
+	return "UnlockIssue"
+}
+
+func (msg *MsgUnlockIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnlockIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnlockIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
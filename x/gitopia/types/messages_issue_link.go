@@ -0,0 +1,160 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgLinkIssue             = "link_issue"
+	TypeMsgUnlinkIssue           = "unlink_issue"
+	TypeMsgCloseIssueAsDuplicate = "close_issue_as_duplicate"
+)
+
+var _ sdk.Msg = &MsgLinkIssue{}
+
+func NewMsgLinkIssue(creator string, repositoryId uint64, iid uint64, linkType IssueLinkType, targetRepositoryId uint64, targetIid uint64) *MsgLinkIssue {
+	return &MsgLinkIssue{
+		Creator:            creator,
+		RepositoryId:       repositoryId,
+		Iid:                iid,
+		LinkType:           linkType,
+		TargetRepositoryId: targetRepositoryId,
+		TargetIid:          targetIid,
+	}
+}
+
+func (msg *MsgLinkIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLinkIssue) Type() string {
+	return TypeMsgLinkIssue
+}
+
+func (msg *MsgLinkIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLinkIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLinkIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateIssueLinkType(msg.LinkType); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.RepositoryId == msg.TargetRepositoryId && msg.Iid == msg.TargetIid {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't be linked to itself")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnlinkIssue{}
+
+func NewMsgUnlinkIssue(creator string, repositoryId uint64, iid uint64, targetRepositoryId uint64, targetIid uint64) *MsgUnlinkIssue {
+	return &MsgUnlinkIssue{
+		Creator:            creator,
+		RepositoryId:       repositoryId,
+		Iid:                iid,
+		TargetRepositoryId: targetRepositoryId,
+		TargetIid:          targetIid,
+	}
+}
+
+func (msg *MsgUnlinkIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnlinkIssue) Type() string {
+	return TypeMsgUnlinkIssue
+}
+
+func (msg *MsgUnlinkIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnlinkIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnlinkIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RepositoryId == msg.TargetRepositoryId && msg.Iid == msg.TargetIid {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't be linked to itself")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCloseIssueAsDuplicate{}
+
+func NewMsgCloseIssueAsDuplicate(creator string, repositoryId uint64, iid uint64, duplicateOfRepositoryId uint64, duplicateOfIid uint64, reason string) *MsgCloseIssueAsDuplicate {
+	return &MsgCloseIssueAsDuplicate{
+		Creator:                 creator,
+		RepositoryId:            repositoryId,
+		Iid:                     iid,
+		DuplicateOfRepositoryId: duplicateOfRepositoryId,
+		DuplicateOfIid:          duplicateOfIid,
+		Reason:                  reason,
+	}
+}
+
+func (msg *MsgCloseIssueAsDuplicate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCloseIssueAsDuplicate) Type() string {
+	return TypeMsgCloseIssueAsDuplicate
+}
+
+func (msg *MsgCloseIssueAsDuplicate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCloseIssueAsDuplicate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCloseIssueAsDuplicate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RepositoryId == msg.DuplicateOfRepositoryId && msg.Iid == msg.DuplicateOfIid {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't be a duplicate of itself")
+	}
+
+	if len(msg.Reason) > 255 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "reason length exceeds limit: 255")
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgLinkIssue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLinkIssue
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgLinkIssue{
+				Creator:   "invalid_address",
+				Iid:       1,
+				LinkType:  IssueLinkTypeBlocks,
+				TargetIid: 2,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgLinkIssue{
+				Creator:   sample.AccAddress(),
+				Iid:       1,
+				LinkType:  IssueLinkTypeBlocks,
+				TargetIid: 2,
+			},
+		}, {
+			name: "cross repository link",
+			msg: MsgLinkIssue{
+				Creator:            sample.AccAddress(),
+				Iid:                1,
+				LinkType:           IssueLinkTypeRelatesTo,
+				TargetRepositoryId: 1,
+				TargetIid:          1,
+			},
+		}, {
+			name: "unspecified link type",
+			msg: MsgLinkIssue{
+				Creator:   sample.AccAddress(),
+				Iid:       1,
+				TargetIid: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid link type",
+			msg: MsgLinkIssue{
+				Creator:   sample.AccAddress(),
+				Iid:       1,
+				LinkType:  9,
+				TargetIid: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "link to itself",
+			msg: MsgLinkIssue{
+				Creator:   sample.AccAddress(),
+				Iid:       1,
+				LinkType:  IssueLinkTypeBlocks,
+				TargetIid: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCloseIssueAsDuplicate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCloseIssueAsDuplicate
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgCloseIssueAsDuplicate{
+				Creator:        "invalid_address",
+				Iid:            1,
+				DuplicateOfIid: 2,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgCloseIssueAsDuplicate{
+				Creator:        sample.AccAddress(),
+				Iid:            1,
+				DuplicateOfIid: 2,
+				Reason:         "same crash",
+			},
+		}, {
+			name: "duplicate of itself",
+			msg: MsgCloseIssueAsDuplicate{
+				Creator:        sample.AccAddress(),
+				Iid:            1,
+				DuplicateOfIid: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "reason too long",
+			msg: MsgCloseIssueAsDuplicate{
+				Creator:        sample.AccAddress(),
+				Iid:            1,
+				DuplicateOfIid: 2,
+				Reason:         strings.Repeat("a", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgSetIssueWeight  = "set_issue_weight"
+	TypeMsgSetIssueDueDate = "set_issue_due_date"
+)
+
+// MaxIssueWeight is the largest weight an issue can be given
+const MaxIssueWeight = 1000
+
+var _ sdk.Msg = &MsgSetIssueWeight{}
+
+func NewMsgSetIssueWeight(creator string, repositoryId uint64, iid uint64, weight uint64) *MsgSetIssueWeight {
+	return &MsgSetIssueWeight{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Weight:       weight,
+	}
+}
+
+func (msg *MsgSetIssueWeight) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIssueWeight) Type() string {
+	return TypeMsgSetIssueWeight
+}
+
+func (msg *MsgSetIssueWeight) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetIssueWeight) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssueWeight) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateIssueWeight(msg.Weight); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetIssueDueDate{}
+
+func NewMsgSetIssueDueDate(creator string, repositoryId uint64, iid uint64, dueDate int64) *MsgSetIssueDueDate {
+	return &MsgSetIssueDueDate{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		DueDate:      dueDate,
+	}
+}
+
+func (msg *MsgSetIssueDueDate) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIssueDueDate) Type() string {
+	return TypeMsgSetIssueDueDate
+}
+
+func (msg *MsgSetIssueDueDate) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetIssueDueDate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssueDueDate) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.DueDate < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid due date (%v)", msg.DueDate)
+	}
+
+	return nil
+}
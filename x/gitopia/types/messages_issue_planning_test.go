@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetIssueWeight_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetIssueWeight
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetIssueWeight{
+				Creator: "invalid_address",
+				Iid:     1,
+				Weight:  3,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgSetIssueWeight{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+				Weight:  3,
+			},
+		}, {
+			name: "remove weight",
+			msg: MsgSetIssueWeight{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+			},
+		}, {
+			name: "weight exceeds limit",
+			msg: MsgSetIssueWeight{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+				Weight:  MaxIssueWeight + 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetIssueDueDate_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetIssueDueDate
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetIssueDueDate{
+				Creator: "invalid_address",
+				Iid:     1,
+				DueDate: 1700000000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgSetIssueDueDate{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+				DueDate: 1700000000,
+			},
+		}, {
+			name: "remove due date",
+			msg: MsgSetIssueDueDate{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+			},
+		}, {
+			name: "negative due date",
+			msg: MsgSetIssueDueDate{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+				DueDate: -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
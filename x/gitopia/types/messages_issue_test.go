@@ -258,6 +258,26 @@ func TestMsgToggleIssueState_ValidateBasic(t *testing.T) {
 			msg: MsgToggleIssueState{
 				Creator: sample.AccAddress(),
 			},
+		}, {
+			name: "valid close reason",
+			msg: MsgToggleIssueState{
+				Creator:     sample.AccAddress(),
+				CloseReason: CloseReasonWontFix,
+			},
+		}, {
+			name: "merged close reason",
+			msg: MsgToggleIssueState{
+				Creator:     sample.AccAddress(),
+				CloseReason: CloseReasonMerged,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid close reason",
+			msg: MsgToggleIssueState{
+				Creator:     sample.AccAddress(),
+				CloseReason: 9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -468,6 +488,124 @@ func TestMsgRemoveIssueAssignees_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSetIssueBountySplit_ValidateBasic(t *testing.T) {
+	sampleAddr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetIssueBountySplit
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetIssueBountySplit{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "equal split",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split:   BountySplit{Type: BountySplitTypeEqual},
+			},
+		}, {
+			name: "equal split with shares",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type:   BountySplitTypeEqual,
+					Shares: []BountyShare{{Address: sample.AccAddress(), Percentage: 100}},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid split type",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split:   BountySplit{Type: 5},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "percentage split",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type: BountySplitTypePercentage,
+					Shares: []BountyShare{
+						{Address: sample.AccAddress(), Percentage: 60},
+						{Address: sample.AccAddress(), Percentage: 40},
+					},
+				},
+			},
+		}, {
+			name: "percentage split without shares",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split:   BountySplit{Type: BountySplitTypePercentage},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "percentages don't add up to 100",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type: BountySplitTypePercentage,
+					Shares: []BountyShare{
+						{Address: sample.AccAddress(), Percentage: 60},
+						{Address: sample.AccAddress(), Percentage: 30},
+					},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "zero percentage",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type: BountySplitTypePercentage,
+					Shares: []BountyShare{
+						{Address: sample.AccAddress(), Percentage: 100},
+						{Address: sample.AccAddress(), Percentage: 0},
+					},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid share address",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type:   BountySplitTypePercentage,
+					Shares: []BountyShare{{Address: "invalid_address", Percentage: 100}},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate share address",
+			msg: MsgSetIssueBountySplit{
+				Creator: sample.AccAddress(),
+				Split: BountySplit{
+					Type: BountySplitTypePercentage,
+					Shares: []BountyShare{
+						{Address: sampleAddr, Percentage: 50},
+						{Address: sampleAddr, Percentage: 50},
+					},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgAddIssueLabels_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -619,3 +757,73 @@ func TestMsgDeleteIssue_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgLockIssue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLockIssue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgLockIssue{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgLockIssue{
+				Creator: sample.AccAddress(),
+				Reason:  LockReasonOffTopic,
+			},
+		}, {
+			name: "invalid reason",
+			msg: MsgLockIssue{
+				Creator: sample.AccAddress(),
+				Reason:  9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnlockIssue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnlockIssue
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnlockIssue{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUnlockIssue{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
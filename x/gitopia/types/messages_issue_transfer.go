@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTransferIssue = "transfer_issue"
+
+var _ sdk.Msg = &MsgTransferIssue{}
+
+func NewMsgTransferIssue(creator string, repositoryId uint64, iid uint64, targetRepositoryId uint64) *MsgTransferIssue {
+	return &MsgTransferIssue{
+		Creator:            creator,
+		RepositoryId:       repositoryId,
+		Iid:                iid,
+		TargetRepositoryId: targetRepositoryId,
+	}
+}
+
+func (msg *MsgTransferIssue) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTransferIssue) Type() string {
+	return TypeMsgTransferIssue
+}
+
+func (msg *MsgTransferIssue) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTransferIssue) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTransferIssue) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RepositoryId == msg.TargetRepositoryId {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "issue can't be transferred to the same repository")
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferIssue_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTransferIssue
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgTransferIssue{
+				Creator:            "invalid_address",
+				Iid:                1,
+				TargetRepositoryId: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgTransferIssue{
+				Creator:            sample.AccAddress(),
+				Iid:                1,
+				TargetRepositoryId: 1,
+			},
+		}, {
+			name: "same repository",
+			msg: MsgTransferIssue{
+				Creator:            sample.AccAddress(),
+				RepositoryId:       1,
+				Iid:                1,
+				TargetRepositoryId: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateMilestone         = "create_milestone"
+	TypeMsgUpdateMilestone         = "update_milestone"
+	TypeMsgToggleMilestoneState    = "toggle_milestone_state"
+	TypeMsgDeleteMilestone         = "delete_milestone"
+	TypeMsgSetIssueMilestone       = "set_issue_milestone"
+	TypeMsgSetPullRequestMilestone = "set_pull_request_milestone"
+)
+
+var _ sdk.Msg = &MsgCreateMilestone{}
+
+func NewMsgCreateMilestone(creator string, repositoryId RepositoryId, title string, description string, dueDate int64) *MsgCreateMilestone {
+	return &MsgCreateMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Title:        title,
+		Description:  description,
+		DueDate:      dueDate,
+	}
+}
+
+func (msg *MsgCreateMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateMilestone) Type() string {
+	return TypeMsgCreateMilestone
+}
+
+func (msg *MsgCreateMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMilestone(msg.Title, msg.Description, msg.DueDate); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateMilestone{}
+
+func NewMsgUpdateMilestone(creator string, repositoryId RepositoryId, iid uint64, title string, description string, dueDate int64) *MsgUpdateMilestone {
+	return &MsgUpdateMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Title:        title,
+		Description:  description,
+		DueDate:      dueDate,
+	}
+}
+
+func (msg *MsgUpdateMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateMilestone) Type() string {
+	return TypeMsgUpdateMilestone
+}
+
+func (msg *MsgUpdateMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMilestone(msg.Title, msg.Description, msg.DueDate); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgToggleMilestoneState{}
+
+func NewMsgToggleMilestoneState(creator string, repositoryId RepositoryId, iid uint64) *MsgToggleMilestoneState {
+	return &MsgToggleMilestoneState{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgToggleMilestoneState) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgToggleMilestoneState) Type() string {
+	return TypeMsgToggleMilestoneState
+}
+
+func (msg *MsgToggleMilestoneState) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgToggleMilestoneState) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleMilestoneState) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteMilestone{}
+
+func NewMsgDeleteMilestone(creator string, repositoryId RepositoryId, iid uint64) *MsgDeleteMilestone {
+	return &MsgDeleteMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgDeleteMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteMilestone) Type() string {
+	return TypeMsgDeleteMilestone
+}
+
+func (msg *MsgDeleteMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetIssueMilestone{}
+
+func NewMsgSetIssueMilestone(creator string, repositoryId uint64, iid uint64, milestoneIid uint64) *MsgSetIssueMilestone {
+	return &MsgSetIssueMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		MilestoneIid: milestoneIid,
+	}
+}
+
+func (msg *MsgSetIssueMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetIssueMilestone) Type() string {
+	return TypeMsgSetIssueMilestone
+}
+
+func (msg *MsgSetIssueMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetIssueMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetIssueMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetPullRequestMilestone{}
+
+func NewMsgSetPullRequestMilestone(creator string, repositoryId uint64, iid uint64, milestoneIid uint64) *MsgSetPullRequestMilestone {
+	return &MsgSetPullRequestMilestone{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		MilestoneIid: milestoneIid,
+	}
+}
+
+func (msg *MsgSetPullRequestMilestone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPullRequestMilestone) Type() string {
+	return TypeMsgSetPullRequestMilestone
+}
+
+func (msg *MsgSetPullRequestMilestone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPullRequestMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPullRequestMilestone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gitopia/gitopia/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateMilestone_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{Id: sample.AccAddress(), Name: "repository"}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateMilestone
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgCreateMilestone{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgCreateMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+				Description:  "first stable release",
+				DueDate:      1700000000,
+			},
+		}, {
+			name: "invalid repository id",
+			msg: MsgCreateMilestone{
+				Creator: sample.AccAddress(),
+				Title:   "v1.0",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty title",
+			msg: MsgCreateMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "title too long",
+			msg: MsgCreateMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        strings.Repeat("a", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "negative due date",
+			msg: MsgCreateMilestone{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				Title:        "v1.0",
+				DueDate:      -1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetIssueMilestone_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetIssueMilestone
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSetIssueMilestone{
+				Creator:      "invalid_address",
+				Iid:          1,
+				MilestoneIid: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid message",
+			msg: MsgSetIssueMilestone{
+				Creator:      sample.AccAddress(),
+				Iid:          1,
+				MilestoneIid: 1,
+			},
+		}, {
+			name: "remove milestone",
+			msg: MsgSetIssueMilestone{
+				Creator: sample.AccAddress(),
+				Iid:     1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
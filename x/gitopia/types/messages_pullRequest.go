@@ -225,12 +225,15 @@ func (msg *MsgUpdatePullRequestDescription) ValidateBasic() error {
 
 var _ sdk.Msg = &MsgInvokeMergePullRequest{}
 
-func NewMsgInvokeMergePullRequest(creator string, repositoryId uint64, iid uint64, provider string) *MsgInvokeMergePullRequest {
+func NewMsgInvokeMergePullRequest(creator string, repositoryId uint64, iid uint64, provider string, mergeStrategy MergeStrategy, commitTitle string, commitMessage string) *MsgInvokeMergePullRequest {
 	return &MsgInvokeMergePullRequest{
-		Creator:      creator,
-		RepositoryId: repositoryId,
-		Iid:          iid,
-		Provider:     provider,
+		Creator:       creator,
+		RepositoryId:  repositoryId,
+		Iid:           iid,
+		Provider:      provider,
+		MergeStrategy: mergeStrategy,
+		CommitTitle:   commitTitle,
+		CommitMessage: commitMessage,
 	}
 }
 
@@ -266,12 +269,132 @@ func (msg *MsgInvokeMergePullRequest) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
 	}
 
+	if err := ValidateMergeStrategy(msg.MergeStrategy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.CommitTitle) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit title length exceeds limit: 255")
+	}
+
+	if len(msg.CommitMessage) > 20000 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit message length exceeds limit: 20000")
+	}
+
+	if msg.MergeStrategy == MergeStrategyRebase && (msg.CommitTitle != "" || msg.CommitMessage != "") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit title and message can't be set when rebasing")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgEnableAutoMerge{}
+
+func NewMsgEnableAutoMerge(creator string, repositoryId uint64, iid uint64, provider string, mergeStrategy MergeStrategy, commitTitle string, commitMessage string) *MsgEnableAutoMerge {
+	return &MsgEnableAutoMerge{
+		Creator:       creator,
+		RepositoryId:  repositoryId,
+		Iid:           iid,
+		Provider:      provider,
+		MergeStrategy: mergeStrategy,
+		CommitTitle:   commitTitle,
+		CommitMessage: commitMessage,
+	}
+}
+
+func (msg *MsgEnableAutoMerge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgEnableAutoMerge) Type() string {
+	return "EnableAutoMerge"
+}
+
+func (msg *MsgEnableAutoMerge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgEnableAutoMerge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgEnableAutoMerge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid provider address (%s)", err)
+	}
+
+	if err := ValidateMergeStrategy(msg.MergeStrategy); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.CommitTitle) > 255 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit title length exceeds limit: 255")
+	}
+
+	if len(msg.CommitMessage) > 20000 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit message length exceeds limit: 20000")
+	}
+
+	if msg.MergeStrategy == MergeStrategyRebase && (msg.CommitTitle != "" || msg.CommitMessage != "") {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "commit title and message can't be set when rebasing")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgDisableAutoMerge{}
+
+func NewMsgDisableAutoMerge(creator string, repositoryId uint64, iid uint64) *MsgDisableAutoMerge {
+	return &MsgDisableAutoMerge{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgDisableAutoMerge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDisableAutoMerge) Type() string {
+	return "DisableAutoMerge"
+}
+
+func (msg *MsgDisableAutoMerge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDisableAutoMerge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDisableAutoMerge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
 	return nil
 }
 
 var _ sdk.Msg = &MsgSetPullRequestState{}
 
-func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64, state string, mergeCommitSha string, commentBody string, taskId uint64) *MsgSetPullRequestState {
+func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64, state string, mergeCommitSha string, commentBody string, taskId uint64, closeReason CloseReason) *MsgSetPullRequestState {
 	return &MsgSetPullRequestState{
 		Creator:        creator,
 		RepositoryId:   repositoryId,
@@ -280,6 +403,7 @@ func NewMsgSetPullRequestState(creator string, repositoryId uint64, iid uint64,
 		MergeCommitSha: mergeCommitSha,
 		CommentBody:    commentBody,
 		TaskId:         taskId,
+		CloseReason:    closeReason,
 	}
 }
 
@@ -319,6 +443,14 @@ func (msg *MsgSetPullRequestState) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if err := ValidateCloseReason(msg.CloseReason); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.CloseReason != CloseReasonUnspecified && msg.State != PullRequest_CLOSED.String() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "close reason can only be set when closing")
+	}
+
 	return nil
 }
 
@@ -440,6 +572,79 @@ func (msg *MsgRemovePullRequestReviewers) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSubmitPullRequestReview{}
+
+func NewMsgSubmitPullRequestReview(creator string, repositoryId uint64, iid uint64, verdict PullRequestReviewVerdict, body string, comments []MsgSubmitPullRequestReview_Comment) *MsgSubmitPullRequestReview {
+	return &MsgSubmitPullRequestReview{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Verdict:      verdict,
+		Body:         body,
+		Comments:     comments,
+	}
+}
+
+func (msg *MsgSubmitPullRequestReview) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitPullRequestReview) Type() string {
+	return "SubmitPullRequestReview"
+}
+
+func (msg *MsgSubmitPullRequestReview) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitPullRequestReview) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitPullRequestReview) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if _, ok := PullRequestReviewVerdict_name[int32(msg.Verdict)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid verdict (%v)", msg.Verdict)
+	}
+
+	// An approval may be submitted without a body, any other verdict needs
+	// either a body or at least one inline comment.
+	if len(msg.Body) > 0 || (msg.Verdict != PullRequestReviewVerdictApprove && len(msg.Comments) == 0) {
+		if err := ValidateCommentBody(msg.Body); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	if len(msg.Comments) > 50 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "inline comments exceeds limit: 50")
+	}
+
+	for _, comment := range msg.Comments {
+		if len(comment.Path) < 1 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "inline comment path can't be empty")
+		} else if len(comment.Path) > 255 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Path exceeds limit: 255")
+		}
+		if len(comment.DiffHunk) > 255 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "DiffHunk exceeds limit: 255")
+		}
+		if err := ValidateCommentBody(comment.Body); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgAddPullRequestAssignees{}
 
 func NewMsgAddPullRequestAssignees(creator string, repositoryId uint64, iid uint64, assignees []string) *MsgAddPullRequestAssignees {
@@ -783,3 +988,125 @@ func (msg *MsgDeletePullRequest) GetSignBytes() []byte {
 func (msg *MsgDeletePullRequest) ValidateBasic() error {
 	return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx WIP")
 }
+
+var _ sdk.Msg = &MsgSetPullRequestDraft{}
+
+func NewMsgSetPullRequestDraft(creator string, repositoryId uint64, iid uint64, draft bool) *MsgSetPullRequestDraft {
+	return &MsgSetPullRequestDraft{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Draft:        draft,
+	}
+}
+
+func (msg *MsgSetPullRequestDraft) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPullRequestDraft) Type() string {
+	return "SetPullRequestDraft"
+}
+
+func (msg *MsgSetPullRequestDraft) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetPullRequestDraft) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetPullRequestDraft) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgLockPullRequest{}
+
+func NewMsgLockPullRequest(creator string, repositoryId uint64, iid uint64, reason LockReason) *MsgLockPullRequest {
+	return &MsgLockPullRequest{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+		Reason:       reason,
+	}
+}
+
+func (msg *MsgLockPullRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLockPullRequest) Type() string {
+	return "LockPullRequest"
+}
+
+func (msg *MsgLockPullRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLockPullRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLockPullRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, ok := LockReason_name[int32(msg.Reason)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reason (%v)", msg.Reason)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnlockPullRequest{}
+
+func NewMsgUnlockPullRequest(creator string, repositoryId uint64, iid uint64) *MsgUnlockPullRequest {
+	return &MsgUnlockPullRequest{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+		Iid:          iid,
+	}
+}
+
+func (msg *MsgUnlockPullRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnlockPullRequest) Type() string {
+	return "UnlockPullRequest"
+}
+
+func (msg *MsgUnlockPullRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnlockPullRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnlockPullRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -427,6 +427,171 @@ func TestMsgUpdatePullRequestDescription_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgInvokeMergePullRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgInvokeMergePullRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgInvokeMergePullRequest{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider",
+			msg: MsgInvokeMergePullRequest{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid merge strategy",
+			msg: MsgInvokeMergePullRequest{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategy(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commit title exceeds limit",
+			msg: MsgInvokeMergePullRequest{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategySquash,
+				CommitTitle:   strings.Repeat("t", 256),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commit message when rebasing",
+			msg: MsgInvokeMergePullRequest{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategyRebase,
+				CommitMessage: "message",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "default merge strategy",
+			msg: MsgInvokeMergePullRequest{
+				Creator:  sample.AccAddress(),
+				Provider: sample.AccAddress(),
+			},
+		}, {
+			name: "valid MsgInvokeMergePullRequest",
+			msg: MsgInvokeMergePullRequest{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategySquash,
+				CommitTitle:   "title",
+				CommitMessage: "message",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgEnableAutoMerge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgEnableAutoMerge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgEnableAutoMerge{
+				Creator:  "invalid_address",
+				Provider: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid provider",
+			msg: MsgEnableAutoMerge{
+				Creator:  sample.AccAddress(),
+				Provider: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid merge strategy",
+			msg: MsgEnableAutoMerge{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategy(10),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "commit title when rebasing",
+			msg: MsgEnableAutoMerge{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategyRebase,
+				CommitTitle:   "title",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgEnableAutoMerge",
+			msg: MsgEnableAutoMerge{
+				Creator:       sample.AccAddress(),
+				Provider:      sample.AccAddress(),
+				MergeStrategy: MergeStrategySquash,
+				CommitTitle:   "title",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDisableAutoMerge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDisableAutoMerge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDisableAutoMerge{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgDisableAutoMerge{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgSetPullRequestState_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name string
@@ -453,6 +618,29 @@ func TestMsgSetPullRequestState_ValidateBasic(t *testing.T) {
 				State:   "invalid_state",
 			},
 			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "close with reason",
+			msg: MsgSetPullRequestState{
+				Creator:     sample.AccAddress(),
+				State:       "CLOSED",
+				CloseReason: CloseReasonStale,
+			},
+		}, {
+			name: "close reason when merging",
+			msg: MsgSetPullRequestState{
+				Creator:     sample.AccAddress(),
+				State:       "MERGED",
+				CloseReason: CloseReasonCompleted,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "merged close reason",
+			msg: MsgSetPullRequestState{
+				Creator:     sample.AccAddress(),
+				State:       "CLOSED",
+				CloseReason: CloseReasonMerged,
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		},
 	}
 	for _, tt := range tests {
@@ -565,6 +753,98 @@ func TestMsgAddPullRequestReviewers_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitPullRequestReview_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitPullRequestReview
+		err  error
+	}{
+		{
+			name: "invalid creator address",
+			msg: MsgSubmitPullRequestReview{
+				Creator: "invalid_address",
+				Verdict: PullRequestReviewVerdictApprove,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "approve without body",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictApprove,
+			},
+		}, {
+			name: "request changes without body",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictRequestChanges,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "request changes with inline comment",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictRequestChanges,
+				Comments: []MsgSubmitPullRequestReview_Comment{
+					{Path: "README.md", DiffHunk: "@@ -1 +1 @@", Position: 1, Body: "typo"},
+				},
+			},
+		}, {
+			name: "comment with body",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictComment,
+				Body:    "looks good so far",
+			},
+		}, {
+			name: "invalid verdict",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdict(10),
+				Body:    "body",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "inline comment without path",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictComment,
+				Comments: []MsgSubmitPullRequestReview_Comment{
+					{Body: "typo"},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "inline comment without body",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictComment,
+				Comments: []MsgSubmitPullRequestReview_Comment{
+					{Path: "README.md"},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "body exceeds limit",
+			msg: MsgSubmitPullRequestReview{
+				Creator: sample.AccAddress(),
+				Verdict: PullRequestReviewVerdictApprove,
+				Body:    strings.Repeat("b", 20001),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgRemovePullRequestReviewers_ValidateBasic(t *testing.T) {
 	sampleAddr := sample.AccAddress()
 	tests := []struct {
@@ -1010,3 +1290,105 @@ func TestMsgDeletePullRequest_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetPullRequestDraft_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetPullRequestDraft
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetPullRequestDraft{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgSetPullRequestDraft{
+				Creator: sample.AccAddress(),
+				Draft:   true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgLockPullRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLockPullRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgLockPullRequest{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgLockPullRequest{
+				Creator: sample.AccAddress(),
+				Reason:  LockReasonTooHeated,
+			},
+		}, {
+			name: "invalid reason",
+			msg: MsgLockPullRequest{
+				Creator: sample.AccAddress(),
+				Reason:  9,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnlockPullRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnlockPullRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnlockPullRequest{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUnlockPullRequest{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
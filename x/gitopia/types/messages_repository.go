@@ -792,6 +792,145 @@ func (msg *MsgToggleRepositoryForking) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgToggleRepositoryArchived{}
+
+func NewMsgToggleRepositoryArchived(creator string, repositoryId RepositoryId) *MsgToggleRepositoryArchived {
+	return &MsgToggleRepositoryArchived{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgToggleRepositoryArchived) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgToggleRepositoryArchived) Type() string {
+	return "ToggleRepositoryArchived"
+}
+
+func (msg *MsgToggleRepositoryArchived) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgToggleRepositoryArchived) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgToggleRepositoryArchived) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetRepositoryMergeRequirements{}
+
+func NewMsgSetRepositoryMergeRequirements(creator string, repositoryId RepositoryId, mergeRequirements *MergeRequirements) *MsgSetRepositoryMergeRequirements {
+	return &MsgSetRepositoryMergeRequirements{
+		Creator:           creator,
+		RepositoryId:      repositoryId,
+		MergeRequirements: mergeRequirements,
+	}
+}
+
+func (msg *MsgSetRepositoryMergeRequirements) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRepositoryMergeRequirements) Type() string {
+	return "SetRepositoryMergeRequirements"
+}
+
+func (msg *MsgSetRepositoryMergeRequirements) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetRepositoryMergeRequirements) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRepositoryMergeRequirements) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMergeRequirements(msg.MergeRequirements); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetRepositoryMergeStrategies{}
+
+func NewMsgSetRepositoryMergeStrategies(creator string, repositoryId RepositoryId, allowedMergeStrategies []MergeStrategy) *MsgSetRepositoryMergeStrategies {
+	return &MsgSetRepositoryMergeStrategies{
+		Creator:                creator,
+		RepositoryId:           repositoryId,
+		AllowedMergeStrategies: allowedMergeStrategies,
+	}
+}
+
+func (msg *MsgSetRepositoryMergeStrategies) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRepositoryMergeStrategies) Type() string {
+	return "SetRepositoryMergeStrategies"
+}
+
+func (msg *MsgSetRepositoryMergeStrategies) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetRepositoryMergeStrategies) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetRepositoryMergeStrategies) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateMergeStrategies(msg.AllowedMergeStrategies); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgToggleArweaveBackup{}
 
 func NewMsgToggleArweaveBackup(creator string, repositoryId RepositoryId) *MsgToggleArweaveBackup {
@@ -867,3 +1006,89 @@ func (msg *MsgDeleteRepository) GetSignBytes() []byte {
 func (msg *MsgDeleteRepository) ValidateBasic() error {
 	return sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx WIP")
 }
+
+var _ sdk.Msg = &MsgStarRepository{}
+
+func NewMsgStarRepository(creator string, repositoryId RepositoryId) *MsgStarRepository {
+	return &MsgStarRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgStarRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStarRepository) Type() string {
+	return "StarRepository"
+}
+
+func (msg *MsgStarRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStarRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgStarRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUnstarRepository{}
+
+func NewMsgUnstarRepository(creator string, repositoryId RepositoryId) *MsgUnstarRepository {
+	return &MsgUnstarRepository{
+		Creator:      creator,
+		RepositoryId: repositoryId,
+	}
+}
+
+func (msg *MsgUnstarRepository) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnstarRepository) Type() string {
+	return "UnstarRepository"
+}
+
+func (msg *MsgUnstarRepository) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnstarRepository) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnstarRepository) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := ValidateRepositoryId(msg.RepositoryId); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
@@ -539,6 +539,175 @@ func TestMsgToggleRepositoryForking_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgToggleRepositoryArchived_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgToggleRepositoryArchived
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgToggleRepositoryArchived{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid MsgToggleRepositoryArchived",
+			msg: MsgToggleRepositoryArchived{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetRepositoryMergeRequirements_ValidateBasic(t *testing.T) {
+	sampleAddr := sample.AccAddress()
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSetRepositoryMergeRequirements
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRepositoryMergeRequirements{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "clear merge requirements",
+			msg: MsgSetRepositoryMergeRequirements{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		}, {
+			name: "required approvals exceeds limit",
+			msg: MsgSetRepositoryMergeRequirements{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				MergeRequirements: &MergeRequirements{
+					RequiredApprovals: 11,
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate required reviewer",
+			msg: MsgSetRepositoryMergeRequirements{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				MergeRequirements: &MergeRequirements{
+					RequiredReviewers: []string{sampleAddr, sampleAddr},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgSetRepositoryMergeRequirements",
+			msg: MsgSetRepositoryMergeRequirements{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+				MergeRequirements: &MergeRequirements{
+					RequiredApprovals:       1,
+					BlockOnChangesRequested: true,
+					RequiredReviewers:       []string{sampleAddr},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetRepositoryMergeStrategies_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgSetRepositoryMergeStrategies
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetRepositoryMergeStrategies{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "unspecified merge strategy",
+			msg: MsgSetRepositoryMergeStrategies{
+				Creator:                sample.AccAddress(),
+				RepositoryId:           repositoryId,
+				AllowedMergeStrategies: []MergeStrategy{MergeStrategyUnspecified},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate merge strategy",
+			msg: MsgSetRepositoryMergeStrategies{
+				Creator:                sample.AccAddress(),
+				RepositoryId:           repositoryId,
+				AllowedMergeStrategies: []MergeStrategy{MergeStrategySquash, MergeStrategySquash},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "allow all merge strategies",
+			msg: MsgSetRepositoryMergeStrategies{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		}, {
+			name: "valid MsgSetRepositoryMergeStrategies",
+			msg: MsgSetRepositoryMergeStrategies{
+				Creator:                sample.AccAddress(),
+				RepositoryId:           repositoryId,
+				AllowedMergeStrategies: []MergeStrategy{MergeStrategySquash, MergeStrategyRebase},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgRenameRepository_ValidateBasic(t *testing.T) {
 	repositoryId := RepositoryId{
 		Id:   sample.AccAddress(),
@@ -641,3 +810,93 @@ func TestMsgDeleteRepository_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgStarRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgStarRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgStarRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid repository id",
+			msg: MsgStarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: RepositoryId{Id: "invalid_id", Name: "repository"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgStarRepository",
+			msg: MsgStarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnstarRepository_ValidateBasic(t *testing.T) {
+	repositoryId := RepositoryId{
+		Id:   sample.AccAddress(),
+		Name: "repository",
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgUnstarRepository
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnstarRepository{
+				Creator:      "invalid_address",
+				RepositoryId: repositoryId,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid repository id",
+			msg: MsgUnstarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: RepositoryId{Id: "invalid_id", Name: "repository"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid MsgUnstarRepository",
+			msg: MsgUnstarRepository{
+				Creator:      sample.AccAddress(),
+				RepositoryId: repositoryId,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -169,3 +169,95 @@ func TestMsgDeleteUser_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgFollow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFollow
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFollow{
+				Creator: "invalid_address",
+				Id:      sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid id",
+			msg: MsgFollow{
+				Creator: sample.AccAddress(),
+				Id:      "a",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgFollow{
+				Creator: sample.AccAddress(),
+				Id:      sample.AccAddress(),
+			},
+		}, {
+			name: "valid username",
+			msg: MsgFollow{
+				Creator: sample.AccAddress(),
+				Id:      "username",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnfollow_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnfollow
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUnfollow{
+				Creator: "invalid_address",
+				Id:      sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid id",
+			msg: MsgUnfollow{
+				Creator: sample.AccAddress(),
+				Id:      "a",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgUnfollow{
+				Creator: sample.AccAddress(),
+				Id:      sample.AccAddress(),
+			},
+		}, {
+			name: "valid username",
+			msg: MsgUnfollow{
+				Creator: sample.AccAddress(),
+				Id:      "username",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
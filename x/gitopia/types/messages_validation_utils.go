@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/base64"
 	"fmt"
+	"path"
 	"reflect"
 	"regexp"
 
@@ -32,23 +33,32 @@ func ValidateRepositoryDescription(description string) error {
 	return nil
 }
 
-func ValidateRepositoryId(repositoryId RepositoryId) error {
-	_, err := sdk.AccAddressFromBech32(repositoryId.Id)
+// ValidateOwnerId validates a user or dao id, either an address or a name
+func ValidateOwnerId(id string) error {
+	_, err := sdk.AccAddressFromBech32(id)
 	if err != nil {
-		if len(repositoryId.Id) < 3 {
+		if len(id) < 3 {
 			return fmt.Errorf("id must consist minimum 3 chars")
-		} else if len(repositoryId.Id) > 39 {
+		} else if len(id) > 39 {
 			return fmt.Errorf("id limit exceed: 39")
 		}
-		valid, err := regexp.MatchString("^[a-zA-Z0-9]+(?:[-]?[a-zA-Z0-9])*$", repositoryId.Id)
+		valid, err := regexp.MatchString("^[a-zA-Z0-9]+(?:[-]?[a-zA-Z0-9])*$", id)
 		if err != nil {
 			return fmt.Errorf(err.Error())
 		}
 		if !valid {
-			return fmt.Errorf("invalid id (%v)", repositoryId.Id)
+			return fmt.Errorf("invalid id (%v)", id)
 		}
 	}
 
+	return nil
+}
+
+func ValidateRepositoryId(repositoryId RepositoryId) error {
+	if err := ValidateOwnerId(repositoryId.Id); err != nil {
+		return err
+	}
+
 	if err := ValidateRepositoryName(repositoryId.Name); err != nil {
 		return err
 	}
@@ -122,6 +132,91 @@ func ValidateArweaveTxId(arweaveTxId string) error {
 	return nil
 }
 
+func ValidateBountySplit(split BountySplit) error {
+	switch split.Type {
+	case BountySplitTypeEqual:
+		if len(split.Shares) > 0 {
+			return fmt.Errorf("shares can't be specified for equal split")
+		}
+	case BountySplitTypePercentage:
+		if len(split.Shares) < 1 {
+			return fmt.Errorf("empty shares list")
+		} else if len(split.Shares) > 10 {
+			return fmt.Errorf("can't specify more than 10 shares")
+		}
+
+		unique := make(map[string]bool, len(split.Shares))
+		var total uint64
+		for _, share := range split.Shares {
+			if _, err := sdk.AccAddressFromBech32(share.Address); err != nil {
+				return fmt.Errorf("invalid share address (%v)", err)
+			}
+			if unique[share.Address] {
+				return fmt.Errorf("duplicate share address (%v)", share.Address)
+			}
+			unique[share.Address] = true
+			if share.Percentage == 0 || share.Percentage > 100 {
+				return fmt.Errorf("invalid share percentage (%v)", share.Percentage)
+			}
+			total += share.Percentage
+		}
+		if total != 100 {
+			return fmt.Errorf("share percentages must add up to 100")
+		}
+	default:
+		return fmt.Errorf("invalid split type (%v)", split.Type)
+	}
+
+	return nil
+}
+
+func ValidateBountyMilestones(milestones []BountyMilestone, amount sdk.Coins) error {
+	if len(milestones) > 20 {
+		return fmt.Errorf("can't specify more than 20 milestones")
+	}
+
+	var total sdk.Coins
+	for _, milestone := range milestones {
+		if len(milestone.Title) < 1 {
+			return fmt.Errorf("milestone title can't be empty")
+		} else if len(milestone.Title) > 255 {
+			return fmt.Errorf("milestone title exceeds limit: 255")
+		}
+		if len(milestone.Amount) == 0 {
+			return fmt.Errorf("empty milestone amount")
+		}
+		if err := milestone.Amount.Validate(); err != nil {
+			return err
+		}
+		if milestone.Released || milestone.ReleasedTo != "" || milestone.ReleasedAt != 0 {
+			return fmt.Errorf("milestone can't be created as released")
+		}
+		total = total.Add(milestone.Amount...)
+	}
+	if !total.IsAllLTE(amount) {
+		return fmt.Errorf("milestones exceed bounty amount")
+	}
+
+	return nil
+}
+
+func ValidateBountyDisputeResolution(resolution BountyDisputeResolution, payoutPercentage uint64) error {
+	switch resolution {
+	case BountyDisputeResolutionPayout, BountyDisputeResolutionRefund:
+		if payoutPercentage != 0 {
+			return fmt.Errorf("payout percentage can only be specified for split")
+		}
+	case BountyDisputeResolutionSplit:
+		if payoutPercentage == 0 || payoutPercentage >= 100 {
+			return fmt.Errorf("invalid payout percentage (%v)", payoutPercentage)
+		}
+	default:
+		return fmt.Errorf("invalid resolution (%v)", resolution)
+	}
+
+	return nil
+}
+
 func allUnique(slice interface{}) bool {
 	seen := make(map[interface{}]bool)
 	v := reflect.ValueOf(slice)
@@ -137,3 +232,122 @@ func allUnique(slice interface{}) bool {
 	}
 	return true
 }
+
+func ValidateBranchProtectionPattern(pattern string) error {
+	if len(pattern) == 0 {
+		return fmt.Errorf("pattern can't be empty")
+	} else if len(pattern) > 255 {
+		return fmt.Errorf("pattern length exceeds limit: 255")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid pattern (%v)", pattern)
+	}
+	return nil
+}
+
+func ValidateCommitStatusContext(context string) error {
+	if len(context) == 0 {
+		return fmt.Errorf("context can't be empty")
+	} else if len(context) > 255 {
+		return fmt.Errorf("context length exceeds limit: 255")
+	}
+	return nil
+}
+
+func ValidateMergeRequirements(requirements *MergeRequirements) error {
+	if requirements == nil {
+		return nil
+	}
+	if requirements.RequiredApprovals > 10 {
+		return fmt.Errorf("required approvals exceeds limit: 10")
+	}
+	if len(requirements.RequiredReviewers) > 10 {
+		return fmt.Errorf("required reviewers exceeds limit: 10")
+	}
+	unique := make(map[string]bool, len(requirements.RequiredReviewers))
+	for _, reviewer := range requirements.RequiredReviewers {
+		if _, err := sdk.AccAddressFromBech32(reviewer); err != nil {
+			return fmt.Errorf("invalid reviewer address (%v)", reviewer)
+		}
+		if unique[reviewer] {
+			return fmt.Errorf("duplicate reviewer (%v)", reviewer)
+		}
+		unique[reviewer] = true
+	}
+	if len(requirements.RequiredChecks) > 20 {
+		return fmt.Errorf("required checks exceeds limit: 20")
+	}
+	uniqueChecks := make(map[string]bool, len(requirements.RequiredChecks))
+	for _, check := range requirements.RequiredChecks {
+		if err := ValidateCommitStatusContext(check); err != nil {
+			return err
+		}
+		if uniqueChecks[check] {
+			return fmt.Errorf("duplicate required check (%v)", check)
+		}
+		uniqueChecks[check] = true
+	}
+	return nil
+}
+
+func ValidateMergeStrategy(strategy MergeStrategy) error {
+	if _, ok := MergeStrategy_name[int32(strategy)]; !ok {
+		return fmt.Errorf("invalid merge strategy (%v)", strategy)
+	}
+	return nil
+}
+
+// ValidateCloseReason checks a close reason given by a user, merged is only
+// set when a pull request is merged
+func ValidateCloseReason(reason CloseReason) error {
+	if _, ok := CloseReason_name[int32(reason)]; !ok || reason == CloseReasonMerged {
+		return fmt.Errorf("invalid close reason (%v)", reason)
+	}
+	return nil
+}
+
+func ValidateIssueLinkType(linkType IssueLinkType) error {
+	if _, ok := IssueLinkType_name[int32(linkType)]; !ok || linkType == IssueLinkTypeUnspecified {
+		return fmt.Errorf("invalid issue link type (%v)", linkType)
+	}
+	return nil
+}
+
+func ValidateMergeStrategies(strategies []MergeStrategy) error {
+	unique := make(map[MergeStrategy]bool, len(strategies))
+	for _, strategy := range strategies {
+		if strategy == MergeStrategyUnspecified {
+			return fmt.Errorf("unspecified merge strategy")
+		}
+		if err := ValidateMergeStrategy(strategy); err != nil {
+			return err
+		}
+		if unique[strategy] {
+			return fmt.Errorf("duplicate merge strategy (%v)", strategy)
+		}
+		unique[strategy] = true
+	}
+	return nil
+}
+
+func ValidateMilestone(title string, description string, dueDate int64) error {
+	if len(title) < 1 {
+		return fmt.Errorf("milestone title can't be empty")
+	} else if len(title) > 255 {
+		return fmt.Errorf("milestone title exceeds limit: 255")
+	}
+	if len(description) > 20000 {
+		return fmt.Errorf("milestone description exceeds limit: 20000")
+	}
+	if dueDate < 0 {
+		return fmt.Errorf("invalid milestone due date (%v)", dueDate)
+	}
+	return nil
+}
+
+func ValidateIssueWeight(weight uint64) error {
+	if weight > MaxIssueWeight {
+		return fmt.Errorf("issue weight exceeds limit: %v", MaxIssueWeight)
+	}
+	return nil
+}
@@ -0,0 +1,1040 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gitopia/milestone.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MilestoneState int32
+
+const (
+	MilestoneStateOpen   MilestoneState = 0
+	MilestoneStateClosed MilestoneState = 1
+)
+
+var MilestoneState_name = map[int32]string{
+	0: "MILESTONE_STATE_OPEN",
+	1: "MILESTONE_STATE_CLOSED",
+}
+
+var MilestoneState_value = map[string]int32{
+	"MILESTONE_STATE_OPEN":   0,
+	"MILESTONE_STATE_CLOSED": 1,
+}
+
+func (x MilestoneState) String() string {
+	return proto.EnumName(MilestoneState_name, int32(x))
+}
+
+func (MilestoneState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9835c1c9ae3a5dd0, []int{0}
+}
+
+type Milestone struct {
+	RepositoryId uint64         `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
+	Iid          uint64         `protobuf:"varint,2,opt,name=iid,proto3" json:"iid,omitempty"`
+	Creator      string         `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Title        string         `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string         `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DueDate      int64          `protobuf:"varint,6,opt,name=dueDate,proto3" json:"dueDate,omitempty"`
+	State        MilestoneState `protobuf:"varint,7,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.MilestoneState" json:"state,omitempty"`
+	CreatedAt    int64          `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt    int64          `protobuf:"varint,9,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ClosedAt     int64          `protobuf:"varint,10,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9835c1c9ae3a5dd0, []int{0}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetRepositoryId() uint64 {
+	if m != nil {
+		return m.RepositoryId
+	}
+	return 0
+}
+
+func (m *Milestone) GetIid() uint64 {
+	if m != nil {
+		return m.Iid
+	}
+	return 0
+}
+
+func (m *Milestone) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Milestone) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetDueDate() int64 {
+	if m != nil {
+		return m.DueDate
+	}
+	return 0
+}
+
+func (m *Milestone) GetState() MilestoneState {
+	if m != nil {
+		return m.State
+	}
+	return MilestoneStateOpen
+}
+
+func (m *Milestone) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Milestone) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Milestone) GetClosedAt() int64 {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return 0
+}
+
+// MilestoneProgress counts the issues and pullRequests of a milestone, merged
+// pullRequests count as closed
+type MilestoneProgress struct {
+	MilestoneIid       uint64 `protobuf:"varint,1,opt,name=milestoneIid,proto3" json:"milestoneIid,omitempty"`
+	OpenIssues         uint64 `protobuf:"varint,2,opt,name=openIssues,proto3" json:"openIssues,omitempty"`
+	ClosedIssues       uint64 `protobuf:"varint,3,opt,name=closedIssues,proto3" json:"closedIssues,omitempty"`
+	OpenPullRequests   uint64 `protobuf:"varint,4,opt,name=openPullRequests,proto3" json:"openPullRequests,omitempty"`
+	ClosedPullRequests uint64 `protobuf:"varint,5,opt,name=closedPullRequests,proto3" json:"closedPullRequests,omitempty"`
+	PercentComplete    uint64 `protobuf:"varint,6,opt,name=percentComplete,proto3" json:"percentComplete,omitempty"`
+}
+
+func (m *MilestoneProgress) Reset()         { *m = MilestoneProgress{} }
+func (m *MilestoneProgress) String() string { return proto.CompactTextString(m) }
+func (*MilestoneProgress) ProtoMessage()    {}
+func (*MilestoneProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9835c1c9ae3a5dd0, []int{1}
+}
+func (m *MilestoneProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MilestoneProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MilestoneProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MilestoneProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneProgress.Merge(m, src)
+}
+func (m *MilestoneProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MilestoneProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneProgress proto.InternalMessageInfo
+
+func (m *MilestoneProgress) GetMilestoneIid() uint64 {
+	if m != nil {
+		return m.MilestoneIid
+	}
+	return 0
+}
+
+func (m *MilestoneProgress) GetOpenIssues() uint64 {
+	if m != nil {
+		return m.OpenIssues
+	}
+	return 0
+}
+
+func (m *MilestoneProgress) GetClosedIssues() uint64 {
+	if m != nil {
+		return m.ClosedIssues
+	}
+	return 0
+}
+
+func (m *MilestoneProgress) GetOpenPullRequests() uint64 {
+	if m != nil {
+		return m.OpenPullRequests
+	}
+	return 0
+}
+
+func (m *MilestoneProgress) GetClosedPullRequests() uint64 {
+	if m != nil {
+		return m.ClosedPullRequests
+	}
+	return 0
+}
+
+func (m *MilestoneProgress) GetPercentComplete() uint64 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.MilestoneState", MilestoneState_name, MilestoneState_value)
+	proto.RegisterType((*Milestone)(nil), "gitopia.gitopia.gitopia.Milestone")
+	proto.RegisterType((*MilestoneProgress)(nil), "gitopia.gitopia.gitopia.MilestoneProgress")
+}
+
+func init() { proto.RegisterFile("gitopia/milestone.proto", fileDescriptor_9835c1c9ae3a5dd0) }
+
+var fileDescriptor_9835c1c9ae3a5dd0 = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0xed, 0xc4, 0x69, 0x9b, 0xf7, 0xfb, 0xa9, 0x84, 0x53, 0x54, 0x2c, 0x0b, 0x59, 0x56,
+	0x16, 0xac, 0x0c, 0x0e, 0x02, 0x56, 0x86, 0x92, 0x78, 0x88, 0xd4, 0x36, 0x91, 0x93, 0x89, 0xa5,
+	0x4a, 0xed, 0x27, 0x73, 0x92, 0xe3, 0x3b, 0x7c, 0x67, 0x89, 0x2e, 0x48, 0x6c, 0xa8, 0x13, 0xff,
+	0x40, 0x27, 0xfe, 0x15, 0x06, 0xc6, 0x8e, 0x8c, 0x28, 0xf9, 0x47, 0x90, 0xcf, 0xb1, 0x89, 0x03,
+	0x4c, 0xf7, 0xde, 0xe7, 0xfb, 0x7d, 0xef, 0xee, 0xde, 0xe9, 0xe0, 0x49, 0x4c, 0x25, 0xe3, 0x74,
+	0x35, 0x5a, 0xd3, 0x04, 0x85, 0x64, 0x29, 0x7a, 0x3c, 0x63, 0x92, 0x91, 0x4a, 0xf0, 0x0e, 0x56,
+	0xab, 0x1f, 0xb3, 0x98, 0x29, 0xcf, 0xa8, 0x88, 0x4a, 0xfb, 0xe0, 0x5b, 0x0b, 0xba, 0x97, 0x55,
+	0x0b, 0x32, 0x80, 0xff, 0x33, 0xe4, 0x4c, 0x50, 0xc9, 0xb2, 0xdb, 0x69, 0x64, 0xea, 0x8e, 0xee,
+	0x1a, 0x41, 0x83, 0x91, 0x1e, 0xb4, 0x29, 0x8d, 0xcc, 0x96, 0x92, 0x8a, 0x90, 0x98, 0x70, 0x1c,
+	0x66, 0xb8, 0x92, 0x2c, 0x33, 0xdb, 0x8e, 0xee, 0x76, 0x83, 0x2a, 0x25, 0x7d, 0xe8, 0x48, 0x2a,
+	0x13, 0x34, 0x0d, 0xc5, 0xcb, 0x84, 0x38, 0xf0, 0x5f, 0x84, 0x22, 0xcc, 0x28, 0x97, 0x94, 0xa5,
+	0x66, 0x47, 0x69, 0xfb, 0xa8, 0xe8, 0x18, 0xe5, 0x38, 0x59, 0x49, 0x34, 0x8f, 0x1c, 0xdd, 0x6d,
+	0x07, 0x55, 0x4a, 0x5e, 0x43, 0x47, 0xc8, 0x82, 0x1f, 0x3b, 0xba, 0x7b, 0xfa, 0xe2, 0x99, 0xf7,
+	0x8f, 0xeb, 0x7a, 0xf5, 0xa5, 0x16, 0x85, 0x3d, 0x28, 0xab, 0xc8, 0x53, 0xe8, 0xaa, 0xb3, 0x61,
+	0x74, 0x2e, 0xcd, 0x13, 0xd5, 0xfa, 0x37, 0x28, 0xd4, 0x9c, 0x47, 0x3b, 0xb5, 0x5b, 0xaa, 0x35,
+	0x20, 0x16, 0x9c, 0x84, 0x09, 0x13, 0x4a, 0x04, 0x25, 0xd6, 0xf9, 0xe0, 0x53, 0x0b, 0x1e, 0xd7,
+	0x3b, 0xce, 0x33, 0x16, 0x67, 0x28, 0x44, 0x31, 0xce, 0xfa, 0x79, 0xa6, 0xb4, 0x1e, 0xe7, 0x3e,
+	0x23, 0x36, 0x00, 0xe3, 0x98, 0x4e, 0x85, 0xc8, 0x51, 0xec, 0xa6, 0xba, 0x47, 0x8a, 0x1e, 0xe5,
+	0x2e, 0x3b, 0x47, 0xbb, 0xec, 0xb1, 0xcf, 0xc8, 0x10, 0x7a, 0x45, 0xc5, 0x3c, 0x4f, 0x92, 0x00,
+	0xdf, 0xe7, 0x28, 0xa4, 0x50, 0x13, 0x37, 0x82, 0x3f, 0x38, 0xf1, 0x80, 0x94, 0xb5, 0x0d, 0x77,
+	0x47, 0xb9, 0xff, 0xa2, 0x10, 0x17, 0x1e, 0x71, 0xcc, 0x42, 0x4c, 0xe5, 0x98, 0xad, 0x79, 0x82,
+	0xbb, 0x27, 0x31, 0x82, 0x43, 0x3c, 0xfc, 0x08, 0xa7, 0xcd, 0xa1, 0x93, 0xe7, 0xd0, 0xbf, 0x9c,
+	0x5e, 0xf8, 0x8b, 0xe5, 0xec, 0xca, 0xbf, 0x5e, 0x2c, 0xcf, 0x97, 0xfe, 0xf5, 0x6c, 0xee, 0x5f,
+	0xf5, 0x34, 0xeb, 0xec, 0xee, 0xde, 0x21, 0x4d, 0xf7, 0x8c, 0x63, 0x4a, 0x5e, 0xc1, 0xd9, 0x61,
+	0xc5, 0xf8, 0x62, 0xb6, 0xf0, 0x27, 0x3d, 0xdd, 0x32, 0xef, 0xee, 0x9d, 0x7e, 0xb3, 0x66, 0xac,
+	0xce, 0x6b, 0x19, 0x9f, 0xbf, 0xda, 0xda, 0x9b, 0xc9, 0xf7, 0x8d, 0xad, 0x3f, 0x6c, 0x6c, 0xfd,
+	0xe7, 0xc6, 0xd6, 0xbf, 0x6c, 0x6d, 0xed, 0x61, 0x6b, 0x6b, 0x3f, 0xb6, 0xb6, 0xf6, 0x76, 0x18,
+	0x53, 0xf9, 0x2e, 0xbf, 0xf1, 0x42, 0xb6, 0x1e, 0x55, 0xff, 0xa6, 0x5a, 0x3f, 0xd4, 0x91, 0xbc,
+	0xe5, 0x28, 0x6e, 0x8e, 0xd4, 0xbf, 0x78, 0xf9, 0x6b, 0x00, 0x77, 0x51, 0xdf, 0x53, 0x61, 0x03,
+	0x00, 0x00,
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClosedAt != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.ClosedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.State != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DueDate != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.DueDate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMilestone(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMilestone(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintMilestone(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Iid != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.Iid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RepositoryId != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.RepositoryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MilestoneProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MilestoneProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MilestoneProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PercentComplete != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.PercentComplete))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClosedPullRequests != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.ClosedPullRequests))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OpenPullRequests != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.OpenPullRequests))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClosedIssues != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.ClosedIssues))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OpenIssues != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.OpenIssues))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MilestoneIid != 0 {
+		i = encodeVarintMilestone(dAtA, i, uint64(m.MilestoneIid))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMilestone(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMilestone(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryId != 0 {
+		n += 1 + sovMilestone(uint64(m.RepositoryId))
+	}
+	if m.Iid != 0 {
+		n += 1 + sovMilestone(uint64(m.Iid))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovMilestone(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMilestone(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMilestone(uint64(l))
+	}
+	if m.DueDate != 0 {
+		n += 1 + sovMilestone(uint64(m.DueDate))
+	}
+	if m.State != 0 {
+		n += 1 + sovMilestone(uint64(m.State))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMilestone(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovMilestone(uint64(m.UpdatedAt))
+	}
+	if m.ClosedAt != 0 {
+		n += 1 + sovMilestone(uint64(m.ClosedAt))
+	}
+	return n
+}
+
+func (m *MilestoneProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MilestoneIid != 0 {
+		n += 1 + sovMilestone(uint64(m.MilestoneIid))
+	}
+	if m.OpenIssues != 0 {
+		n += 1 + sovMilestone(uint64(m.OpenIssues))
+	}
+	if m.ClosedIssues != 0 {
+		n += 1 + sovMilestone(uint64(m.ClosedIssues))
+	}
+	if m.OpenPullRequests != 0 {
+		n += 1 + sovMilestone(uint64(m.OpenPullRequests))
+	}
+	if m.ClosedPullRequests != 0 {
+		n += 1 + sovMilestone(uint64(m.ClosedPullRequests))
+	}
+	if m.PercentComplete != 0 {
+		n += 1 + sovMilestone(uint64(m.PercentComplete))
+	}
+	return n
+}
+
+func sovMilestone(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMilestone(x uint64) (n int) {
+	return sovMilestone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMilestone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Iid", wireType)
+			}
+			m.Iid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Iid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueDate", wireType)
+			}
+			m.DueDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueDate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= MilestoneState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			m.ClosedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMilestone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MilestoneProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMilestone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MilestoneProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MilestoneProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIid", wireType)
+			}
+			m.MilestoneIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestoneIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenIssues", wireType)
+			}
+			m.OpenIssues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenIssues |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedIssues", wireType)
+			}
+			m.ClosedIssues = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedIssues |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenPullRequests", wireType)
+			}
+			m.OpenPullRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenPullRequests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedPullRequests", wireType)
+			}
+			m.ClosedPullRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedPullRequests |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentComplete", wireType)
+			}
+			m.PercentComplete = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PercentComplete |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMilestone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMilestone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMilestone(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMilestone
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMilestone
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMilestone
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMilestone
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMilestone
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMilestone        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMilestone          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMilestone = fmt.Errorf("proto: unexpected end of group")
+)
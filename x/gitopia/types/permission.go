@@ -3,23 +3,41 @@ package types
 /* Minimum Allowed Permissions */
 const (
 	AssignPermission                      = RepositoryCollaborator_TRIAGE
+	AutoMergePermission                   = RepositoryCollaborator_ADMIN
+	BranchProtectionRulePermission        = RepositoryCollaborator_ADMIN
+	CommentOnLockedConversationPermission = RepositoryCollaborator_READ
 	DefaultBranchPermission               = RepositoryCollaborator_ADMIN
 	DeleteIssuePermission                 = RepositoryCollaborator_ADMIN
 	DeleteRepositoryPermission            = RepositoryCollaborator_ADMIN
+	HideCommentPermission                 = RepositoryCollaborator_TRIAGE
+	IssuePlanningPermission               = RepositoryCollaborator_TRIAGE
+	KeepAutoMergeOnPushPermission         = RepositoryCollaborator_MAINTAIN
 	LabelPermission                       = RepositoryCollaborator_TRIAGE
+	LinkIssuePermission                   = RepositoryCollaborator_TRIAGE
 	LinkPullRequestIssuePermission        = RepositoryCollaborator_TRIAGE
+	LockConversationPermission            = RepositoryCollaborator_TRIAGE
+	MergeRequirementsPermission           = RepositoryCollaborator_ADMIN
+	MergeStrategiesPermission             = RepositoryCollaborator_ADMIN
+	MilestonePermission                   = RepositoryCollaborator_TRIAGE
 	PullRequestCreatePermission           = RepositoryCollaborator_WRITE
+	PullRequestDraftPermission            = RepositoryCollaborator_WRITE
 	PullRequestMergePermission            = RepositoryCollaborator_WRITE
 	PushBranchPermission                  = RepositoryCollaborator_WRITE
 	PushProtectedBranchPermission         = RepositoryCollaborator_ADMIN
 	PushTagPermission                     = RepositoryCollaborator_WRITE
 	ReleasePermission                     = RepositoryCollaborator_WRITE
+	ReleaseBountyMilestonePermission      = RepositoryCollaborator_MAINTAIN
 	RepositoryCollaboratorPermission      = RepositoryCollaborator_ADMIN
 	RepositoryLabelPermission             = RepositoryCollaborator_WRITE
+	RepositoryMilestonePermission         = RepositoryCollaborator_WRITE
 	RepositoryRenamePermission            = RepositoryCollaborator_ADMIN
 	RepositoryTransferOwnershipPermission = RepositoryCollaborator_ADMIN
 	RepositoryUpdateDescriptionPermission = RepositoryCollaborator_MAINTAIN
+	ResolveCommentThreadPermission        = RepositoryCollaborator_TRIAGE
+	ReviewPullRequestPermission           = RepositoryCollaborator_READ
+	ToggleRepositoryArchivedPermission    = RepositoryCollaborator_ADMIN
 	ToggleRepositoryForkingPermission     = RepositoryCollaborator_ADMIN
+	TransferIssuePermission               = RepositoryCollaborator_TRIAGE
 	ToggleIssueStatePermission            = RepositoryCollaborator_TRIAGE
 	RepositoryBackupPermission            = RepositoryCollaborator_ADMIN
 	ToggleForcePushToBranchPermission     = RepositoryCollaborator_ADMIN
@@ -23,6 +23,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type PullRequestReviewVerdict int32
+
+const (
+	PullRequestReviewVerdictComment        PullRequestReviewVerdict = 0
+	PullRequestReviewVerdictApprove        PullRequestReviewVerdict = 1
+	PullRequestReviewVerdictRequestChanges PullRequestReviewVerdict = 2
+)
+
+var PullRequestReviewVerdict_name = map[int32]string{
+	0: "PULL_REQUEST_REVIEW_VERDICT_COMMENT",
+	1: "PULL_REQUEST_REVIEW_VERDICT_APPROVE",
+	2: "PULL_REQUEST_REVIEW_VERDICT_REQUEST_CHANGES",
+}
+
+var PullRequestReviewVerdict_value = map[string]int32{
+	"PULL_REQUEST_REVIEW_VERDICT_COMMENT":         0,
+	"PULL_REQUEST_REVIEW_VERDICT_APPROVE":         1,
+	"PULL_REQUEST_REVIEW_VERDICT_REQUEST_CHANGES": 2,
+}
+
+func (x PullRequestReviewVerdict) String() string {
+	return proto.EnumName(PullRequestReviewVerdict_name, int32(x))
+}
+
+func (PullRequestReviewVerdict) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{0}
+}
+
 type PullRequest_State int32
 
 const (
@@ -52,29 +80,36 @@ func (PullRequest_State) EnumDescriptor() ([]byte, []int) {
 }
 
 type PullRequest struct {
-	Creator             string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                  uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Iid                 uint64            `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`
-	Title               string            `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	State               PullRequest_State `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.PullRequest_State" json:"state,omitempty"`
-	Description         string            `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	Locked              bool              `protobuf:"varint,7,opt,name=locked,proto3" json:"locked,omitempty"`
-	CommentsCount       uint64            `protobuf:"varint,8,opt,name=commentsCount,proto3" json:"commentsCount,omitempty"`
-	Issues              []*IssueIid       `protobuf:"bytes,9,rep,name=issues,proto3" json:"issues,omitempty"`
-	Labels              []uint64          `protobuf:"varint,10,rep,packed,name=labels,proto3" json:"labels,omitempty"`
-	Assignees           []string          `protobuf:"bytes,11,rep,name=assignees,proto3" json:"assignees,omitempty"`
-	Reviewers           []string          `protobuf:"bytes,12,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
-	Draft               bool              `protobuf:"varint,13,opt,name=draft,proto3" json:"draft,omitempty"`
-	CreatedAt           int64             `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt           int64             `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	ClosedAt            int64             `protobuf:"varint,16,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
-	ClosedBy            string            `protobuf:"bytes,17,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
-	MergedAt            int64             `protobuf:"varint,18,opt,name=mergedAt,proto3" json:"mergedAt,omitempty"`
-	MergedBy            string            `protobuf:"bytes,19,opt,name=mergedBy,proto3" json:"mergedBy,omitempty"`
-	MergeCommitSha      string            `protobuf:"bytes,20,opt,name=mergeCommitSha,proto3" json:"mergeCommitSha,omitempty"`
-	MaintainerCanModify bool              `protobuf:"varint,21,opt,name=maintainerCanModify,proto3" json:"maintainerCanModify,omitempty"`
-	Head                *PullRequestHead  `protobuf:"bytes,22,opt,name=head,proto3" json:"head,omitempty"`
-	Base                *PullRequestBase  `protobuf:"bytes,23,opt,name=base,proto3" json:"base,omitempty"`
+	Creator             string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                  uint64                `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Iid                 uint64                `protobuf:"varint,3,opt,name=iid,proto3" json:"iid,omitempty"`
+	Title               string                `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	State               PullRequest_State     `protobuf:"varint,5,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.PullRequest_State" json:"state,omitempty"`
+	Description         string                `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Locked              bool                  `protobuf:"varint,7,opt,name=locked,proto3" json:"locked,omitempty"`
+	CommentsCount       uint64                `protobuf:"varint,8,opt,name=commentsCount,proto3" json:"commentsCount,omitempty"`
+	Issues              []*IssueIid           `protobuf:"bytes,9,rep,name=issues,proto3" json:"issues,omitempty"`
+	Labels              []uint64              `protobuf:"varint,10,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Assignees           []string              `protobuf:"bytes,11,rep,name=assignees,proto3" json:"assignees,omitempty"`
+	Reviewers           []string              `protobuf:"bytes,12,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Draft               bool                  `protobuf:"varint,13,opt,name=draft,proto3" json:"draft,omitempty"`
+	CreatedAt           int64                 `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt           int64                 `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ClosedAt            int64                 `protobuf:"varint,16,opt,name=closedAt,proto3" json:"closedAt,omitempty"`
+	ClosedBy            string                `protobuf:"bytes,17,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
+	MergedAt            int64                 `protobuf:"varint,18,opt,name=mergedAt,proto3" json:"mergedAt,omitempty"`
+	MergedBy            string                `protobuf:"bytes,19,opt,name=mergedBy,proto3" json:"mergedBy,omitempty"`
+	MergeCommitSha      string                `protobuf:"bytes,20,opt,name=mergeCommitSha,proto3" json:"mergeCommitSha,omitempty"`
+	MaintainerCanModify bool                  `protobuf:"varint,21,opt,name=maintainerCanModify,proto3" json:"maintainerCanModify,omitempty"`
+	Head                *PullRequestHead      `protobuf:"bytes,22,opt,name=head,proto3" json:"head,omitempty"`
+	Base                *PullRequestBase      `protobuf:"bytes,23,opt,name=base,proto3" json:"base,omitempty"`
+	Bounties            []uint64              `protobuf:"varint,24,rep,packed,name=bounties,proto3" json:"bounties,omitempty"`
+	Reviews             []PullRequestReview   `protobuf:"bytes,25,rep,name=reviews,proto3" json:"reviews"`
+	Reactions           []*Reaction           `protobuf:"bytes,26,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	LockReason          LockReason            `protobuf:"varint,27,opt,name=lockReason,proto3,enum=gitopia.gitopia.gitopia.LockReason" json:"lockReason,omitempty"`
+	AutoMerge           *PullRequestAutoMerge `protobuf:"bytes,28,opt,name=autoMerge,proto3" json:"autoMerge,omitempty"`
+	Milestone           uint64                `protobuf:"varint,29,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	CloseReason         CloseReason           `protobuf:"varint,30,opt,name=closeReason,proto3,enum=gitopia.gitopia.gitopia.CloseReason" json:"closeReason,omitempty"`
 }
 
 func (m *PullRequest) Reset()         { *m = PullRequest{} }
@@ -271,6 +306,123 @@ func (m *PullRequest) GetBase() *PullRequestBase {
 	return nil
 }
 
+func (m *PullRequest) GetBounties() []uint64 {
+	if m != nil {
+		return m.Bounties
+	}
+	return nil
+}
+
+func (m *PullRequest) GetReviews() []PullRequestReview {
+	if m != nil {
+		return m.Reviews
+	}
+	return nil
+}
+
+func (m *PullRequest) GetReactions() []*Reaction {
+	if m != nil {
+		return m.Reactions
+	}
+	return nil
+}
+
+func (m *PullRequest) GetLockReason() LockReason {
+	if m != nil {
+		return m.LockReason
+	}
+	return LockReasonNone
+}
+
+func (m *PullRequest) GetAutoMerge() *PullRequestAutoMerge {
+	if m != nil {
+		return m.AutoMerge
+	}
+	return nil
+}
+
+func (m *PullRequest) GetMilestone() uint64 {
+	if m != nil {
+		return m.Milestone
+	}
+	return 0
+}
+
+func (m *PullRequest) GetCloseReason() CloseReason {
+	if m != nil {
+		return m.CloseReason
+	}
+	return CloseReasonUnspecified
+}
+
+type PullRequestAutoMerge struct {
+	EnabledBy    string        `protobuf:"bytes,1,opt,name=enabledBy,proto3" json:"enabledBy,omitempty"`
+	Provider     string        `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	MergeOptions *MergeOptions `protobuf:"bytes,3,opt,name=mergeOptions,proto3" json:"mergeOptions,omitempty"`
+	EnabledAt    int64         `protobuf:"varint,4,opt,name=enabledAt,proto3" json:"enabledAt,omitempty"`
+}
+
+func (m *PullRequestAutoMerge) Reset()         { *m = PullRequestAutoMerge{} }
+func (m *PullRequestAutoMerge) String() string { return proto.CompactTextString(m) }
+func (*PullRequestAutoMerge) ProtoMessage()    {}
+func (*PullRequestAutoMerge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{1}
+}
+func (m *PullRequestAutoMerge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestAutoMerge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestAutoMerge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestAutoMerge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestAutoMerge.Merge(m, src)
+}
+func (m *PullRequestAutoMerge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestAutoMerge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestAutoMerge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestAutoMerge proto.InternalMessageInfo
+
+func (m *PullRequestAutoMerge) GetEnabledBy() string {
+	if m != nil {
+		return m.EnabledBy
+	}
+	return ""
+}
+
+func (m *PullRequestAutoMerge) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *PullRequestAutoMerge) GetMergeOptions() *MergeOptions {
+	if m != nil {
+		return m.MergeOptions
+	}
+	return nil
+}
+
+func (m *PullRequestAutoMerge) GetEnabledAt() int64 {
+	if m != nil {
+		return m.EnabledAt
+	}
+	return 0
+}
+
 type PullRequestHead struct {
 	RepositoryId uint64 `protobuf:"varint,1,opt,name=repositoryId,proto3" json:"repositoryId,omitempty"`
 	Branch       string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -281,7 +433,7 @@ func (m *PullRequestHead) Reset()         { *m = PullRequestHead{} }
 func (m *PullRequestHead) String() string { return proto.CompactTextString(m) }
 func (*PullRequestHead) ProtoMessage()    {}
 func (*PullRequestHead) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee729f91ddeb1e95, []int{1}
+	return fileDescriptor_ee729f91ddeb1e95, []int{2}
 }
 func (m *PullRequestHead) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +493,7 @@ func (m *PullRequestBase) Reset()         { *m = PullRequestBase{} }
 func (m *PullRequestBase) String() string { return proto.CompactTextString(m) }
 func (*PullRequestBase) ProtoMessage()    {}
 func (*PullRequestBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee729f91ddeb1e95, []int{2}
+	return fileDescriptor_ee729f91ddeb1e95, []int{3}
 }
 func (m *PullRequestBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,55 +543,259 @@ func (m *PullRequestBase) GetCommitSha() string {
 	return ""
 }
 
+type PullRequestReview struct {
+	Reviewer    string                   `protobuf:"bytes,1,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	Verdict     PullRequestReviewVerdict `protobuf:"varint,2,opt,name=verdict,proto3,enum=gitopia.gitopia.gitopia.PullRequestReviewVerdict" json:"verdict,omitempty"`
+	Body        string                   `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	CommitSha   string                   `protobuf:"bytes,4,opt,name=commitSha,proto3" json:"commitSha,omitempty"`
+	Stale       bool                     `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
+	CommentIid  uint64                   `protobuf:"varint,6,opt,name=commentIid,proto3" json:"commentIid,omitempty"`
+	SubmittedAt int64                    `protobuf:"varint,7,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"`
+}
+
+func (m *PullRequestReview) Reset()         { *m = PullRequestReview{} }
+func (m *PullRequestReview) String() string { return proto.CompactTextString(m) }
+func (*PullRequestReview) ProtoMessage()    {}
+func (*PullRequestReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{4}
+}
+func (m *PullRequestReview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestReview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestReview.Merge(m, src)
+}
+func (m *PullRequestReview) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestReview proto.InternalMessageInfo
+
+func (m *PullRequestReview) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetVerdict() PullRequestReviewVerdict {
+	if m != nil {
+		return m.Verdict
+	}
+	return PullRequestReviewVerdictComment
+}
+
+func (m *PullRequestReview) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetCommitSha() string {
+	if m != nil {
+		return m.CommitSha
+	}
+	return ""
+}
+
+func (m *PullRequestReview) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *PullRequestReview) GetCommentIid() uint64 {
+	if m != nil {
+		return m.CommentIid
+	}
+	return 0
+}
+
+func (m *PullRequestReview) GetSubmittedAt() int64 {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return 0
+}
+
+type PullRequestReviewSummary struct {
+	HeadCommitSha      string              `protobuf:"bytes,1,opt,name=headCommitSha,proto3" json:"headCommitSha,omitempty"`
+	ApprovedBy         []string            `protobuf:"bytes,2,rep,name=approvedBy,proto3" json:"approvedBy,omitempty"`
+	ChangesRequestedBy []string            `protobuf:"bytes,3,rep,name=changesRequestedBy,proto3" json:"changesRequestedBy,omitempty"`
+	PendingReviewers   []string            `protobuf:"bytes,4,rep,name=pendingReviewers,proto3" json:"pendingReviewers,omitempty"`
+	Reviews            []PullRequestReview `protobuf:"bytes,5,rep,name=reviews,proto3" json:"reviews"`
+}
+
+func (m *PullRequestReviewSummary) Reset()         { *m = PullRequestReviewSummary{} }
+func (m *PullRequestReviewSummary) String() string { return proto.CompactTextString(m) }
+func (*PullRequestReviewSummary) ProtoMessage()    {}
+func (*PullRequestReviewSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee729f91ddeb1e95, []int{5}
+}
+func (m *PullRequestReviewSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PullRequestReviewSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PullRequestReviewSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PullRequestReviewSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullRequestReviewSummary.Merge(m, src)
+}
+func (m *PullRequestReviewSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *PullRequestReviewSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullRequestReviewSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullRequestReviewSummary proto.InternalMessageInfo
+
+func (m *PullRequestReviewSummary) GetHeadCommitSha() string {
+	if m != nil {
+		return m.HeadCommitSha
+	}
+	return ""
+}
+
+func (m *PullRequestReviewSummary) GetApprovedBy() []string {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return nil
+}
+
+func (m *PullRequestReviewSummary) GetChangesRequestedBy() []string {
+	if m != nil {
+		return m.ChangesRequestedBy
+	}
+	return nil
+}
+
+func (m *PullRequestReviewSummary) GetPendingReviewers() []string {
+	if m != nil {
+		return m.PendingReviewers
+	}
+	return nil
+}
+
+func (m *PullRequestReviewSummary) GetReviews() []PullRequestReview {
+	if m != nil {
+		return m.Reviews
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.PullRequestReviewVerdict", PullRequestReviewVerdict_name, PullRequestReviewVerdict_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.PullRequest_State", PullRequest_State_name, PullRequest_State_value)
 	proto.RegisterType((*PullRequest)(nil), "gitopia.gitopia.gitopia.PullRequest")
+	proto.RegisterType((*PullRequestAutoMerge)(nil), "gitopia.gitopia.gitopia.PullRequestAutoMerge")
 	proto.RegisterType((*PullRequestHead)(nil), "gitopia.gitopia.gitopia.PullRequestHead")
 	proto.RegisterType((*PullRequestBase)(nil), "gitopia.gitopia.gitopia.PullRequestBase")
+	proto.RegisterType((*PullRequestReview)(nil), "gitopia.gitopia.gitopia.PullRequestReview")
+	proto.RegisterType((*PullRequestReviewSummary)(nil), "gitopia.gitopia.gitopia.PullRequestReviewSummary")
 }
 
 func init() { proto.RegisterFile("gitopia/pullRequest.proto", fileDescriptor_ee729f91ddeb1e95) }
 
 var fileDescriptor_ee729f91ddeb1e95 = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0x13, 0x3d,
-	0x14, 0xcd, 0x64, 0x92, 0x34, 0x71, 0xda, 0x34, 0x9f, 0xdb, 0xaf, 0x35, 0x15, 0x8a, 0x86, 0x08,
-	0xa1, 0xa1, 0x8b, 0x14, 0x95, 0x15, 0x12, 0x0b, 0x48, 0x1a, 0x41, 0x25, 0x4a, 0x2b, 0x77, 0xc7,
-	0xce, 0x19, 0xdf, 0x26, 0x56, 0x33, 0xe3, 0xc1, 0x76, 0x80, 0xbc, 0x05, 0x8f, 0xc5, 0xb2, 0x12,
-	0x1b, 0x96, 0xa8, 0x7d, 0x11, 0x64, 0x4f, 0x32, 0xd3, 0x46, 0x54, 0xea, 0x86, 0x95, 0xef, 0x39,
-	0xe7, 0x1e, 0xfb, 0xfa, 0xe7, 0x1a, 0x3d, 0x1a, 0x0b, 0x23, 0x53, 0xc1, 0x0e, 0xd2, 0xd9, 0x74,
-	0x4a, 0xe1, 0xf3, 0x0c, 0xb4, 0xe9, 0xa5, 0x4a, 0x1a, 0x89, 0x77, 0x17, 0x52, 0x6f, 0x65, 0xdc,
-	0xdb, 0x1e, 0xcb, 0xb1, 0x74, 0x39, 0x07, 0x36, 0xca, 0xd2, 0xf7, 0xc8, 0x72, 0x26, 0x05, 0xa9,
-	0xd4, 0xc2, 0x48, 0x35, 0xcf, 0x94, 0xee, 0xcf, 0x1a, 0x6a, 0x9e, 0x15, 0xd3, 0x63, 0x82, 0xd6,
-	0x22, 0x05, 0xcc, 0x48, 0x45, 0xbc, 0xc0, 0x0b, 0x1b, 0x74, 0x09, 0x71, 0x0b, 0x95, 0x05, 0x27,
-	0xe5, 0xc0, 0x0b, 0x2b, 0xb4, 0x2c, 0x38, 0x6e, 0x23, 0x5f, 0x08, 0x4e, 0x7c, 0x47, 0xd8, 0x10,
-	0x6f, 0xa3, 0xaa, 0x11, 0x66, 0x0a, 0xa4, 0xe2, 0x9c, 0x19, 0xc0, 0x6f, 0x50, 0x55, 0x1b, 0x66,
-	0x80, 0x54, 0x03, 0x2f, 0x6c, 0x1d, 0xee, 0xf7, 0xee, 0x29, 0xbd, 0x77, 0xab, 0x8c, 0xde, 0xb9,
-	0x75, 0xd0, 0xcc, 0x88, 0x03, 0xd4, 0xe4, 0xa0, 0x23, 0x25, 0x52, 0x23, 0x64, 0x42, 0x6a, 0x6e,
-	0xf6, 0xdb, 0x14, 0xde, 0x41, 0xb5, 0xa9, 0x8c, 0x2e, 0x81, 0x93, 0xb5, 0xc0, 0x0b, 0xeb, 0x74,
-	0x81, 0xf0, 0x53, 0xb4, 0x11, 0xc9, 0x38, 0x86, 0xc4, 0xe8, 0x81, 0x9c, 0x25, 0x86, 0xd4, 0x5d,
-	0xb5, 0x77, 0x49, 0xfc, 0x0a, 0xd5, 0x84, 0xd6, 0x33, 0xd0, 0xa4, 0x11, 0xf8, 0x61, 0xf3, 0xf0,
-	0xc9, 0xbd, 0x25, 0x1e, 0xdb, 0xb4, 0x63, 0xc1, 0xe9, 0xc2, 0xe0, 0x16, 0x66, 0x23, 0x98, 0x6a,
-	0x82, 0x02, 0x3f, 0xac, 0xd0, 0x05, 0xc2, 0x8f, 0x51, 0x83, 0x69, 0x2d, 0xc6, 0x09, 0x80, 0x26,
-	0xcd, 0xc0, 0x0f, 0x1b, 0xb4, 0x20, 0xac, 0xaa, 0xe0, 0x8b, 0x80, 0xaf, 0xa0, 0x34, 0x59, 0xcf,
-	0xd4, 0x9c, 0xb0, 0xc7, 0xc8, 0x15, 0xbb, 0x30, 0x64, 0xc3, 0xed, 0x25, 0x03, 0xd6, 0xe3, 0x6e,
-	0x02, 0xf8, 0x5b, 0x43, 0x5a, 0x81, 0x17, 0xfa, 0xb4, 0x20, 0xac, 0x3a, 0x4b, 0xf9, 0x42, 0xdd,
-	0xcc, 0xd4, 0x9c, 0xc0, 0x7b, 0xa8, 0x1e, 0x4d, 0xa5, 0x76, 0x62, 0xdb, 0x89, 0x39, 0x2e, 0xb4,
-	0xfe, 0x9c, 0xfc, 0xe7, 0x4e, 0x36, 0xc7, 0x56, 0x8b, 0x41, 0x8d, 0x9d, 0x0f, 0x67, 0xbe, 0x25,
-	0x2e, 0xb4, 0xfe, 0x9c, 0x6c, 0x65, 0xbe, 0x25, 0xc6, 0xcf, 0x50, 0xcb, 0xc5, 0x03, 0x19, 0xc7,
-	0xc2, 0x9c, 0x4f, 0x18, 0xd9, 0x76, 0x19, 0x2b, 0x2c, 0x7e, 0x81, 0xb6, 0x62, 0x26, 0x12, 0xc3,
-	0x44, 0x02, 0x6a, 0xc0, 0x92, 0x13, 0xc9, 0xc5, 0xc5, 0x9c, 0xfc, 0xef, 0xf6, 0xfd, 0x37, 0x09,
-	0xbf, 0x46, 0x95, 0x09, 0x30, 0x4e, 0x76, 0x02, 0x2f, 0x6c, 0x1e, 0x86, 0x0f, 0x79, 0x4b, 0xef,
-	0x81, 0x71, 0xea, 0x5c, 0xd6, 0x3d, 0x62, 0x1a, 0xc8, 0xee, 0xc3, 0xdd, 0x7d, 0xa6, 0x81, 0x3a,
-	0x57, 0xf7, 0x39, 0xaa, 0xba, 0x67, 0x89, 0xeb, 0xa8, 0x72, 0x7a, 0x36, 0xfc, 0xd8, 0x2e, 0x61,
-	0x84, 0x6a, 0x83, 0x0f, 0xa7, 0xe7, 0xc3, 0xa3, 0xb6, 0x67, 0xe3, 0x93, 0x21, 0x7d, 0x37, 0x3c,
-	0x6a, 0x97, 0xbb, 0x97, 0x68, 0x73, 0xa5, 0x02, 0xdc, 0x45, 0xeb, 0x45, 0xf3, 0x1d, 0x73, 0xd7,
-	0x5d, 0x15, 0x7a, 0x87, 0xb3, 0xaf, 0x69, 0xa4, 0x58, 0x12, 0x4d, 0x5c, 0x9b, 0x35, 0xe8, 0x02,
-	0xb9, 0xbb, 0xcf, 0x8f, 0xd2, 0x77, 0x52, 0x41, 0xac, 0x2c, 0x66, 0x0b, 0xfe, 0x77, 0x8b, 0xf5,
-	0x8f, 0x7e, 0x5c, 0x77, 0xbc, 0xab, 0xeb, 0x8e, 0xf7, 0xfb, 0xba, 0xe3, 0x7d, 0xbf, 0xe9, 0x94,
-	0xae, 0x6e, 0x3a, 0xa5, 0x5f, 0x37, 0x9d, 0xd2, 0xa7, 0xfd, 0xb1, 0x30, 0x93, 0xd9, 0xa8, 0x17,
-	0xc9, 0xf8, 0x60, 0xf9, 0xdd, 0x2c, 0xc7, 0x6f, 0x79, 0x64, 0xe6, 0x29, 0xe8, 0x51, 0xcd, 0x7d,
-	0x3e, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xf7, 0x57, 0xa3, 0xe2, 0x04, 0x00, 0x00,
+	// 1111 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x72, 0x1b, 0x45,
+	0x10, 0xd6, 0x4a, 0xeb, 0x1f, 0x8d, 0x12, 0x47, 0x99, 0x98, 0x64, 0x22, 0x82, 0xb2, 0xc8, 0x21,
+	0x25, 0x4c, 0x21, 0x83, 0x39, 0x51, 0x45, 0x15, 0xc8, 0xf2, 0x92, 0x88, 0x58, 0xb6, 0x19, 0x39,
+	0xa6, 0x0a, 0x0e, 0xae, 0x91, 0x76, 0x22, 0x4f, 0x59, 0xda, 0x5d, 0x76, 0x46, 0x06, 0xbd, 0x00,
+	0x95, 0xca, 0x89, 0x2b, 0x87, 0x9c, 0x78, 0x0a, 0xde, 0x20, 0xc7, 0x1c, 0x39, 0x51, 0x94, 0xfd,
+	0x0a, 0x3c, 0x00, 0x35, 0x3d, 0xfb, 0x23, 0xff, 0x28, 0x71, 0x15, 0xc5, 0x69, 0xa7, 0xbf, 0xee,
+	0xaf, 0xa7, 0xa7, 0xbb, 0xa7, 0x67, 0xd1, 0xdd, 0x81, 0x50, 0x41, 0x28, 0xd8, 0x5a, 0x38, 0x1e,
+	0x0e, 0x29, 0xff, 0x71, 0xcc, 0xa5, 0x6a, 0x84, 0x51, 0xa0, 0x02, 0x7c, 0x27, 0x56, 0x35, 0xce,
+	0x7d, 0x2b, 0xcb, 0x83, 0x60, 0x10, 0x80, 0xcd, 0x9a, 0x5e, 0x19, 0xf3, 0x0a, 0x49, 0x3c, 0x45,
+	0x3c, 0x0c, 0xa4, 0x50, 0x41, 0x34, 0x89, 0x35, 0xb7, 0x33, 0x0d, 0xeb, 0x2b, 0x11, 0xf8, 0x31,
+	0x7e, 0x2b, 0xc1, 0x85, 0x94, 0x63, 0x1e, 0x83, 0x38, 0x01, 0x15, 0x93, 0x47, 0x06, 0xab, 0xfd,
+	0x53, 0x44, 0xa5, 0xdd, 0x2c, 0x3e, 0x4c, 0xd0, 0x42, 0x3f, 0xe2, 0x4c, 0x05, 0x11, 0xb1, 0x1c,
+	0xab, 0x5e, 0xa4, 0x89, 0x88, 0x97, 0x50, 0x5e, 0x78, 0x24, 0xef, 0x58, 0x75, 0x9b, 0xe6, 0x85,
+	0x87, 0xcb, 0xa8, 0x20, 0x84, 0x47, 0x0a, 0x00, 0xe8, 0x25, 0x5e, 0x46, 0x73, 0x4a, 0xa8, 0x21,
+	0x27, 0x36, 0x30, 0x8d, 0x80, 0xbf, 0x42, 0x73, 0x52, 0x31, 0xc5, 0xc9, 0x9c, 0x63, 0xd5, 0x97,
+	0xd6, 0x57, 0x1b, 0x33, 0xce, 0xde, 0x98, 0x0a, 0xa3, 0xd1, 0xd5, 0x0c, 0x6a, 0x88, 0xd8, 0x41,
+	0x25, 0x8f, 0xcb, 0x7e, 0x24, 0x42, 0x7d, 0x42, 0x32, 0x0f, 0xde, 0xa7, 0x21, 0x7c, 0x1b, 0xcd,
+	0x0f, 0x83, 0xfe, 0x11, 0xf7, 0xc8, 0x82, 0x63, 0xd5, 0x17, 0x69, 0x2c, 0xe1, 0x07, 0xe8, 0x7a,
+	0x3f, 0x18, 0x8d, 0xb8, 0xaf, 0x64, 0x2b, 0x18, 0xfb, 0x8a, 0x2c, 0x42, 0xb4, 0x67, 0x41, 0xfc,
+	0x39, 0x9a, 0x87, 0x34, 0x49, 0x52, 0x74, 0x0a, 0xf5, 0xd2, 0xfa, 0xfb, 0x33, 0x43, 0x6c, 0x6b,
+	0xb3, 0xb6, 0xf0, 0x68, 0x4c, 0x80, 0x8d, 0x59, 0x8f, 0x0f, 0x25, 0x41, 0x4e, 0xa1, 0x6e, 0xd3,
+	0x58, 0xc2, 0xf7, 0x50, 0x91, 0x49, 0x29, 0x06, 0x3e, 0xe7, 0x92, 0x94, 0x9c, 0x42, 0xbd, 0x48,
+	0x33, 0x40, 0x6b, 0x23, 0x7e, 0x2c, 0xf8, 0x4f, 0x3c, 0x92, 0xe4, 0x9a, 0xd1, 0xa6, 0x80, 0x4e,
+	0xa3, 0x17, 0xb1, 0x67, 0x8a, 0x5c, 0x87, 0xb3, 0x18, 0x41, 0x73, 0xa0, 0x12, 0xdc, 0x6b, 0x2a,
+	0xb2, 0xe4, 0x58, 0xf5, 0x02, 0xcd, 0x00, 0xad, 0x1d, 0x87, 0x5e, 0xac, 0xbd, 0x61, 0xb4, 0x29,
+	0x80, 0x2b, 0x68, 0xb1, 0x3f, 0x0c, 0x24, 0x28, 0xcb, 0xa0, 0x4c, 0xe5, 0x4c, 0xb7, 0x31, 0x21,
+	0x37, 0x21, 0xb3, 0xa9, 0xac, 0x75, 0x23, 0x1e, 0x0d, 0x80, 0x87, 0x0d, 0x2f, 0x91, 0x33, 0xdd,
+	0xc6, 0x84, 0xdc, 0x32, 0xbc, 0x44, 0xc6, 0x0f, 0xd1, 0x12, 0xac, 0x5b, 0xc1, 0x68, 0x24, 0x54,
+	0xf7, 0x90, 0x91, 0x65, 0xb0, 0x38, 0x87, 0xe2, 0x4f, 0xd0, 0xad, 0x11, 0x13, 0xbe, 0x62, 0xc2,
+	0xe7, 0x51, 0x8b, 0xf9, 0x9d, 0xc0, 0x13, 0xcf, 0x26, 0xe4, 0x1d, 0x38, 0xf7, 0x65, 0x2a, 0xfc,
+	0x05, 0xb2, 0x0f, 0x39, 0xf3, 0xc8, 0x6d, 0xc7, 0xaa, 0x97, 0xd6, 0xeb, 0x57, 0xe9, 0xa5, 0xc7,
+	0x9c, 0x79, 0x14, 0x58, 0x9a, 0xdd, 0x63, 0x92, 0x93, 0x3b, 0x57, 0x67, 0x6f, 0x30, 0xc9, 0x29,
+	0xb0, 0xf4, 0x89, 0x7b, 0xba, 0x5f, 0x04, 0x97, 0x84, 0x40, 0xb5, 0x53, 0x19, 0x7f, 0x83, 0x16,
+	0x4c, 0x01, 0x25, 0xb9, 0x0b, 0x3d, 0x74, 0xa5, 0x36, 0xa7, 0x40, 0xd9, 0xb0, 0x5f, 0xfd, 0x75,
+	0x3f, 0x47, 0x13, 0x07, 0xf8, 0x4b, 0x54, 0x4c, 0x6e, 0xb3, 0x24, 0x95, 0xb7, 0x74, 0x24, 0x8d,
+	0x2d, 0x69, 0xc6, 0xc1, 0x2d, 0x84, 0x74, 0xff, 0x53, 0xce, 0x64, 0xe0, 0x93, 0x77, 0xe1, 0xda,
+	0xad, 0xcc, 0xf4, 0xb0, 0x95, 0x9a, 0xd2, 0x29, 0x1a, 0x7e, 0x82, 0x8a, 0x6c, 0xac, 0x82, 0x8e,
+	0xae, 0x18, 0xb9, 0x07, 0x09, 0xfb, 0xf8, 0x2a, 0x67, 0x6a, 0x26, 0x24, 0x9a, 0xf1, 0x75, 0x7b,
+	0x8e, 0xc4, 0x90, 0x4b, 0x15, 0xf8, 0x9c, 0xbc, 0x07, 0x77, 0x30, 0x03, 0xf0, 0xd7, 0xa8, 0x04,
+	0x2d, 0x17, 0x07, 0x5c, 0x85, 0x80, 0x1f, 0xcc, 0xdc, 0xac, 0x95, 0xd9, 0xd2, 0x69, 0x62, 0xed,
+	0x43, 0x34, 0x07, 0x73, 0x03, 0x2f, 0x22, 0x7b, 0x67, 0xd7, 0xdd, 0x2e, 0xe7, 0x30, 0x42, 0xf3,
+	0xad, 0xad, 0x9d, 0xae, 0xbb, 0x59, 0xb6, 0xf4, 0xba, 0xe3, 0xd2, 0x47, 0xee, 0x66, 0x39, 0x5f,
+	0xfb, 0xc3, 0x42, 0xcb, 0x97, 0x05, 0xad, 0x23, 0xe5, 0x3e, 0xeb, 0x0d, 0xa1, 0xaf, 0xcd, 0x04,
+	0xcc, 0x00, 0xdd, 0x02, 0x61, 0x14, 0x1c, 0x0b, 0x8f, 0x47, 0x30, 0x09, 0x8b, 0x34, 0x95, 0x71,
+	0x1b, 0x5d, 0x83, 0xf6, 0xde, 0x09, 0x4d, 0xe5, 0x0a, 0x90, 0xb3, 0x0f, 0x66, 0x1e, 0xa3, 0x33,
+	0x65, 0x4c, 0xcf, 0x50, 0xa7, 0x82, 0x68, 0x2a, 0x18, 0xa6, 0x05, 0x9a, 0x01, 0xb5, 0x23, 0x74,
+	0xe3, 0x5c, 0x7b, 0xe3, 0x1a, 0xba, 0x96, 0x3d, 0x0d, 0x6d, 0x0f, 0x02, 0xb7, 0xe9, 0x19, 0x4c,
+	0x8f, 0xaa, 0x5e, 0xc4, 0xfc, 0xfe, 0x61, 0x1c, 0x79, 0x2c, 0xc1, 0x60, 0x49, 0xef, 0x69, 0xc1,
+	0x9c, 0x38, 0x05, 0xce, 0x6d, 0xa6, 0x6f, 0xc3, 0xff, 0xb8, 0xd9, 0x2f, 0x79, 0x74, 0xf3, 0xc2,
+	0xf5, 0xd0, 0x49, 0x4f, 0x86, 0x63, 0x5c, 0x91, 0x54, 0xc6, 0x4f, 0xd0, 0xc2, 0x31, 0x8f, 0x3c,
+	0xd1, 0x57, 0xb0, 0xd1, 0xd2, 0xfa, 0xa7, 0x57, 0xbf, 0x77, 0xfb, 0x86, 0x48, 0x13, 0x0f, 0x18,
+	0x23, 0xbb, 0x17, 0x78, 0x93, 0x38, 0x2e, 0x58, 0x9f, 0x0d, 0xd8, 0x3e, 0x17, 0xb0, 0x1e, 0xd5,
+	0x52, 0xb1, 0xa1, 0x79, 0xdb, 0x16, 0xa9, 0x11, 0x70, 0x15, 0xa1, 0xf8, 0x81, 0x69, 0x0b, 0x0f,
+	0x9e, 0x2b, 0x9b, 0x4e, 0x21, 0xfa, 0x3d, 0x93, 0xe3, 0xde, 0x48, 0x28, 0x33, 0xae, 0x17, 0xa0,
+	0xc0, 0xd3, 0x50, 0xed, 0x79, 0x1e, 0x91, 0x0b, 0xf1, 0x76, 0xc7, 0xa3, 0x11, 0x8b, 0x26, 0xfa,
+	0x51, 0xd3, 0xd3, 0x2c, 0x1b, 0xae, 0x26, 0x29, 0x67, 0x41, 0x1d, 0x04, 0x0b, 0x75, 0x73, 0x42,
+	0x27, 0xe7, 0xe1, 0x91, 0x99, 0x42, 0x70, 0x03, 0xe1, 0xfe, 0x21, 0xf3, 0x07, 0x5c, 0xc6, 0x9b,
+	0x80, 0x5d, 0x01, 0xec, 0x2e, 0xd1, 0xe0, 0x55, 0x54, 0x0e, 0xb9, 0xef, 0x09, 0x7f, 0x40, 0xd3,
+	0xa7, 0xcb, 0x06, 0xeb, 0x0b, 0xf8, 0xf4, 0x34, 0x9c, 0xfb, 0x8f, 0xd3, 0x70, 0xf5, 0xb7, 0xcb,
+	0x52, 0x11, 0x97, 0x0e, 0x6f, 0xa1, 0x95, 0xdd, 0xa7, 0x5b, 0x5b, 0x07, 0xd4, 0xfd, 0xf6, 0xa9,
+	0xdb, 0xdd, 0x3b, 0xa0, 0xee, 0x7e, 0xdb, 0xfd, 0xee, 0x60, 0xdf, 0xa5, 0x9b, 0xed, 0xd6, 0xde,
+	0x41, 0x6b, 0xa7, 0xd3, 0x71, 0xb7, 0xf7, 0xca, 0xb9, 0xca, 0xca, 0x8b, 0x97, 0xce, 0xfd, 0x59,
+	0x6e, 0x5a, 0xa6, 0x34, 0x6f, 0xf3, 0xd6, 0xdc, 0xdd, 0xa5, 0x3b, 0xfb, 0x6e, 0xd9, 0x7a, 0xb3,
+	0xb7, 0xa6, 0xc9, 0x31, 0xfe, 0x01, 0x7d, 0xf4, 0x26, 0x6f, 0x09, 0xdc, 0x7a, 0xdc, 0xdc, 0x7e,
+	0xe4, 0x76, 0xcb, 0xf9, 0xca, 0xea, 0x8b, 0x97, 0xce, 0xc3, 0x99, 0x5d, 0x6a, 0xb0, 0x96, 0x29,
+	0x4c, 0xc5, 0x7e, 0xfe, 0x7b, 0x35, 0xb7, 0xb1, 0xf9, 0xea, 0xa4, 0x6a, 0xbd, 0x3e, 0xa9, 0x5a,
+	0x7f, 0x9f, 0x54, 0xad, 0x5f, 0x4f, 0xab, 0xb9, 0xd7, 0xa7, 0xd5, 0xdc, 0x9f, 0xa7, 0xd5, 0xdc,
+	0xf7, 0xab, 0x03, 0xa1, 0x0e, 0xc7, 0xbd, 0x46, 0x3f, 0x18, 0xad, 0x25, 0x7f, 0x7d, 0xc9, 0xf7,
+	0xe7, 0x74, 0xa5, 0x26, 0x21, 0x97, 0xbd, 0x79, 0xf8, 0x13, 0xfc, 0xec, 0xdf, 0x01, 0x00, 0x98,
+	0x4b, 0x4e, 0xd9, 0xb0, 0x0a, 0x00, 0x00,
 }
 
 func (m *PullRequest) Marshal() (dAtA []byte, err error) {
@@ -462,6 +818,93 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CloseReason != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.CloseReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.Milestone != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.Milestone))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.AutoMerge != nil {
+		{
+			size, err := m.AutoMerge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPullRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.LockReason != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.LockReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.Reactions) > 0 {
+		for iNdEx := len(m.Reactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Reviews) > 0 {
+		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Bounties) > 0 {
+		dAtA3 := make([]byte, len(m.Bounties)*10)
+		var j2 int
+		for _, num := range m.Bounties {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintPullRequest(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
 	if m.Base != nil {
 		{
 			size, err := m.Base.MarshalToSizedBuffer(dAtA[:i])
@@ -582,20 +1025,20 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Labels) > 0 {
-		dAtA4 := make([]byte, len(m.Labels)*10)
-		var j3 int
+		dAtA7 := make([]byte, len(m.Labels)*10)
+		var j6 int
 		for _, num := range m.Labels {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j6++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintPullRequest(dAtA, i, uint64(j3))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintPullRequest(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -667,7 +1110,7 @@ func (m *PullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
+func (m *PullRequestAutoMerge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -677,25 +1120,79 @@ func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PullRequestHead) MarshalTo(dAtA []byte) (int, error) {
+func (m *PullRequestAutoMerge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PullRequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PullRequestAutoMerge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CommitSha) > 0 {
-		i -= len(m.CommitSha)
-		copy(dAtA[i:], m.CommitSha)
-		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+	if m.EnabledAt != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.EnabledAt))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
+	if m.MergeOptions != nil {
+		{
+			size, err := m.MergeOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPullRequest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EnabledBy) > 0 {
+		i -= len(m.EnabledBy)
+		copy(dAtA[i:], m.EnabledBy)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.EnabledBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestHead) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestHead) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestHead) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitSha) > 0 {
+		i -= len(m.CommitSha)
+		copy(dAtA[i:], m.CommitSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
 		copy(dAtA[i:], m.Branch)
 		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Branch)))
 		i--
@@ -751,6 +1248,146 @@ func (m *PullRequestBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PullRequestReview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestReview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestReview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmittedAt != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.SubmittedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CommentIid != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.CommentIid))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CommitSha) > 0 {
+		i -= len(m.CommitSha)
+		copy(dAtA[i:], m.CommitSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.CommitSha)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Verdict != 0 {
+		i = encodeVarintPullRequest(dAtA, i, uint64(m.Verdict))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullRequestReviewSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullRequestReviewSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullRequestReviewSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reviews) > 0 {
+		for iNdEx := len(m.Reviews) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reviews[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPullRequest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PendingReviewers) > 0 {
+		for iNdEx := len(m.PendingReviewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingReviewers[iNdEx])
+			copy(dAtA[i:], m.PendingReviewers[iNdEx])
+			i = encodeVarintPullRequest(dAtA, i, uint64(len(m.PendingReviewers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ChangesRequestedBy) > 0 {
+		for iNdEx := len(m.ChangesRequestedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangesRequestedBy[iNdEx])
+			copy(dAtA[i:], m.ChangesRequestedBy[iNdEx])
+			i = encodeVarintPullRequest(dAtA, i, uint64(len(m.ChangesRequestedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ApprovedBy) > 0 {
+		for iNdEx := len(m.ApprovedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ApprovedBy[iNdEx])
+			copy(dAtA[i:], m.ApprovedBy[iNdEx])
+			i = encodeVarintPullRequest(dAtA, i, uint64(len(m.ApprovedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HeadCommitSha) > 0 {
+		i -= len(m.HeadCommitSha)
+		copy(dAtA[i:], m.HeadCommitSha)
+		i = encodeVarintPullRequest(dAtA, i, uint64(len(m.HeadCommitSha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPullRequest(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPullRequest(v)
 	base := offset
@@ -858,6 +1495,62 @@ func (m *PullRequest) Size() (n int) {
 		l = m.Base.Size()
 		n += 2 + l + sovPullRequest(uint64(l))
 	}
+	if len(m.Bounties) > 0 {
+		l = 0
+		for _, e := range m.Bounties {
+			l += sovPullRequest(uint64(e))
+		}
+		n += 2 + sovPullRequest(uint64(l)) + l
+	}
+	if len(m.Reviews) > 0 {
+		for _, e := range m.Reviews {
+			l = e.Size()
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.Reactions) > 0 {
+		for _, e := range m.Reactions {
+			l = e.Size()
+			n += 2 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if m.LockReason != 0 {
+		n += 2 + sovPullRequest(uint64(m.LockReason))
+	}
+	if m.AutoMerge != nil {
+		l = m.AutoMerge.Size()
+		n += 2 + l + sovPullRequest(uint64(l))
+	}
+	if m.Milestone != 0 {
+		n += 2 + sovPullRequest(uint64(m.Milestone))
+	}
+	if m.CloseReason != 0 {
+		n += 2 + sovPullRequest(uint64(m.CloseReason))
+	}
+	return n
+}
+
+func (m *PullRequestAutoMerge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EnabledBy)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.MergeOptions != nil {
+		l = m.MergeOptions.Size()
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.EnabledAt != 0 {
+		n += 1 + sovPullRequest(uint64(m.EnabledAt))
+	}
 	return n
 }
 
@@ -901,6 +1594,76 @@ func (m *PullRequestBase) Size() (n int) {
 	return n
 }
 
+func (m *PullRequestReview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.Verdict != 0 {
+		n += 1 + sovPullRequest(uint64(m.Verdict))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	l = len(m.CommitSha)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.CommentIid != 0 {
+		n += 1 + sovPullRequest(uint64(m.CommentIid))
+	}
+	if m.SubmittedAt != 0 {
+		n += 1 + sovPullRequest(uint64(m.SubmittedAt))
+	}
+	return n
+}
+
+func (m *PullRequestReviewSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeadCommitSha)
+	if l > 0 {
+		n += 1 + l + sovPullRequest(uint64(l))
+	}
+	if len(m.ApprovedBy) > 0 {
+		for _, s := range m.ApprovedBy {
+			l = len(s)
+			n += 1 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.ChangesRequestedBy) > 0 {
+		for _, s := range m.ChangesRequestedBy {
+			l = len(s)
+			n += 1 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.PendingReviewers) > 0 {
+		for _, s := range m.PendingReviewers {
+			l = len(s)
+			n += 1 + l + sovPullRequest(uint64(l))
+		}
+	}
+	if len(m.Reviews) > 0 {
+		for _, e := range m.Reviews {
+			l = e.Size()
+			n += 1 + l + sovPullRequest(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovPullRequest(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1586,41 +2349,713 @@ func (m *PullRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPullRequest(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPullRequest
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPullRequest
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
+		case 24:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPullRequest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Bounties = append(m.Bounties, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPullRequest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPullRequest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPullRequest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Bounties) == 0 {
+					m.Bounties = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPullRequest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Bounties = append(m.Bounties, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounties", wireType)
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviews = append(m.Reviews, PullRequestReview{})
+			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reactions = append(m.Reactions, &Reaction{})
+			if err := m.Reactions[len(m.Reactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockReason", wireType)
+			}
+			m.LockReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockReason |= LockReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoMerge == nil {
+				m.AutoMerge = &PullRequestAutoMerge{}
+			}
+			if err := m.AutoMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseReason", wireType)
+			}
+			m.CloseReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CloseReason |= CloseReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestAutoMerge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestAutoMerge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestAutoMerge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeOptions == nil {
+				m.MergeOptions = &MergeOptions{}
+			}
+			if err := m.MergeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledAt", wireType)
+			}
+			m.EnabledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnabledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PullRequestBase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PullRequestBase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			}
+			m.RepositoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RepositoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPullRequest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PullRequestReview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPullRequest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -1630,17 +3065,17 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestReview: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestReview: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
 			}
-			m.RepositoryId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPullRequest
@@ -1650,14 +3085,46 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verdict", wireType)
+			}
+			m.Verdict = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Verdict |= PullRequestReviewVerdict(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1685,9 +3152,9 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CommitSha", wireType)
 			}
@@ -1719,6 +3186,64 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 			}
 			m.CommitSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentIid", wireType)
+			}
+			m.CommentIid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentIid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAt", wireType)
+			}
+			m.SubmittedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPullRequest(dAtA[iNdEx:])
@@ -1740,7 +3265,7 @@ func (m *PullRequestHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
+func (m *PullRequestReviewSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,17 +3288,17 @@ func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PullRequestBase: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullRequestReviewSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PullRequestBase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullRequestReviewSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadCommitSha", wireType)
 			}
-			m.RepositoryId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPullRequest
@@ -1783,14 +3308,27 @@ func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RepositoryId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadCommitSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1818,11 +3356,11 @@ func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.ApprovedBy = append(m.ApprovedBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSha", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangesRequestedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1850,7 +3388,73 @@ func (m *PullRequestBase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommitSha = string(dAtA[iNdEx:postIndex])
+			m.ChangesRequestedBy = append(m.ChangesRequestedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReviewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReviewers = append(m.PendingReviewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviews", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPullRequest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPullRequest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviews = append(m.Reviews, PullRequestReview{})
+			if err := m.Reviews[len(m.Reviews)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28,16 +28,34 @@ type Emoji int32
 const (
 	EmojiThumbsUp   Emoji = 0
 	EmojiThumbsDown Emoji = 1
+	EmojiLaugh      Emoji = 2
+	EmojiHooray     Emoji = 3
+	EmojiConfused   Emoji = 4
+	EmojiHeart      Emoji = 5
+	EmojiRocket     Emoji = 6
+	EmojiEyes       Emoji = 7
 )
 
 var Emoji_name = map[int32]string{
 	0: "EMOJI_THUMBS_UP",
 	1: "EMOJI_THUMBS_DOWN",
+	2: "EMOJI_LAUGH",
+	3: "EMOJI_HOORAY",
+	4: "EMOJI_CONFUSED",
+	5: "EMOJI_HEART",
+	6: "EMOJI_ROCKET",
+	7: "EMOJI_EYES",
 }
 
 var Emoji_value = map[string]int32{
 	"EMOJI_THUMBS_UP":   0,
 	"EMOJI_THUMBS_DOWN": 1,
+	"EMOJI_LAUGH":       2,
+	"EMOJI_HOORAY":      3,
+	"EMOJI_CONFUSED":    4,
+	"EMOJI_HEART":       5,
+	"EMOJI_ROCKET":      6,
+	"EMOJI_EYES":        7,
 }
 
 func (x Emoji) String() string {
@@ -100,31 +118,151 @@ func (m *Reaction) GetEmojis() []Emoji {
 	return nil
 }
 
+type ReactionCount struct {
+	Emoji Emoji  `protobuf:"varint,1,opt,name=emoji,proto3,enum=gitopia.gitopia.gitopia.Emoji" json:"emoji,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ReactionCount) Reset()         { *m = ReactionCount{} }
+func (m *ReactionCount) String() string { return proto.CompactTextString(m) }
+func (*ReactionCount) ProtoMessage()    {}
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6751c3791b2fecc0, []int{1}
+}
+func (m *ReactionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReactionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReactionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReactionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionCount.Merge(m, src)
+}
+func (m *ReactionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReactionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionCount proto.InternalMessageInfo
+
+func (m *ReactionCount) GetEmoji() Emoji {
+	if m != nil {
+		return m.Emoji
+	}
+	return EmojiThumbsUp
+}
+
+func (m *ReactionCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type CommentReactionCounts struct {
+	CommentId      uint64          `protobuf:"varint,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	ReactionCounts []ReactionCount `protobuf:"bytes,2,rep,name=reactionCounts,proto3" json:"reactionCounts"`
+}
+
+func (m *CommentReactionCounts) Reset()         { *m = CommentReactionCounts{} }
+func (m *CommentReactionCounts) String() string { return proto.CompactTextString(m) }
+func (*CommentReactionCounts) ProtoMessage()    {}
+func (*CommentReactionCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6751c3791b2fecc0, []int{2}
+}
+func (m *CommentReactionCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommentReactionCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommentReactionCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommentReactionCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentReactionCounts.Merge(m, src)
+}
+func (m *CommentReactionCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommentReactionCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentReactionCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentReactionCounts proto.InternalMessageInfo
+
+func (m *CommentReactionCounts) GetCommentId() uint64 {
+	if m != nil {
+		return m.CommentId
+	}
+	return 0
+}
+
+func (m *CommentReactionCounts) GetReactionCounts() []ReactionCount {
+	if m != nil {
+		return m.ReactionCounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.Emoji", Emoji_name, Emoji_value)
 	proto.RegisterType((*Reaction)(nil), "gitopia.gitopia.gitopia.Reaction")
+	proto.RegisterType((*ReactionCount)(nil), "gitopia.gitopia.gitopia.ReactionCount")
+	proto.RegisterType((*CommentReactionCounts)(nil), "gitopia.gitopia.gitopia.CommentReactionCounts")
 }
 
 func init() { proto.RegisterFile("gitopia/reaction.proto", fileDescriptor_6751c3791b2fecc0) }
 
 var fileDescriptor_6751c3791b2fecc0 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xcf, 0x2c, 0xc9,
-	0x2f, 0xc8, 0x4c, 0xd4, 0x2f, 0x4a, 0x4d, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x87, 0x8a, 0xeb, 0xa1, 0xd1, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60,
-	0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x52, 0x0c, 0x17, 0x47, 0x10, 0xd4, 0x00, 0x21, 0x09, 0x2e,
-	0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x18,
-	0x57, 0xc8, 0x8c, 0x8b, 0x2d, 0x35, 0x37, 0x3f, 0x2b, 0xb3, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83,
-	0xcf, 0x48, 0x4e, 0x0f, 0x87, 0x2d, 0x7a, 0xae, 0x20, 0x65, 0x41, 0x50, 0xd5, 0x5a, 0x89, 0x5c,
-	0xac, 0x60, 0x01, 0x21, 0x35, 0x2e, 0x7e, 0x57, 0x5f, 0x7f, 0x2f, 0xcf, 0xf8, 0x10, 0x8f, 0x50,
-	0x5f, 0xa7, 0xe0, 0xf8, 0xd0, 0x00, 0x01, 0x06, 0x29, 0xc1, 0xae, 0xb9, 0x0a, 0xbc, 0x60, 0xf9,
-	0x90, 0x8c, 0xd2, 0xdc, 0xa4, 0xe2, 0xd0, 0x02, 0x21, 0x2d, 0x2e, 0x41, 0x14, 0x75, 0x2e, 0xfe,
-	0xe1, 0x7e, 0x02, 0x8c, 0x52, 0xc2, 0x5d, 0x73, 0x15, 0xf8, 0x91, 0x54, 0xba, 0xe4, 0x97, 0xe7,
-	0x49, 0xb1, 0x74, 0x2c, 0x96, 0x63, 0x70, 0x72, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x58,
-	0x60, 0xc1, 0xe8, 0x0a, 0x38, 0xab, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x1a, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96, 0x5c, 0xd6, 0x80, 0x56, 0x01, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x8e, 0xd2, 0x50,
+	0x14, 0x87, 0x5b, 0xa6, 0x30, 0x72, 0x10, 0xe8, 0x5c, 0x47, 0x25, 0x8d, 0x76, 0x2a, 0x89, 0x13,
+	0xc2, 0xa2, 0x24, 0xa3, 0x71, 0x0f, 0xa5, 0xda, 0xd1, 0x99, 0xa9, 0xb9, 0xb4, 0x31, 0xa3, 0x26,
+	0x93, 0x52, 0x6a, 0xa9, 0xa6, 0xbd, 0xa4, 0x7f, 0xa2, 0xbc, 0x81, 0xa9, 0x1b, 0x5f, 0xa0, 0x2b,
+	0x1f, 0xc0, 0xd7, 0x98, 0xe5, 0x2c, 0x5d, 0x19, 0x03, 0x2f, 0x62, 0xb8, 0x05, 0x81, 0x49, 0x26,
+	0xae, 0xee, 0xbd, 0xe7, 0x7c, 0xfd, 0x7d, 0xa7, 0xc9, 0x81, 0x7b, 0xae, 0x17, 0x93, 0x89, 0x67,
+	0x75, 0x42, 0xc7, 0xb2, 0x63, 0x8f, 0x04, 0xf2, 0x24, 0x24, 0x31, 0x41, 0xf7, 0x97, 0x75, 0xf9,
+	0xda, 0x29, 0xec, 0xbb, 0xc4, 0x25, 0x94, 0xe9, 0x2c, 0x6e, 0x39, 0xde, 0x7c, 0x0f, 0xb7, 0xf0,
+	0x32, 0x00, 0x35, 0x60, 0xd7, 0x1a, 0x8d, 0x42, 0x27, 0x8a, 0x1a, 0xac, 0xc4, 0xb6, 0xca, 0x78,
+	0xf5, 0x44, 0xcf, 0xa0, 0xe4, 0xf8, 0xe4, 0xa3, 0x17, 0x35, 0x0a, 0xd2, 0x4e, 0xab, 0x76, 0x24,
+	0xca, 0x37, 0x58, 0x64, 0x75, 0x81, 0xe1, 0x25, 0xdd, 0x7c, 0x07, 0xd5, 0x55, 0xba, 0x42, 0x92,
+	0x20, 0x46, 0x4f, 0xa1, 0x48, 0x5b, 0x54, 0xf0, 0xff, 0x9c, 0x1c, 0x46, 0xfb, 0x50, 0xb4, 0x17,
+	0x9f, 0x37, 0x0a, 0x12, 0xdb, 0xe2, 0x70, 0xfe, 0x68, 0x7e, 0x63, 0xe1, 0xae, 0x42, 0x7c, 0xdf,
+	0x09, 0xe2, 0x2d, 0x49, 0x84, 0x1e, 0x40, 0xd9, 0xce, 0x1b, 0xc7, 0x23, 0x6a, 0xe2, 0xf0, 0xba,
+	0x80, 0x0c, 0xa8, 0x85, 0x5b, 0x3c, 0xfd, 0xa9, 0xca, 0xd1, 0xe1, 0x8d, 0xc3, 0x6c, 0xc5, 0xf7,
+	0xb8, 0xcb, 0xdf, 0x07, 0x0c, 0xbe, 0x96, 0xd1, 0xfe, 0x59, 0x80, 0x22, 0x1d, 0x1a, 0x1d, 0x42,
+	0x5d, 0x3d, 0xd5, 0x5f, 0x1e, 0x5f, 0x18, 0x9a, 0x79, 0xda, 0x1b, 0x5c, 0x98, 0xaf, 0x79, 0x46,
+	0xd8, 0x4b, 0x33, 0xa9, 0x4a, 0xfb, 0xc6, 0x38, 0xf1, 0x87, 0x91, 0x39, 0x41, 0x6d, 0xd8, 0xdb,
+	0xe2, 0xfa, 0xfa, 0x9b, 0x33, 0x9e, 0x15, 0xee, 0xa4, 0x99, 0x54, 0xdf, 0x20, 0xfb, 0xe4, 0x73,
+	0x80, 0x0e, 0xa0, 0x92, 0xb3, 0x27, 0x5d, 0xf3, 0x85, 0xc6, 0x17, 0x84, 0x5a, 0x9a, 0x49, 0x40,
+	0xa9, 0x13, 0x2b, 0x71, 0xc7, 0xe8, 0x11, 0xdc, 0xce, 0x01, 0x4d, 0xd7, 0x71, 0xf7, 0x9c, 0xdf,
+	0x11, 0xea, 0x69, 0x26, 0x55, 0x28, 0xa1, 0x11, 0x12, 0x5a, 0x53, 0xf4, 0x18, 0x6a, 0x39, 0xa2,
+	0xe8, 0x67, 0xcf, 0xcd, 0x81, 0xda, 0xe7, 0xb9, 0x8d, 0xb1, 0x14, 0x12, 0x7c, 0x48, 0x22, 0x67,
+	0xb4, 0x56, 0x69, 0x6a, 0x17, 0x1b, 0x7c, 0x71, 0x43, 0xa5, 0x39, 0x56, 0x18, 0xaf, 0x55, 0x58,
+	0x57, 0x5e, 0xa9, 0x06, 0x5f, 0xda, 0x50, 0x61, 0x62, 0x7f, 0x72, 0x62, 0xf4, 0x10, 0x20, 0x47,
+	0xd4, 0x73, 0x75, 0xc0, 0xef, 0x0a, 0xd5, 0x34, 0x93, 0xca, 0x14, 0x50, 0xa7, 0x4e, 0x24, 0x70,
+	0x5f, 0x7f, 0x88, 0x4c, 0xaf, 0x7f, 0x39, 0x13, 0xd9, 0xab, 0x99, 0xc8, 0xfe, 0x99, 0x89, 0xec,
+	0xf7, 0xb9, 0xc8, 0x5c, 0xcd, 0x45, 0xe6, 0xd7, 0x5c, 0x64, 0xde, 0xb6, 0x5d, 0x2f, 0x1e, 0x27,
+	0x43, 0xd9, 0x26, 0x7e, 0x67, 0xb5, 0xe6, 0xab, 0xf3, 0xcb, 0xbf, 0x5b, 0x3c, 0x9d, 0x38, 0xd1,
+	0xb0, 0x44, 0xf7, 0xf8, 0xc9, 0xdf, 0x01, 0x00, 0x05, 0xb0, 0xdc, 0x3d, 0x10, 0x03, 0x00, 0x00,
 }
 
 func (m *Reaction) Marshal() (dAtA []byte, err error) {
@@ -175,6 +313,81 @@ func (m *Reaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReactionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReactionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReactionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Emoji != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Emoji))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommentReactionCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommentReactionCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommentReactionCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReactionCounts) > 0 {
+		for iNdEx := len(m.ReactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CommentId != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.CommentId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReaction(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReaction(v)
 	base := offset
@@ -206,6 +419,39 @@ func (m *Reaction) Size() (n int) {
 	return n
 }
 
+func (m *ReactionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Emoji != 0 {
+		n += 1 + sovReaction(uint64(m.Emoji))
+	}
+	if m.Count != 0 {
+		n += 1 + sovReaction(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *CommentReactionCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentId != 0 {
+		n += 1 + sovReaction(uint64(m.CommentId))
+	}
+	if len(m.ReactionCounts) > 0 {
+		for _, e := range m.ReactionCounts {
+			l = e.Size()
+			n += 1 + l + sovReaction(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovReaction(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -363,6 +609,197 @@ func (m *Reaction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReactionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReactionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReactionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emoji", wireType)
+			}
+			m.Emoji = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Emoji |= Emoji(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommentReactionCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommentReactionCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommentReactionCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentId", wireType)
+			}
+			m.CommentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactionCounts = append(m.ReactionCounts, ReactionCount{})
+			if err := m.ReactionCounts[len(m.ReactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReaction(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MergeStrategy int32
+
+const (
+	MergeStrategyUnspecified MergeStrategy = 0
+	MergeStrategyMerge       MergeStrategy = 1
+	MergeStrategySquash      MergeStrategy = 2
+	MergeStrategyRebase      MergeStrategy = 3
+)
+
+var MergeStrategy_name = map[int32]string{
+	0: "MERGE_STRATEGY_UNSPECIFIED",
+	1: "MERGE_STRATEGY_MERGE",
+	2: "MERGE_STRATEGY_SQUASH",
+	3: "MERGE_STRATEGY_REBASE",
+}
+
+var MergeStrategy_value = map[string]int32{
+	"MERGE_STRATEGY_UNSPECIFIED": 0,
+	"MERGE_STRATEGY_MERGE":       1,
+	"MERGE_STRATEGY_SQUASH":      2,
+	"MERGE_STRATEGY_REBASE":      3,
+}
+
+func (x MergeStrategy) String() string {
+	return proto.EnumName(MergeStrategy_name, int32(x))
+}
+
+func (MergeStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{0}
+}
+
 type RepositoryCollaborator_Permission int32
 
 const (
@@ -79,36 +110,40 @@ func (x RepositoryBackup_Store) String() string {
 }
 
 func (RepositoryBackup_Store) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{9, 0}
+	return fileDescriptor_771033d6361900fa, []int{11, 0}
 }
 
 type Repository struct {
-	Creator             string                    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Id                  uint64                    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name                string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Owner               *RepositoryOwner          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	Description         string                    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Forks               []uint64                  `protobuf:"varint,6,rep,packed,name=forks,proto3" json:"forks,omitempty"`
-	Subscribers         string                    `protobuf:"bytes,7,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
-	Commits             string                    `protobuf:"bytes,8,opt,name=commits,proto3" json:"commits,omitempty"`
-	IssuesCount         uint64                    `protobuf:"varint,9,opt,name=issuesCount,proto3" json:"issuesCount,omitempty"`
-	PullsCount          uint64                    `protobuf:"varint,10,opt,name=pullsCount,proto3" json:"pullsCount,omitempty"`
-	Labels              []*RepositoryLabel        `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
-	LabelsCount         uint64                    `protobuf:"varint,12,opt,name=labelsCount,proto3" json:"labelsCount,omitempty"`
-	Releases            []*RepositoryRelease      `protobuf:"bytes,13,rep,name=releases,proto3" json:"releases,omitempty"`
-	CreatedAt           int64                     `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt           int64                     `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	PushedAt            int64                     `protobuf:"varint,16,opt,name=pushedAt,proto3" json:"pushedAt,omitempty"`
-	Stargazers          []uint64                  `protobuf:"varint,17,rep,packed,name=stargazers,proto3" json:"stargazers,omitempty"`
-	Archived            bool                      `protobuf:"varint,18,opt,name=archived,proto3" json:"archived,omitempty"`
-	License             string                    `protobuf:"bytes,19,opt,name=license,proto3" json:"license,omitempty"`
-	DefaultBranch       string                    `protobuf:"bytes,20,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
-	Parent              uint64                    `protobuf:"varint,21,opt,name=parent,proto3" json:"parent,omitempty"`
-	Fork                bool                      `protobuf:"varint,22,opt,name=fork,proto3" json:"fork,omitempty"`
-	Collaborators       []*RepositoryCollaborator `protobuf:"bytes,23,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
-	AllowForking        bool                      `protobuf:"varint,24,opt,name=allowForking,proto3" json:"allowForking,omitempty"`
-	Backups             []*RepositoryBackup       `protobuf:"bytes,25,rep,name=backups,proto3" json:"backups,omitempty"`
-	EnableArweaveBackup bool                      `protobuf:"varint,26,opt,name=enableArweaveBackup,proto3" json:"enableArweaveBackup,omitempty"`
+	Creator                string                    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Id                     uint64                    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name                   string                    `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Owner                  *RepositoryOwner          `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	Description            string                    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Forks                  []uint64                  `protobuf:"varint,6,rep,packed,name=forks,proto3" json:"forks,omitempty"`
+	Subscribers            string                    `protobuf:"bytes,7,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Commits                string                    `protobuf:"bytes,8,opt,name=commits,proto3" json:"commits,omitempty"`
+	IssuesCount            uint64                    `protobuf:"varint,9,opt,name=issuesCount,proto3" json:"issuesCount,omitempty"`
+	PullsCount             uint64                    `protobuf:"varint,10,opt,name=pullsCount,proto3" json:"pullsCount,omitempty"`
+	Labels                 []*RepositoryLabel        `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty"`
+	LabelsCount            uint64                    `protobuf:"varint,12,opt,name=labelsCount,proto3" json:"labelsCount,omitempty"`
+	Releases               []*RepositoryRelease      `protobuf:"bytes,13,rep,name=releases,proto3" json:"releases,omitempty"`
+	CreatedAt              int64                     `protobuf:"varint,14,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt              int64                     `protobuf:"varint,15,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	PushedAt               int64                     `protobuf:"varint,16,opt,name=pushedAt,proto3" json:"pushedAt,omitempty"`
+	Stargazers             []uint64                  `protobuf:"varint,17,rep,packed,name=stargazers,proto3" json:"stargazers,omitempty"`
+	Archived               bool                      `protobuf:"varint,18,opt,name=archived,proto3" json:"archived,omitempty"`
+	License                string                    `protobuf:"bytes,19,opt,name=license,proto3" json:"license,omitempty"`
+	DefaultBranch          string                    `protobuf:"bytes,20,opt,name=defaultBranch,proto3" json:"defaultBranch,omitempty"`
+	Parent                 uint64                    `protobuf:"varint,21,opt,name=parent,proto3" json:"parent,omitempty"`
+	Fork                   bool                      `protobuf:"varint,22,opt,name=fork,proto3" json:"fork,omitempty"`
+	Collaborators          []*RepositoryCollaborator `protobuf:"bytes,23,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
+	AllowForking           bool                      `protobuf:"varint,24,opt,name=allowForking,proto3" json:"allowForking,omitempty"`
+	Backups                []*RepositoryBackup       `protobuf:"bytes,25,rep,name=backups,proto3" json:"backups,omitempty"`
+	EnableArweaveBackup    bool                      `protobuf:"varint,26,opt,name=enableArweaveBackup,proto3" json:"enableArweaveBackup,omitempty"`
+	BranchProtectionRules  []*BranchProtectionRule   `protobuf:"bytes,27,rep,name=branchProtectionRules,proto3" json:"branchProtectionRules,omitempty"`
+	MergeRequirements      *MergeRequirements        `protobuf:"bytes,28,opt,name=mergeRequirements,proto3" json:"mergeRequirements,omitempty"`
+	AllowedMergeStrategies []MergeStrategy           `protobuf:"varint,29,rep,packed,name=allowedMergeStrategies,proto3,enum=gitopia.gitopia.gitopia.MergeStrategy" json:"allowedMergeStrategies,omitempty"`
+	MilestonesCount        uint64                    `protobuf:"varint,30,opt,name=milestonesCount,proto3" json:"milestonesCount,omitempty"`
 }
 
 func (m *Repository) Reset()         { *m = Repository{} }
@@ -326,6 +361,34 @@ func (m *Repository) GetEnableArweaveBackup() bool {
 	return false
 }
 
+func (m *Repository) GetBranchProtectionRules() []*BranchProtectionRule {
+	if m != nil {
+		return m.BranchProtectionRules
+	}
+	return nil
+}
+
+func (m *Repository) GetMergeRequirements() *MergeRequirements {
+	if m != nil {
+		return m.MergeRequirements
+	}
+	return nil
+}
+
+func (m *Repository) GetAllowedMergeStrategies() []MergeStrategy {
+	if m != nil {
+		return m.AllowedMergeStrategies
+	}
+	return nil
+}
+
+func (m *Repository) GetMilestonesCount() uint64 {
+	if m != nil {
+		return m.MilestonesCount
+	}
+	return 0
+}
+
 type RepositoryId struct {
 	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -646,6 +709,158 @@ func (m *RepositoryCollaborator) GetPermission() RepositoryCollaborator_Permissi
 	return RepositoryCollaborator_READ
 }
 
+type BranchProtectionRule struct {
+	Pattern              string                            `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	MinPushPermission    RepositoryCollaborator_Permission `protobuf:"varint,2,opt,name=minPushPermission,proto3,enum=gitopia.gitopia.gitopia.RepositoryCollaborator_Permission" json:"minPushPermission,omitempty"`
+	RequirePullRequest   bool                              `protobuf:"varint,3,opt,name=requirePullRequest,proto3" json:"requirePullRequest,omitempty"`
+	AllowDeletion        bool                              `protobuf:"varint,4,opt,name=allowDeletion,proto3" json:"allowDeletion,omitempty"`
+	MergeRequirements    *MergeRequirements                `protobuf:"bytes,5,opt,name=mergeRequirements,proto3" json:"mergeRequirements,omitempty"`
+	DefaultMergeStrategy MergeStrategy                     `protobuf:"varint,6,opt,name=defaultMergeStrategy,proto3,enum=gitopia.gitopia.gitopia.MergeStrategy" json:"defaultMergeStrategy,omitempty"`
+}
+
+func (m *BranchProtectionRule) Reset()         { *m = BranchProtectionRule{} }
+func (m *BranchProtectionRule) String() string { return proto.CompactTextString(m) }
+func (*BranchProtectionRule) ProtoMessage()    {}
+func (*BranchProtectionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{7}
+}
+func (m *BranchProtectionRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BranchProtectionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BranchProtectionRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BranchProtectionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BranchProtectionRule.Merge(m, src)
+}
+func (m *BranchProtectionRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *BranchProtectionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_BranchProtectionRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BranchProtectionRule proto.InternalMessageInfo
+
+func (m *BranchProtectionRule) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
+func (m *BranchProtectionRule) GetMinPushPermission() RepositoryCollaborator_Permission {
+	if m != nil {
+		return m.MinPushPermission
+	}
+	return RepositoryCollaborator_READ
+}
+
+func (m *BranchProtectionRule) GetRequirePullRequest() bool {
+	if m != nil {
+		return m.RequirePullRequest
+	}
+	return false
+}
+
+func (m *BranchProtectionRule) GetAllowDeletion() bool {
+	if m != nil {
+		return m.AllowDeletion
+	}
+	return false
+}
+
+func (m *BranchProtectionRule) GetMergeRequirements() *MergeRequirements {
+	if m != nil {
+		return m.MergeRequirements
+	}
+	return nil
+}
+
+func (m *BranchProtectionRule) GetDefaultMergeStrategy() MergeStrategy {
+	if m != nil {
+		return m.DefaultMergeStrategy
+	}
+	return MergeStrategyUnspecified
+}
+
+type MergeRequirements struct {
+	RequiredApprovals       uint64   `protobuf:"varint,1,opt,name=requiredApprovals,proto3" json:"requiredApprovals,omitempty"`
+	BlockOnChangesRequested bool     `protobuf:"varint,2,opt,name=blockOnChangesRequested,proto3" json:"blockOnChangesRequested,omitempty"`
+	RequiredReviewers       []string `protobuf:"bytes,3,rep,name=requiredReviewers,proto3" json:"requiredReviewers,omitempty"`
+	RequiredChecks          []string `protobuf:"bytes,4,rep,name=requiredChecks,proto3" json:"requiredChecks,omitempty"`
+}
+
+func (m *MergeRequirements) Reset()         { *m = MergeRequirements{} }
+func (m *MergeRequirements) String() string { return proto.CompactTextString(m) }
+func (*MergeRequirements) ProtoMessage()    {}
+func (*MergeRequirements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_771033d6361900fa, []int{8}
+}
+func (m *MergeRequirements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeRequirements.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeRequirements.Merge(m, src)
+}
+func (m *MergeRequirements) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeRequirements.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeRequirements proto.InternalMessageInfo
+
+func (m *MergeRequirements) GetRequiredApprovals() uint64 {
+	if m != nil {
+		return m.RequiredApprovals
+	}
+	return 0
+}
+
+func (m *MergeRequirements) GetBlockOnChangesRequested() bool {
+	if m != nil {
+		return m.BlockOnChangesRequested
+	}
+	return false
+}
+
+func (m *MergeRequirements) GetRequiredReviewers() []string {
+	if m != nil {
+		return m.RequiredReviewers
+	}
+	return nil
+}
+
+func (m *MergeRequirements) GetRequiredChecks() []string {
+	if m != nil {
+		return m.RequiredChecks
+	}
+	return nil
+}
+
 type RepositoryLabel struct {
 	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -657,7 +872,7 @@ func (m *RepositoryLabel) Reset()         { *m = RepositoryLabel{} }
 func (m *RepositoryLabel) String() string { return proto.CompactTextString(m) }
 func (*RepositoryLabel) ProtoMessage()    {}
 func (*RepositoryLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{7}
+	return fileDescriptor_771033d6361900fa, []int{9}
 }
 func (m *RepositoryLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +938,7 @@ func (m *RepositoryRelease) Reset()         { *m = RepositoryRelease{} }
 func (m *RepositoryRelease) String() string { return proto.CompactTextString(m) }
 func (*RepositoryRelease) ProtoMessage()    {}
 func (*RepositoryRelease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{8}
+	return fileDescriptor_771033d6361900fa, []int{10}
 }
 func (m *RepositoryRelease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +990,7 @@ func (m *RepositoryBackup) Reset()         { *m = RepositoryBackup{} }
 func (m *RepositoryBackup) String() string { return proto.CompactTextString(m) }
 func (*RepositoryBackup) ProtoMessage()    {}
 func (*RepositoryBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_771033d6361900fa, []int{9}
+	return fileDescriptor_771033d6361900fa, []int{11}
 }
 func (m *RepositoryBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -819,6 +1034,7 @@ func (m *RepositoryBackup) GetRefs() []string {
 }
 
 func init() {
+	proto.RegisterEnum("gitopia.gitopia.gitopia.MergeStrategy", MergeStrategy_name, MergeStrategy_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryCollaborator_Permission", RepositoryCollaborator_Permission_name, RepositoryCollaborator_Permission_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.RepositoryBackup_Store", RepositoryBackup_Store_name, RepositoryBackup_Store_value)
 	proto.RegisterType((*Repository)(nil), "gitopia.gitopia.gitopia.Repository")
@@ -828,6 +1044,8 @@ func init() {
 	proto.RegisterType((*IssueIid)(nil), "gitopia.gitopia.gitopia.IssueIid")
 	proto.RegisterType((*PullRequestIid)(nil), "gitopia.gitopia.gitopia.PullRequestIid")
 	proto.RegisterType((*RepositoryCollaborator)(nil), "gitopia.gitopia.gitopia.RepositoryCollaborator")
+	proto.RegisterType((*BranchProtectionRule)(nil), "gitopia.gitopia.gitopia.BranchProtectionRule")
+	proto.RegisterType((*MergeRequirements)(nil), "gitopia.gitopia.gitopia.MergeRequirements")
 	proto.RegisterType((*RepositoryLabel)(nil), "gitopia.gitopia.gitopia.RepositoryLabel")
 	proto.RegisterType((*RepositoryRelease)(nil), "gitopia.gitopia.gitopia.RepositoryRelease")
 	proto.RegisterType((*RepositoryBackup)(nil), "gitopia.gitopia.gitopia.RepositoryBackup")
@@ -836,62 +1054,87 @@ func init() {
 func init() { proto.RegisterFile("gitopia/repository.proto", fileDescriptor_771033d6361900fa) }
 
 var fileDescriptor_771033d6361900fa = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xf3, 0xbf, 0xaf, 0x6d, 0xd6, 0x9d, 0x96, 0xee, 0x50, 0xa1, 0x28, 0xb2, 0x38, 0x84,
-	0x15, 0x4a, 0x51, 0x91, 0x38, 0x20, 0x81, 0x70, 0xdb, 0x14, 0x59, 0xb0, 0xa5, 0xcc, 0x16, 0x56,
-	0xec, 0x6d, 0x62, 0x4f, 0x13, 0xab, 0x8e, 0xc7, 0xcc, 0xd8, 0x5b, 0xc2, 0x77, 0x40, 0xe2, 0x63,
-	0x71, 0xe0, 0xb0, 0x47, 0x4e, 0x08, 0xb5, 0x5f, 0x04, 0xcd, 0x8c, 0xe3, 0xb8, 0x49, 0x2a, 0x65,
-	0x4f, 0x7e, 0xff, 0x7e, 0xef, 0xcd, 0xfb, 0x6b, 0xc0, 0xe3, 0x30, 0xe5, 0x49, 0x48, 0x8f, 0x05,
-	0x4b, 0xb8, 0x0c, 0x53, 0x2e, 0x66, 0x83, 0x44, 0xf0, 0x94, 0xa3, 0xe7, 0xb9, 0x66, 0xb0, 0xf4,
-	0x3d, 0x3a, 0x18, 0xf3, 0x31, 0xd7, 0x36, 0xc7, 0x8a, 0x32, 0xe6, 0x47, 0xfb, 0x73, 0x47, 0x77,
-	0x13, 0x1e, 0x4a, 0x23, 0x74, 0xfe, 0x6d, 0x01, 0x90, 0xc2, 0x31, 0xc2, 0xd0, 0xf2, 0x05, 0xa3,
-	0x29, 0x17, 0xd8, 0xea, 0x59, 0xfd, 0x2d, 0x32, 0x67, 0x51, 0x07, 0xaa, 0x61, 0x80, 0xab, 0x3d,
-	0xab, 0x5f, 0x27, 0xd5, 0x30, 0x40, 0x08, 0xea, 0x31, 0x9d, 0x32, 0x5c, 0xd3, 0x66, 0x9a, 0x46,
-	0x5f, 0x43, 0x83, 0xdf, 0xc5, 0x4c, 0xe0, 0x7a, 0xcf, 0xea, 0x6f, 0x9f, 0xf4, 0x07, 0x4f, 0x3c,
-	0x70, 0xb0, 0x88, 0xf8, 0x83, 0xb2, 0x27, 0x06, 0x86, 0x7a, 0xb0, 0x1d, 0x30, 0xe9, 0x8b, 0x30,
-	0x49, 0x43, 0x1e, 0xe3, 0x86, 0x76, 0x5d, 0x16, 0xa1, 0x03, 0x68, 0xdc, 0x70, 0x71, 0x2b, 0x71,
-	0xb3, 0x57, 0xeb, 0xd7, 0x89, 0x61, 0x14, 0x4e, 0x66, 0x23, 0x65, 0x35, 0x62, 0x42, 0xe2, 0x96,
-	0xc1, 0x95, 0x44, 0x3a, 0x2f, 0x3e, 0x9d, 0x86, 0xa9, 0xc4, 0xed, 0x3c, 0x2f, 0xc3, 0x2a, 0x6c,
-	0x28, 0x65, 0xc6, 0xe4, 0x19, 0xcf, 0xe2, 0x14, 0x6f, 0xe9, 0x04, 0xcb, 0x22, 0xd4, 0x05, 0x48,
-	0xb2, 0x28, 0xca, 0x0d, 0x40, 0x1b, 0x94, 0x24, 0xe8, 0x1b, 0x68, 0x46, 0x74, 0xc4, 0x22, 0x89,
-	0xb7, 0x7b, 0xb5, 0x0d, 0xd3, 0xfe, 0x5e, 0x01, 0x48, 0x8e, 0x53, 0x6f, 0x30, 0x94, 0x09, 0xb1,
-	0x63, 0xde, 0x50, 0x12, 0xa1, 0x0b, 0x68, 0x0b, 0x16, 0x31, 0x2a, 0x99, 0xc4, 0xbb, 0x3a, 0xca,
-	0x8b, 0x0d, 0xa2, 0x10, 0x03, 0x21, 0x05, 0x16, 0x7d, 0x04, 0x5b, 0xba, 0xa1, 0x2c, 0x70, 0x53,
-	0xdc, 0xe9, 0x59, 0xfd, 0x1a, 0x59, 0x08, 0x94, 0x36, 0x4b, 0x82, 0x5c, 0xfb, 0xcc, 0x68, 0x0b,
-	0x01, 0x3a, 0x82, 0x76, 0x92, 0xc9, 0x89, 0x56, 0xda, 0x5a, 0x59, 0xf0, 0xaa, 0x46, 0x32, 0xa5,
-	0x62, 0x4c, 0x7f, 0x57, 0x0d, 0xd8, 0xd3, 0xcd, 0x29, 0x49, 0x14, 0x96, 0x0a, 0x7f, 0x12, 0xbe,
-	0x65, 0x01, 0x46, 0x3d, 0xab, 0xdf, 0x26, 0x05, 0xaf, 0x7a, 0x13, 0x85, 0x3e, 0x8b, 0x25, 0xc3,
-	0xfb, 0xa6, 0x37, 0x39, 0x8b, 0x3e, 0x86, 0xdd, 0x80, 0xdd, 0xd0, 0x2c, 0x4a, 0x4f, 0x05, 0x8d,
-	0xfd, 0x09, 0x3e, 0xd0, 0xfa, 0xc7, 0x42, 0x74, 0x08, 0xcd, 0x84, 0x0a, 0x16, 0xa7, 0xf8, 0x03,
-	0x5d, 0xb8, 0x9c, 0x53, 0x13, 0xaa, 0xc6, 0x03, 0x1f, 0xea, 0x78, 0x9a, 0x46, 0x3f, 0xc1, 0xae,
-	0xcf, 0xa3, 0x88, 0x8e, 0xb8, 0x50, 0x53, 0x2d, 0xf1, 0x73, 0x5d, 0xcc, 0xe3, 0x0d, 0x8a, 0x79,
-	0x56, 0xc2, 0x91, 0xc7, 0x5e, 0x90, 0x03, 0x3b, 0x34, 0x8a, 0xf8, 0xdd, 0x05, 0x17, 0xb7, 0x61,
-	0x3c, 0xc6, 0x58, 0x87, 0x7c, 0x24, 0x43, 0x67, 0xd0, 0x1a, 0x51, 0xff, 0x36, 0x4b, 0x24, 0xfe,
-	0x50, 0x07, 0xfd, 0x64, 0x83, 0xa0, 0xa7, 0x1a, 0x41, 0xe6, 0x48, 0xf4, 0x19, 0xec, 0xb3, 0x98,
-	0x8e, 0x22, 0xe6, 0x8a, 0x3b, 0x46, 0xdf, 0x32, 0xa3, 0xc7, 0x47, 0x3a, 0xde, 0x3a, 0x95, 0x73,
-	0x02, 0x3b, 0x0b, 0x77, 0x5e, 0x90, 0xef, 0xb1, 0x59, 0xee, 0xf2, 0x1e, 0x57, 0x17, 0x7b, 0xec,
-	0xfc, 0x08, 0x7b, 0xa7, 0x6a, 0x6e, 0x0a, 0xdc, 0x77, 0x6c, 0x56, 0x02, 0x9a, 0x03, 0x80, 0xa1,
-	0x45, 0x83, 0x40, 0x30, 0x29, 0x73, 0xec, 0x9c, 0x5d, 0x77, 0x1a, 0x9c, 0x5f, 0xe0, 0xd9, 0xd2,
-	0xd2, 0xaf, 0xbc, 0xe4, 0x0b, 0xa8, 0xa7, 0xb3, 0xc4, 0xbc, 0xa4, 0x73, 0xe2, 0x3c, 0x59, 0x1d,
-	0x8d, 0xbe, 0x9e, 0x25, 0x8c, 0x68, 0x7b, 0xe7, 0x53, 0x68, 0x7b, 0x6a, 0x5d, 0xbd, 0x30, 0x40,
-	0x36, 0xd4, 0xc2, 0xe2, 0x95, 0x8a, 0x5c, 0xbe, 0x5b, 0xce, 0x09, 0x74, 0xae, 0xb2, 0x28, 0x22,
-	0xec, 0xd7, 0x8c, 0xc9, 0x74, 0x33, 0xcc, 0xdf, 0x16, 0x1c, 0xae, 0x1f, 0x84, 0x95, 0x24, 0xde,
-	0x00, 0x24, 0x4c, 0x4c, 0x43, 0x29, 0xd5, 0x05, 0x33, 0xa9, 0x7c, 0xf9, 0x9e, 0xd3, 0x35, 0xb8,
-	0x2a, 0x3c, 0x90, 0x92, 0x37, 0xe7, 0x02, 0x60, 0xa1, 0x41, 0x6d, 0xa8, 0x93, 0xa1, 0x7b, 0x6e,
-	0x57, 0x10, 0x40, 0xf3, 0x9a, 0x78, 0xee, 0xb7, 0x43, 0xdb, 0x42, 0x5b, 0xd0, 0x78, 0x4d, 0xbc,
-	0xeb, 0xa1, 0x5d, 0x45, 0x3b, 0xd0, 0x7e, 0xe9, 0x7a, 0x97, 0xd7, 0xae, 0x77, 0x69, 0xd7, 0x94,
-	0xc2, 0x3d, 0x7f, 0xe9, 0x5d, 0xda, 0x75, 0x67, 0x5a, 0xee, 0x85, 0xbe, 0x44, 0x2b, 0xcd, 0x5d,
-	0x33, 0x15, 0xea, 0xf6, 0xfa, 0x3c, 0xe2, 0x22, 0xef, 0xab, 0x61, 0x96, 0x6f, 0x76, 0x7d, 0xe5,
-	0x66, 0x3b, 0x5f, 0xc1, 0xde, 0xca, 0x49, 0x5a, 0x37, 0x4d, 0x29, 0x1d, 0x5f, 0x2e, 0x62, 0xce,
-	0x59, 0xe7, 0x0f, 0x0b, 0xec, 0xe5, 0x85, 0x40, 0x43, 0x68, 0xc8, 0x94, 0x0b, 0xa6, 0x3d, 0x74,
-	0x36, 0xda, 0x5f, 0x83, 0x1c, 0xbc, 0x52, 0x30, 0x62, 0xd0, 0x2a, 0x4d, 0xc1, 0x6e, 0xd4, 0x00,
-	0xd7, 0x54, 0x9a, 0x8a, 0x76, 0xba, 0xd0, 0xd0, 0x36, 0xaa, 0xc0, 0xde, 0xd5, 0xc5, 0x2b, 0xbb,
-	0x82, 0xb6, 0xa1, 0xe5, 0x92, 0xd7, 0x43, 0xf7, 0xe7, 0xa1, 0x6d, 0x9d, 0x9e, 0xff, 0x75, 0xdf,
-	0xb5, 0xde, 0xdd, 0x77, 0xad, 0xff, 0xee, 0xbb, 0xd6, 0x9f, 0x0f, 0xdd, 0xca, 0xbb, 0x87, 0x6e,
-	0xe5, 0x9f, 0x87, 0x6e, 0xe5, 0xcd, 0x8b, 0x71, 0x98, 0x4e, 0xb2, 0xd1, 0xc0, 0xe7, 0xd3, 0xe3,
-	0xf9, 0xbf, 0x76, 0xfe, 0xfd, 0xad, 0xa0, 0xd4, 0xcc, 0xca, 0x51, 0x53, 0xff, 0x7e, 0x3f, 0xff,
-	0x3f, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xe1, 0x9a, 0xa5, 0xde, 0x07, 0x00, 0x00,
+	// 1278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc6, 0x76, 0xe2, 0x9c, 0x24, 0xce, 0x7a, 0x92, 0x26, 0xf3, 0xf7, 0xbf, 0x58, 0xab,
+	0x15, 0xaa, 0x4c, 0x55, 0x9c, 0x2a, 0x48, 0x08, 0x21, 0x40, 0x38, 0xc9, 0xa6, 0x58, 0x90, 0xd4,
+	0x1d, 0x27, 0x94, 0xf6, 0x82, 0x6a, 0xbd, 0x3b, 0xb1, 0x57, 0x59, 0xef, 0x6c, 0x67, 0x76, 0x13,
+	0xc2, 0x13, 0xa0, 0x4a, 0x48, 0xbc, 0x40, 0xaf, 0x90, 0xe0, 0x55, 0xb8, 0xe0, 0xa2, 0xdc, 0x71,
+	0x89, 0x9a, 0x6b, 0xde, 0x01, 0xcd, 0xec, 0xda, 0xf1, 0xc7, 0xa6, 0x4a, 0xa5, 0x5e, 0x79, 0xce,
+	0xc7, 0xef, 0x9c, 0x33, 0xe7, 0x6b, 0xd6, 0x80, 0x7b, 0x5e, 0xc4, 0x42, 0xcf, 0xde, 0xe2, 0x34,
+	0x64, 0xc2, 0x8b, 0x18, 0xbf, 0x68, 0x84, 0x9c, 0x45, 0x0c, 0x6d, 0xa6, 0x92, 0xc6, 0xd4, 0x6f,
+	0x75, 0xbd, 0xc7, 0x7a, 0x4c, 0xe9, 0x6c, 0xc9, 0x53, 0xa2, 0x5e, 0x5d, 0x1b, 0x1a, 0x3a, 0xef,
+	0x33, 0x4f, 0x24, 0x4c, 0xf3, 0x77, 0x00, 0x20, 0x23, 0xc3, 0x08, 0xc3, 0x82, 0xc3, 0xa9, 0x1d,
+	0x31, 0x8e, 0x35, 0x43, 0xab, 0x2f, 0x92, 0x21, 0x89, 0xca, 0x30, 0xe7, 0xb9, 0x78, 0xce, 0xd0,
+	0xea, 0x05, 0x32, 0xe7, 0xb9, 0x08, 0x41, 0x21, 0xb0, 0x07, 0x14, 0xe7, 0x95, 0x9a, 0x3a, 0xa3,
+	0x2f, 0xa0, 0xc8, 0xce, 0x03, 0xca, 0x71, 0xc1, 0xd0, 0xea, 0x4b, 0xdb, 0xf5, 0xc6, 0x35, 0x01,
+	0x36, 0xae, 0x3c, 0x3e, 0x94, 0xfa, 0x24, 0x81, 0x21, 0x03, 0x96, 0x5c, 0x2a, 0x1c, 0xee, 0x85,
+	0x91, 0xc7, 0x02, 0x5c, 0x54, 0xa6, 0xc7, 0x59, 0x68, 0x1d, 0x8a, 0x27, 0x8c, 0x9f, 0x0a, 0x3c,
+	0x6f, 0xe4, 0xeb, 0x05, 0x92, 0x10, 0x12, 0x27, 0xe2, 0xae, 0xd4, 0xea, 0x52, 0x2e, 0xf0, 0x42,
+	0x82, 0x1b, 0x63, 0xa9, 0x7b, 0xb1, 0xc1, 0xc0, 0x8b, 0x04, 0x2e, 0xa5, 0xf7, 0x4a, 0x48, 0x89,
+	0xf5, 0x84, 0x88, 0xa9, 0xd8, 0x65, 0x71, 0x10, 0xe1, 0x45, 0x75, 0xc1, 0x71, 0x16, 0xaa, 0x01,
+	0x84, 0xb1, 0xef, 0xa7, 0x0a, 0xa0, 0x14, 0xc6, 0x38, 0xe8, 0x4b, 0x98, 0xf7, 0xed, 0x2e, 0xf5,
+	0x05, 0x5e, 0x32, 0xf2, 0x37, 0xbc, 0xf6, 0x37, 0x12, 0x40, 0x52, 0x9c, 0x8c, 0x21, 0x39, 0x25,
+	0x2e, 0x96, 0x93, 0x18, 0xc6, 0x58, 0x68, 0x1f, 0x4a, 0x9c, 0xfa, 0xd4, 0x16, 0x54, 0xe0, 0x15,
+	0xe5, 0xe5, 0xee, 0x0d, 0xbc, 0x90, 0x04, 0x42, 0x46, 0x58, 0x74, 0x1b, 0x16, 0x55, 0x41, 0xa9,
+	0xdb, 0x8c, 0x70, 0xd9, 0xd0, 0xea, 0x79, 0x72, 0xc5, 0x90, 0xd2, 0x38, 0x74, 0x53, 0xe9, 0x6a,
+	0x22, 0x1d, 0x31, 0x50, 0x15, 0x4a, 0x61, 0x2c, 0xfa, 0x4a, 0xa8, 0x2b, 0xe1, 0x88, 0x96, 0x39,
+	0x12, 0x91, 0xcd, 0x7b, 0xf6, 0x8f, 0xb2, 0x00, 0x15, 0x55, 0x9c, 0x31, 0x8e, 0xc4, 0xda, 0xdc,
+	0xe9, 0x7b, 0x67, 0xd4, 0xc5, 0xc8, 0xd0, 0xea, 0x25, 0x32, 0xa2, 0x65, 0x6d, 0x7c, 0xcf, 0xa1,
+	0x81, 0xa0, 0x78, 0x2d, 0xa9, 0x4d, 0x4a, 0xa2, 0xf7, 0x61, 0xc5, 0xa5, 0x27, 0x76, 0xec, 0x47,
+	0x3b, 0xdc, 0x0e, 0x9c, 0x3e, 0x5e, 0x57, 0xf2, 0x49, 0x26, 0xda, 0x80, 0xf9, 0xd0, 0xe6, 0x34,
+	0x88, 0xf0, 0x2d, 0x95, 0xb8, 0x94, 0x92, 0x1d, 0x2a, 0xdb, 0x03, 0x6f, 0x28, 0x7f, 0xea, 0x8c,
+	0x8e, 0x61, 0xc5, 0x61, 0xbe, 0x6f, 0x77, 0x19, 0x97, 0x5d, 0x2d, 0xf0, 0xa6, 0x4a, 0xe6, 0xd6,
+	0x0d, 0x92, 0xb9, 0x3b, 0x86, 0x23, 0x93, 0x56, 0x90, 0x09, 0xcb, 0xb6, 0xef, 0xb3, 0xf3, 0x7d,
+	0xc6, 0x4f, 0xbd, 0xa0, 0x87, 0xb1, 0x72, 0x39, 0xc1, 0x43, 0xbb, 0xb0, 0xd0, 0xb5, 0x9d, 0xd3,
+	0x38, 0x14, 0xf8, 0x7f, 0xca, 0xe9, 0x07, 0x37, 0x70, 0xba, 0xa3, 0x10, 0x64, 0x88, 0x44, 0xf7,
+	0x61, 0x8d, 0x06, 0x76, 0xd7, 0xa7, 0x4d, 0x7e, 0x4e, 0xed, 0x33, 0x9a, 0xc8, 0x71, 0x55, 0xf9,
+	0xcb, 0x12, 0x21, 0x07, 0x6e, 0x75, 0x55, 0x9e, 0xda, 0x9c, 0x45, 0xd4, 0x91, 0x53, 0x44, 0x62,
+	0x9f, 0x0a, 0xfc, 0x7f, 0x15, 0xc4, 0x87, 0xd7, 0x06, 0xb1, 0x93, 0x81, 0x22, 0xd9, 0xb6, 0xd0,
+	0x77, 0x50, 0x19, 0x50, 0xde, 0xa3, 0x84, 0x3e, 0x8f, 0x3d, 0x4e, 0x07, 0x34, 0x88, 0x04, 0xbe,
+	0x6d, 0x68, 0x6f, 0xec, 0xd3, 0x83, 0x69, 0x04, 0x99, 0x35, 0x82, 0xbe, 0x87, 0x0d, 0x95, 0x45,
+	0xea, 0x2a, 0xf5, 0x4e, 0xc4, 0xed, 0x88, 0xf6, 0x3c, 0x2a, 0xf0, 0x7b, 0x46, 0xbe, 0x5e, 0xde,
+	0xbe, 0xf3, 0x66, 0xf3, 0xa9, 0xfe, 0x05, 0xb9, 0xc6, 0x0a, 0xaa, 0xc3, 0xea, 0xc0, 0xf3, 0xa9,
+	0x88, 0x58, 0x30, 0x5c, 0x01, 0x35, 0xd5, 0x45, 0xd3, 0x6c, 0x73, 0x1b, 0x96, 0xaf, 0xea, 0xd2,
+	0x72, 0xd3, 0x85, 0x98, 0x6c, 0xc9, 0xf1, 0x85, 0x38, 0x77, 0xb5, 0x10, 0xcd, 0x47, 0x50, 0xd9,
+	0x91, 0x03, 0x38, 0xc2, 0x7d, 0x4d, 0x2f, 0xc6, 0x80, 0xc9, 0x26, 0xc5, 0xb0, 0x60, 0xbb, 0x2e,
+	0xa7, 0x42, 0xa4, 0xd8, 0x21, 0x99, 0xb5, 0x63, 0xcd, 0x27, 0xb0, 0x3a, 0xb5, 0x3d, 0x67, 0x22,
+	0xf9, 0x18, 0x0a, 0xd1, 0x45, 0x98, 0x44, 0x52, 0xde, 0x36, 0xaf, 0xcd, 0x90, 0x42, 0x1f, 0x5d,
+	0x84, 0x94, 0x28, 0x7d, 0xf3, 0x1e, 0x94, 0x5a, 0x72, 0xef, 0xb5, 0x3c, 0x17, 0xe9, 0x90, 0xf7,
+	0x46, 0x51, 0xca, 0xe3, 0xf4, 0x03, 0x60, 0x6e, 0x43, 0xb9, 0x1d, 0xfb, 0xbe, 0xac, 0x16, 0x15,
+	0xd1, 0xcd, 0x30, 0x7f, 0x6a, 0xb0, 0x91, 0x3d, 0x51, 0x33, 0x97, 0x78, 0x0a, 0x10, 0x52, 0x3e,
+	0xf0, 0x84, 0x90, 0x4f, 0x41, 0x72, 0x95, 0x4f, 0xdf, 0x72, 0x4c, 0x1b, 0xed, 0x91, 0x05, 0x32,
+	0x66, 0xcd, 0xdc, 0x07, 0xb8, 0x92, 0xa0, 0x12, 0x14, 0x88, 0xd5, 0xdc, 0xd3, 0x73, 0x08, 0x60,
+	0xfe, 0x88, 0xb4, 0x9a, 0x0f, 0x2c, 0x5d, 0x43, 0x8b, 0x50, 0x7c, 0x4c, 0x5a, 0x47, 0x96, 0x3e,
+	0x87, 0x96, 0xa1, 0x74, 0xd0, 0x6c, 0x1d, 0x1e, 0x35, 0x5b, 0x87, 0x7a, 0x5e, 0x0a, 0x9a, 0x7b,
+	0x07, 0xad, 0x43, 0xbd, 0x60, 0xfe, 0x96, 0x87, 0xf5, 0xac, 0x31, 0x91, 0x25, 0x0d, 0xed, 0x28,
+	0xa2, 0x3c, 0x18, 0x3e, 0xa3, 0x29, 0x89, 0xfa, 0x50, 0x19, 0x78, 0x41, 0x3b, 0x16, 0xfd, 0xf6,
+	0xbb, 0xbc, 0xdd, 0xac, 0x51, 0xd4, 0x00, 0xc4, 0x93, 0x49, 0x1a, 0x2b, 0x93, 0x6a, 0xa5, 0x12,
+	0xc9, 0x90, 0xc8, 0x65, 0xab, 0x66, 0x64, 0x8f, 0xfa, 0x54, 0x3d, 0xbf, 0x05, 0xa5, 0x3a, 0xc9,
+	0xcc, 0x9e, 0xf4, 0xe2, 0xbb, 0x98, 0xf4, 0xa7, 0xb0, 0x9e, 0xee, 0xf5, 0x89, 0xc9, 0xc5, 0xf3,
+	0x86, 0xf6, 0x16, 0x73, 0x9e, 0x69, 0xc3, 0xfc, 0x4b, 0x83, 0xca, 0x4c, 0x10, 0xe8, 0x1e, 0x54,
+	0xd2, 0x3c, 0xb8, 0xcd, 0x30, 0xe4, 0xec, 0xcc, 0xf6, 0x45, 0xda, 0xbd, 0xb3, 0x02, 0xf4, 0x09,
+	0x6c, 0x76, 0x7d, 0xe6, 0x9c, 0x3e, 0x0c, 0x76, 0xfb, 0x76, 0xd0, 0xa3, 0x22, 0x4d, 0x1c, 0x4d,
+	0x1a, 0xbc, 0x44, 0xae, 0x13, 0x8f, 0xfb, 0x21, 0xf4, 0xcc, 0xa3, 0xe7, 0xf2, 0x8d, 0xcc, 0x1b,
+	0xf9, 0xfa, 0x22, 0x99, 0x15, 0xa0, 0x3b, 0x50, 0x1e, 0x32, 0x77, 0xfb, 0xd4, 0x39, 0x15, 0xb8,
+	0xa0, 0x54, 0xa7, 0xb8, 0xe6, 0x00, 0x56, 0xa7, 0xbe, 0x27, 0x66, 0x36, 0x4b, 0xc6, 0x4a, 0x92,
+	0x5f, 0x50, 0x0e, 0xf3, 0x19, 0x4f, 0x97, 0x4a, 0x42, 0x4c, 0x7f, 0x79, 0x15, 0x66, 0xbe, 0xbc,
+	0xcc, 0xcf, 0xa1, 0x32, 0xf3, 0x61, 0x91, 0xb5, 0xca, 0x22, 0xbb, 0x77, 0x78, 0xe5, 0x73, 0x48,
+	0x9a, 0x3f, 0x6b, 0xa0, 0x4f, 0x3f, 0x6b, 0xc8, 0x82, 0xa2, 0x88, 0x18, 0xa7, 0xca, 0x42, 0xf9,
+	0x46, 0xaf, 0x70, 0x82, 0x6c, 0x74, 0x24, 0x8c, 0x24, 0x68, 0x79, 0x4d, 0x4e, 0x4f, 0xe4, 0xf6,
+	0x94, 0x79, 0x52, 0x67, 0xb3, 0x06, 0x45, 0xa5, 0x23, 0xa7, 0xbb, 0xd5, 0xde, 0xef, 0xe8, 0x39,
+	0xb4, 0x04, 0x0b, 0x4d, 0xf2, 0xd8, 0x6a, 0x7e, 0x6b, 0xe9, 0xda, 0xdd, 0x7f, 0x35, 0x58, 0x99,
+	0xe8, 0x11, 0xf4, 0x19, 0x54, 0x0f, 0x2c, 0xf2, 0xc0, 0x7a, 0xd6, 0x39, 0x22, 0xcd, 0x23, 0xeb,
+	0xc1, 0x93, 0x67, 0xc7, 0x87, 0x9d, 0xb6, 0xb5, 0xdb, 0xda, 0x6f, 0x59, 0x7b, 0x7a, 0xae, 0x7a,
+	0xfb, 0xc5, 0x4b, 0x03, 0x4f, 0x40, 0x8e, 0x03, 0x11, 0x52, 0xc7, 0x3b, 0xf1, 0xa8, 0x8b, 0xee,
+	0xc3, 0xfa, 0x14, 0x5a, 0x91, 0xba, 0x56, 0xdd, 0x78, 0xf1, 0xd2, 0x40, 0x13, 0x38, 0x45, 0xa0,
+	0x6d, 0xb8, 0x35, 0x85, 0xe8, 0x3c, 0x3a, 0x6e, 0x76, 0xbe, 0xd2, 0xe7, 0xaa, 0x9b, 0x2f, 0x5e,
+	0x1a, 0x6b, 0x13, 0x90, 0xce, 0xf3, 0xd8, 0x16, 0xfd, 0x0c, 0x0c, 0xb1, 0x76, 0x9a, 0x1d, 0x4b,
+	0xcf, 0x67, 0x60, 0x08, 0xed, 0xda, 0x82, 0x56, 0x0b, 0x3f, 0xfd, 0x5a, 0xcb, 0xed, 0xec, 0xfd,
+	0xf1, 0xba, 0xa6, 0xbd, 0x7a, 0x5d, 0xd3, 0xfe, 0x79, 0x5d, 0xd3, 0x7e, 0xb9, 0xac, 0xe5, 0x5e,
+	0x5d, 0xd6, 0x72, 0x7f, 0x5f, 0xd6, 0x72, 0x4f, 0xef, 0xf6, 0xbc, 0xa8, 0x1f, 0x77, 0x1b, 0x0e,
+	0x1b, 0x6c, 0x0d, 0xff, 0x21, 0x0c, 0x7f, 0x7f, 0x18, 0x9d, 0xe4, 0x03, 0x21, 0xba, 0xf3, 0xea,
+	0x4f, 0xc3, 0x47, 0xff, 0x0d, 0x00, 0xac, 0xa9, 0x86, 0x29, 0x94, 0x0c, 0x00, 0x00,
 }
 
 func (m *Repository) Marshal() (dAtA []byte, err error) {
@@ -914,6 +1157,63 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MilestonesCount != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MilestonesCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if len(m.AllowedMergeStrategies) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedMergeStrategies)*10)
+		var j1 int
+		for _, num := range m.AllowedMergeStrategies {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintRepository(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.MergeRequirements != nil {
+		{
+			size, err := m.MergeRequirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.BranchProtectionRules) > 0 {
+		for iNdEx := len(m.BranchProtectionRules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BranchProtectionRules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if m.EnableArweaveBackup {
 		i--
 		if m.EnableArweaveBackup {
@@ -1020,20 +1320,20 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x90
 	}
 	if len(m.Stargazers) > 0 {
-		dAtA2 := make([]byte, len(m.Stargazers)*10)
-		var j1 int
+		dAtA5 := make([]byte, len(m.Stargazers)*10)
+		var j4 int
 		for _, num := range m.Stargazers {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j4++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintRepository(dAtA, i, uint64(j1))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintRepository(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -1114,20 +1414,20 @@ func (m *Repository) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 	}
 	if len(m.Forks) > 0 {
-		dAtA4 := make([]byte, len(m.Forks)*10)
-		var j3 int
+		dAtA7 := make([]byte, len(m.Forks)*10)
+		var j6 int
 		for _, num := range m.Forks {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j6++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA7[j6] = uint8(num)
+			j6++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintRepository(dAtA, i, uint64(j3))
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRepository(dAtA, i, uint64(j6))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -1387,7 +1687,7 @@ func (m *RepositoryCollaborator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryLabel) Marshal() (dAtA []byte, err error) {
+func (m *BranchProtectionRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1397,46 +1697,69 @@ func (m *RepositoryLabel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryLabel) MarshalTo(dAtA []byte) (int, error) {
+func (m *BranchProtectionRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BranchProtectionRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Description)))
+	if m.DefaultMergeStrategy != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.DefaultMergeStrategy))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x30
 	}
-	if len(m.Color) > 0 {
-		i -= len(m.Color)
-		copy(dAtA[i:], m.Color)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Color)))
+	if m.MergeRequirements != nil {
+		{
+			size, err := m.MergeRequirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+	if m.AllowDeletion {
 		i--
-		dAtA[i] = 0x12
+		if m.AllowDeletion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Id != 0 {
-		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+	if m.RequirePullRequest {
 		i--
-		dAtA[i] = 0x8
+		if m.RequirePullRequest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinPushPermission != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MinPushPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
+func (m *MergeRequirements) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1446,32 +1769,53 @@ func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryRelease) MarshalTo(dAtA []byte) (int, error) {
+func (m *MergeRequirements) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MergeRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TagName) > 0 {
-		i -= len(m.TagName)
-		copy(dAtA[i:], m.TagName)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.TagName)))
+	if len(m.RequiredChecks) > 0 {
+		for iNdEx := len(m.RequiredChecks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredChecks[iNdEx])
+			copy(dAtA[i:], m.RequiredChecks[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.RequiredChecks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RequiredReviewers) > 0 {
+		for iNdEx := len(m.RequiredReviewers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredReviewers[iNdEx])
+			copy(dAtA[i:], m.RequiredReviewers[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.RequiredReviewers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BlockOnChangesRequested {
 		i--
-		dAtA[i] = 0x12
+		if m.BlockOnChangesRequested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Id != 0 {
-		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+	if m.RequiredApprovals != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.RequiredApprovals))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
+func (m *RepositoryLabel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1481,18 +1825,102 @@ func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepositoryBackup) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepositoryLabel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepositoryBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepositoryLabel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Refs) > 0 {
-		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Color) > 0 {
+		i -= len(m.Color)
+		copy(dAtA[i:], m.Color)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Color)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryRelease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TagName) > 0 {
+		i -= len(m.TagName)
+		copy(dAtA[i:], m.TagName)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TagName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refs) > 0 {
+		for iNdEx := len(m.Refs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Refs[iNdEx])
 			copy(dAtA[i:], m.Refs[iNdEx])
 			i = encodeVarintRepository(dAtA, i, uint64(len(m.Refs[iNdEx])))
@@ -1631,6 +2059,26 @@ func (m *Repository) Size() (n int) {
 	if m.EnableArweaveBackup {
 		n += 3
 	}
+	if len(m.BranchProtectionRules) > 0 {
+		for _, e := range m.BranchProtectionRules {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.MergeRequirements != nil {
+		l = m.MergeRequirements.Size()
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.AllowedMergeStrategies) > 0 {
+		l = 0
+		for _, e := range m.AllowedMergeStrategies {
+			l += sovRepository(uint64(e))
+		}
+		n += 2 + sovRepository(uint64(l)) + l
+	}
+	if m.MilestonesCount != 0 {
+		n += 2 + sovRepository(uint64(m.MilestonesCount))
+	}
 	return n
 }
 
@@ -1733,6 +2181,62 @@ func (m *RepositoryCollaborator) Size() (n int) {
 	return n
 }
 
+func (m *BranchProtectionRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.MinPushPermission != 0 {
+		n += 1 + sovRepository(uint64(m.MinPushPermission))
+	}
+	if m.RequirePullRequest {
+		n += 2
+	}
+	if m.AllowDeletion {
+		n += 2
+	}
+	if m.MergeRequirements != nil {
+		l = m.MergeRequirements.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.DefaultMergeStrategy != 0 {
+		n += 1 + sovRepository(uint64(m.DefaultMergeStrategy))
+	}
+	return n
+}
+
+func (m *MergeRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredApprovals != 0 {
+		n += 1 + sovRepository(uint64(m.RequiredApprovals))
+	}
+	if m.BlockOnChangesRequested {
+		n += 2
+	}
+	if len(m.RequiredReviewers) > 0 {
+		for _, s := range m.RequiredReviewers {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if len(m.RequiredChecks) > 0 {
+		for _, s := range m.RequiredChecks {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RepositoryLabel) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2606,61 +3110,11 @@ func (m *Repository) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableArweaveBackup = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepositoryId) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepositoryId: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepositoryId: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProtectionRules", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2670,29 +3124,31 @@ func (m *RepositoryId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.BranchProtectionRules = append(m.BranchProtectionRules, &BranchProtectionRule{})
+			if err := m.BranchProtectionRules[len(m.BranchProtectionRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequirements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -2702,71 +3158,277 @@ func (m *RepositoryId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MergeRequirements == nil {
+				m.MergeRequirements = &MergeRequirements{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MergeRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BaseRepositoryKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
+			iNdEx = postIndex
+		case 29:
+			if wireType == 0 {
+				var v MergeStrategy
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= MergeStrategy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedMergeStrategies = append(m.AllowedMergeStrategies, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRepository
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRepository
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedMergeStrategies) == 0 {
+					m.AllowedMergeStrategies = make([]MergeStrategy, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v MergeStrategy
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= MergeStrategy(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedMergeStrategies = append(m.AllowedMergeStrategies, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMergeStrategies", wireType)
+			}
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestonesCount", wireType)
+			}
+			m.MilestonesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestonesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepositoryId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepositoryId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepositoryId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseRepositoryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseRepositoryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
 			return fmt.Errorf("proto: BaseRepositoryKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
@@ -3252,6 +3914,355 @@ func (m *RepositoryCollaborator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BranchProtectionRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BranchProtectionRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BranchProtectionRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPushPermission", wireType)
+			}
+			m.MinPushPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPushPermission |= RepositoryCollaborator_Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequirePullRequest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequirePullRequest = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowDeletion = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeRequirements == nil {
+				m.MergeRequirements = &MergeRequirements{}
+			}
+			if err := m.MergeRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultMergeStrategy", wireType)
+			}
+			m.DefaultMergeStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultMergeStrategy |= MergeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOnChangesRequested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockOnChangesRequested = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredReviewers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredReviewers = append(m.RequiredReviewers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredChecks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredChecks = append(m.RequiredChecks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepositoryLabel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -77,12 +77,13 @@ func (TaskState) EnumDescriptor() ([]byte, []int) {
 }
 
 type Task struct {
-	Id       uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type     TaskType  `protobuf:"varint,2,opt,name=type,proto3,enum=gitopia.gitopia.gitopia.TaskType" json:"type,omitempty"`
-	State    TaskState `protobuf:"varint,3,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.TaskState" json:"state,omitempty"`
-	Message  string    `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Creator  string    `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
-	Provider string    `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	Id           uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type         TaskType      `protobuf:"varint,2,opt,name=type,proto3,enum=gitopia.gitopia.gitopia.TaskType" json:"type,omitempty"`
+	State        TaskState     `protobuf:"varint,3,opt,name=state,proto3,enum=gitopia.gitopia.gitopia.TaskState" json:"state,omitempty"`
+	Message      string        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Creator      string        `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Provider     string        `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	MergeOptions *MergeOptions `protobuf:"bytes,7,opt,name=mergeOptions,proto3" json:"mergeOptions,omitempty"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -160,44 +161,118 @@ func (m *Task) GetProvider() string {
 	return ""
 }
 
+func (m *Task) GetMergeOptions() *MergeOptions {
+	if m != nil {
+		return m.MergeOptions
+	}
+	return nil
+}
+
+type MergeOptions struct {
+	MergeStrategy MergeStrategy `protobuf:"varint,1,opt,name=mergeStrategy,proto3,enum=gitopia.gitopia.gitopia.MergeStrategy" json:"mergeStrategy,omitempty"`
+	CommitTitle   string        `protobuf:"bytes,2,opt,name=commitTitle,proto3" json:"commitTitle,omitempty"`
+	CommitMessage string        `protobuf:"bytes,3,opt,name=commitMessage,proto3" json:"commitMessage,omitempty"`
+}
+
+func (m *MergeOptions) Reset()         { *m = MergeOptions{} }
+func (m *MergeOptions) String() string { return proto.CompactTextString(m) }
+func (*MergeOptions) ProtoMessage()    {}
+func (*MergeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6920678987ef43f, []int{1}
+}
+func (m *MergeOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeOptions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MergeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeOptions.Merge(m, src)
+}
+func (m *MergeOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeOptions proto.InternalMessageInfo
+
+func (m *MergeOptions) GetMergeStrategy() MergeStrategy {
+	if m != nil {
+		return m.MergeStrategy
+	}
+	return MergeStrategyUnspecified
+}
+
+func (m *MergeOptions) GetCommitTitle() string {
+	if m != nil {
+		return m.CommitTitle
+	}
+	return ""
+}
+
+func (m *MergeOptions) GetCommitMessage() string {
+	if m != nil {
+		return m.CommitMessage
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("gitopia.gitopia.gitopia.TaskType", TaskType_name, TaskType_value)
 	proto.RegisterEnum("gitopia.gitopia.gitopia.TaskState", TaskState_name, TaskState_value)
 	proto.RegisterType((*Task)(nil), "gitopia.gitopia.gitopia.Task")
+	proto.RegisterType((*MergeOptions)(nil), "gitopia.gitopia.gitopia.MergeOptions")
 }
 
 func init() { proto.RegisterFile("gitopia/task.proto", fileDescriptor_a6920678987ef43f) }
 
 var fileDescriptor_a6920678987ef43f = []byte{
-	// 438 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe3, 0x92, 0x8d, 0xcd, 0x42, 0x53, 0x64, 0x21, 0x16, 0x82, 0x14, 0x85, 0x9d, 0xaa,
-	0x1e, 0x52, 0x09, 0x34, 0x89, 0x6b, 0xd9, 0x5c, 0x54, 0xad, 0x5a, 0x83, 0xed, 0x1e, 0xc6, 0x25,
-	0xca, 0x5a, 0x2b, 0x58, 0xed, 0xe6, 0x60, 0x3b, 0x88, 0x7e, 0x03, 0xd4, 0x0b, 0x7c, 0x81, 0x9e,
-	0xf8, 0x32, 0x1c, 0x77, 0x83, 0x23, 0x6a, 0xbf, 0x08, 0x8a, 0xdb, 0x94, 0xa9, 0x12, 0x3b, 0xbd,
-	0xf7, 0xfc, 0x7e, 0xff, 0xbf, 0xde, 0x93, 0x1f, 0x44, 0xb9, 0x30, 0xb2, 0x10, 0x59, 0xdb, 0x64,
-	0x7a, 0x12, 0x17, 0x4a, 0x1a, 0x89, 0x8e, 0x37, 0x6f, 0xf1, 0x4e, 0x0c, 0x9e, 0xe6, 0x32, 0x97,
-	0x96, 0x69, 0x57, 0xd9, 0x1a, 0x3f, 0xf9, 0x05, 0xa0, 0xcb, 0x32, 0x3d, 0x41, 0x47, 0xb0, 0x21,
-	0xc6, 0x3e, 0x88, 0x40, 0xd3, 0x25, 0x0d, 0x31, 0x46, 0xa7, 0xd0, 0x35, 0xb3, 0x82, 0xfb, 0x8d,
-	0x08, 0x34, 0x8f, 0x5e, 0xbd, 0x8c, 0xff, 0x63, 0x1b, 0x57, 0x62, 0x36, 0x2b, 0x38, 0xb1, 0x38,
-	0x7a, 0x03, 0xf7, 0xb4, 0xc9, 0x0c, 0xf7, 0x1f, 0x59, 0xdd, 0xc9, 0x83, 0x3a, 0x5a, 0x91, 0x64,
-	0x2d, 0x40, 0x3e, 0x7c, 0x7c, 0xc3, 0xb5, 0xce, 0x72, 0xee, 0xbb, 0x11, 0x68, 0x1e, 0x92, 0xba,
-	0xac, 0x3a, 0x23, 0xc5, 0x33, 0x23, 0x95, 0xbf, 0xb7, 0xee, 0x6c, 0x4a, 0x14, 0xc0, 0x83, 0x42,
-	0xc9, 0xcf, 0x62, 0xcc, 0x95, 0xbf, 0x6f, 0x5b, 0xdb, 0xba, 0x35, 0x07, 0xf0, 0xa0, 0x1e, 0x0e,
-	0x9d, 0xc2, 0xe7, 0xac, 0x43, 0x2f, 0x52, 0x76, 0x95, 0xe0, 0xb4, 0x3b, 0x20, 0x17, 0x29, 0xc1,
-	0xc9, 0x80, 0xf6, 0xd8, 0x80, 0x5c, 0x79, 0x4e, 0xf0, 0x6c, 0xbe, 0x88, 0x50, 0x05, 0x76, 0xa5,
-	0x9a, 0x10, 0x5e, 0x48, 0x2d, 0x8c, 0x54, 0x33, 0xd4, 0x81, 0xd1, 0x3f, 0x19, 0xc5, 0x2c, 0x4d,
-	0x86, 0xfd, 0x7e, 0x4a, 0xf0, 0xfb, 0x21, 0xa6, 0x2c, 0xa5, 0xac, 0xc3, 0xb0, 0x07, 0x82, 0x17,
-	0xf3, 0x45, 0x74, 0x5c, 0xa9, 0x29, 0x37, 0x49, 0x39, 0x9d, 0x12, 0xfe, 0xa9, 0xe4, 0xda, 0xd8,
-	0xed, 0x02, 0xf7, 0xeb, 0x8f, 0xd0, 0x69, 0x7d, 0x03, 0xf0, 0x70, 0xbb, 0x31, 0x6a, 0x42, 0x64,
-	0x6d, 0xad, 0x41, 0x9a, 0xe0, 0xcb, 0xf3, 0xde, 0xe5, 0x3b, 0xcf, 0x09, 0xbc, 0xf9, 0x22, 0x7a,
-	0x62, 0x91, 0x84, 0xdf, 0x8e, 0xc5, 0x6d, 0xbe, 0x43, 0xd2, 0xe1, 0xd9, 0x19, 0xa6, 0xd4, 0x03,
-	0xf7, 0x48, 0x5a, 0x8e, 0x46, 0x5c, 0xeb, 0x1d, 0xb2, 0xdb, 0xe9, 0xf5, 0x87, 0x04, 0x7b, 0x8d,
-	0x7b, 0x64, 0x37, 0x13, 0xd3, 0x52, 0x6d, 0x26, 0x7a, 0x7b, 0xfe, 0x73, 0x19, 0x82, 0xbb, 0x65,
-	0x08, 0xfe, 0x2c, 0x43, 0xf0, 0x7d, 0x15, 0x3a, 0x77, 0xab, 0xd0, 0xf9, 0xbd, 0x0a, 0x9d, 0x0f,
-	0xad, 0x5c, 0x98, 0x8f, 0xe5, 0x75, 0x3c, 0x92, 0x37, 0xed, 0xfa, 0xc0, 0xea, 0xf8, 0x65, 0x9b,
-	0x55, 0xbf, 0xad, 0xaf, 0xf7, 0xed, 0x15, 0xbd, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x69, 0xfa,
-	0x3f, 0x24, 0x8a, 0x02, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x4f, 0x6f, 0xda, 0x3e,
+	0x18, 0xc7, 0x63, 0x4a, 0xff, 0xb9, 0x14, 0x45, 0xd6, 0x4f, 0xbf, 0x66, 0x99, 0x14, 0x65, 0x68,
+	0x9b, 0x10, 0x07, 0x90, 0x98, 0x2a, 0xed, 0xca, 0xda, 0x30, 0xa1, 0xd2, 0x92, 0xd9, 0xe1, 0xd0,
+	0x5d, 0xa2, 0x14, 0xac, 0xcc, 0xe2, 0x8f, 0xb3, 0xd8, 0x4c, 0xe3, 0x1d, 0x4c, 0x5c, 0xb6, 0x37,
+	0xc0, 0x65, 0xdb, 0x8b, 0xd9, 0xb1, 0xc7, 0x1d, 0x27, 0x78, 0x23, 0x53, 0x1c, 0xc2, 0x00, 0xa9,
+	0x3d, 0xd9, 0xcf, 0xf3, 0xfd, 0x7c, 0x1f, 0x5b, 0xcf, 0x63, 0x43, 0x14, 0x32, 0xc9, 0x23, 0x16,
+	0xd4, 0x64, 0x20, 0x06, 0xd5, 0x28, 0xe6, 0x92, 0xa3, 0xb3, 0x55, 0xae, 0xba, 0xb3, 0x9a, 0xff,
+	0x85, 0x3c, 0xe4, 0x8a, 0xa9, 0x25, 0xbb, 0x14, 0x37, 0x8d, 0xac, 0x44, 0x4c, 0x23, 0x2e, 0x98,
+	0xe4, 0xf1, 0x34, 0x55, 0x4a, 0xdf, 0x73, 0x30, 0xef, 0x05, 0x62, 0x80, 0x8a, 0x30, 0xc7, 0xfa,
+	0x06, 0xb0, 0x41, 0x39, 0x8f, 0x73, 0xac, 0x8f, 0xce, 0x61, 0x5e, 0x4e, 0x23, 0x6a, 0xe4, 0x6c,
+	0x50, 0x2e, 0xd6, 0x9f, 0x55, 0x1f, 0x38, 0xb0, 0x9a, 0x98, 0xbd, 0x69, 0x44, 0xb1, 0xc2, 0xd1,
+	0x6b, 0xb8, 0x2f, 0x64, 0x20, 0xa9, 0xb1, 0xa7, 0x7c, 0xa5, 0x47, 0x7d, 0x24, 0x21, 0x71, 0x6a,
+	0x40, 0x06, 0x3c, 0x1c, 0x51, 0x21, 0x82, 0x90, 0x1a, 0x79, 0x1b, 0x94, 0x8f, 0x71, 0x16, 0x26,
+	0x4a, 0x2f, 0xa6, 0x81, 0xe4, 0xb1, 0xb1, 0x9f, 0x2a, 0xab, 0x10, 0x99, 0xf0, 0x28, 0x8a, 0xf9,
+	0x27, 0xd6, 0xa7, 0xb1, 0x71, 0xa0, 0xa4, 0x75, 0x8c, 0x5a, 0xb0, 0x30, 0xa2, 0x71, 0x48, 0x3b,
+	0x91, 0x64, 0x7c, 0x2c, 0x8c, 0x43, 0x1b, 0x94, 0x4f, 0xea, 0x2f, 0x1e, 0xbc, 0xd0, 0xf5, 0x06,
+	0x8c, 0xb7, 0xac, 0xa5, 0x9f, 0x00, 0x16, 0x36, 0x65, 0xd4, 0x86, 0xa7, 0x0a, 0x20, 0x32, 0x0e,
+	0x24, 0x0d, 0xa7, 0xaa, 0x6f, 0xc5, 0xfa, 0xcb, 0xc7, 0x8b, 0x67, 0x34, 0xde, 0x36, 0x23, 0x1b,
+	0x9e, 0xf4, 0xf8, 0x68, 0xc4, 0xa4, 0xc7, 0xe4, 0x30, 0xed, 0xf8, 0x31, 0xde, 0x4c, 0xa1, 0xe7,
+	0xf0, 0x34, 0x0d, 0xaf, 0x57, 0x1d, 0xda, 0x53, 0xcc, 0x76, 0xb2, 0x32, 0x03, 0xf0, 0x28, 0x1b,
+	0x07, 0x3a, 0x87, 0x4f, 0xbc, 0x06, 0xb9, 0xf2, 0xbd, 0x5b, 0xd7, 0xf1, 0x9b, 0x1d, 0x7c, 0xe5,
+	0x63, 0xc7, 0xed, 0x90, 0x96, 0xd7, 0xc1, 0xb7, 0xba, 0x66, 0xfe, 0x3f, 0x9b, 0xdb, 0x28, 0x01,
+	0x9b, 0x3c, 0x1e, 0xe0, 0xf5, 0xab, 0x40, 0x0d, 0x68, 0xff, 0xb3, 0x11, 0xc7, 0xf3, 0xdd, 0x6e,
+	0xbb, 0xed, 0x63, 0xe7, 0x5d, 0xd7, 0x21, 0x9e, 0x4f, 0xbc, 0x86, 0xe7, 0xe8, 0xc0, 0x7c, 0x3a,
+	0x9b, 0xdb, 0x67, 0x89, 0x9b, 0x50, 0xe9, 0x4e, 0x86, 0x43, 0x4c, 0x3f, 0x4e, 0xa8, 0x90, 0x6a,
+	0x9e, 0x66, 0xfe, 0xcb, 0x0f, 0x4b, 0xab, 0x7c, 0x05, 0xf0, 0x78, 0x3d, 0x63, 0x54, 0x86, 0x48,
+	0x95, 0x55, 0x05, 0x7c, 0xd7, 0xb9, 0xb9, 0x6c, 0xdd, 0xbc, 0xd5, 0x35, 0x53, 0x9f, 0xcd, 0xed,
+	0x82, 0x42, 0x5c, 0x3a, 0xee, 0xb3, 0x71, 0xb8, 0x43, 0x92, 0xee, 0xc5, 0x85, 0x43, 0x88, 0x0e,
+	0x36, 0x48, 0x32, 0xe9, 0xf5, 0xa8, 0x10, 0x3b, 0x64, 0xb3, 0xd1, 0x6a, 0x77, 0xb1, 0xa3, 0xe7,
+	0x36, 0xc8, 0x66, 0xc0, 0x86, 0x93, 0x78, 0x75, 0xa3, 0x37, 0x97, 0xbf, 0x16, 0x16, 0xb8, 0x5f,
+	0x58, 0xe0, 0xcf, 0xc2, 0x02, 0xdf, 0x96, 0x96, 0x76, 0xbf, 0xb4, 0xb4, 0xdf, 0x4b, 0x4b, 0x7b,
+	0x5f, 0x09, 0x99, 0xfc, 0x30, 0xb9, 0xab, 0xf6, 0xf8, 0xa8, 0x96, 0xfd, 0x94, 0x6c, 0xfd, 0xbc,
+	0xde, 0x25, 0xef, 0x5b, 0xdc, 0x1d, 0xa8, 0x7f, 0xf3, 0xea, 0xef, 0x00, 0x9f, 0xcf, 0xf2, 0xfd,
+	0x96, 0x03, 0x00, 0x00,
 }
 
 func (m *Task) Marshal() (dAtA []byte, err error) {
@@ -220,6 +295,18 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MergeOptions != nil {
+		{
+			size, err := m.MergeOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Provider) > 0 {
 		i -= len(m.Provider)
 		copy(dAtA[i:], m.Provider)
@@ -259,6 +346,48 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MergeOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CommitMessage) > 0 {
+		i -= len(m.CommitMessage)
+		copy(dAtA[i:], m.CommitMessage)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.CommitMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CommitTitle) > 0 {
+		i -= len(m.CommitTitle)
+		copy(dAtA[i:], m.CommitTitle)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.CommitTitle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MergeStrategy != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MergeStrategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTask(v)
 	base := offset
@@ -297,6 +426,30 @@ func (m *Task) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if m.MergeOptions != nil {
+		l = m.MergeOptions.Size()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *MergeOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MergeStrategy != 0 {
+		n += 1 + sovTask(uint64(m.MergeStrategy))
+	}
+	l = len(m.CommitTitle)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
 	return n
 }
 
@@ -488,6 +641,175 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			}
 			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeOptions == nil {
+				m.MergeOptions = &MergeOptions{}
+			}
+			if err := m.MergeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeStrategy", wireType)
+			}
+			m.MergeStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MergeStrategy |= MergeStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTitle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitTitle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package utils
 
+import "github.com/gitopia/gitopia/x/gitopia/types"
+
 func BountyIdExists(b []uint64, val uint64) (int, bool) {
 	for i, v := range b {
 		if v == val {
@@ -8,3 +10,12 @@ func BountyIdExists(b []uint64, val uint64) (int, bool) {
 	}
 	return 0, false
 }
+
+func BountyShareExists(s []types.BountyShare, address string) (int, bool) {
+	for i, v := range s {
+		if v.Address == address {
+			return i, true
+		}
+	}
+	return 0, false
+}
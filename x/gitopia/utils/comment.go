@@ -2,6 +2,8 @@ package utils
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gitopia/gitopia/x/gitopia/types"
@@ -62,6 +64,50 @@ func CreateBountyCommentBody(creator string, amount sdk.Coins) string {
 	return fmt.Sprintf("@%v created bounty of %v", creator, amount.String())
 }
 
+func FundBountyCommentBody(creator string, amount sdk.Coins) string {
+	return fmt.Sprintf("@%v added %v to bounty", creator, amount.String())
+}
+
+func SetBountySplitCommentBody(creator string, split types.BountySplit) string {
+	if split.Type == types.BountySplitTypeEqual {
+		return fmt.Sprintf("@%v set bounty to be split equally between assignees", creator)
+	}
+
+	shares := make([]string, 0, len(split.Shares))
+	for _, share := range split.Shares {
+		shares = append(shares, fmt.Sprintf("@%v (%d%%)", share.Address, share.Percentage))
+	}
+	return fmt.Sprintf("@%v set bounty split to %v", creator, strings.Join(shares, ", "))
+}
+
+func ReleaseBountyMilestoneCommentBody(creator string, title string, amount sdk.Coins, recipient string) string {
+	return fmt.Sprintf("@%v released bounty milestone %q of %v to @%v", creator, title, amount.String(), recipient)
+}
+
+func bountyDisputeResolutionString(resolution types.BountyDisputeResolution, payoutPercentage uint64) string {
+	switch resolution {
+	case types.BountyDisputeResolutionPayout:
+		return "pay out the bounty"
+	case types.BountyDisputeResolutionRefund:
+		return "refund the bounty"
+	case types.BountyDisputeResolutionSplit:
+		return fmt.Sprintf("split the bounty (%d%% paid out)", payoutPercentage)
+	}
+	return resolution.String()
+}
+
+func OpenBountyDisputeCommentBody(creator string, contributor string) string {
+	return fmt.Sprintf("@%v opened a dispute over the bounty work of @%v; the bounty is frozen until the dao resolves it", creator, contributor)
+}
+
+func VoteBountyDisputeCommentBody(creator string, resolution types.BountyDisputeResolution, payoutPercentage uint64) string {
+	return fmt.Sprintf("@%v voted to %v", creator, bountyDisputeResolutionString(resolution, payoutPercentage))
+}
+
+func ResolveBountyDisputeCommentBody(resolution types.BountyDisputeResolution, payoutPercentage uint64) string {
+	return fmt.Sprintf("bounty dispute resolved: dao decided to %v", bountyDisputeResolutionString(resolution, payoutPercentage))
+}
+
 func UpdateBountyExpiryCommentBody(creator string) string {
 	return fmt.Sprintf("@%v changed bounty expiry", creator)
 }
@@ -70,6 +116,18 @@ func CloseBountyCommentBody(creator string) string {
 	return fmt.Sprintf("@%v closed bounty", creator)
 }
 
+func ExpireBountyCommentBody() string {
+	return "bounty expired and was refunded to the creator"
+}
+
+func RewardBountyCommentBody(amount sdk.Coins, recipients []string) string {
+	return fmt.Sprintf("bounty of %v rewarded to"+JoinAssignees(recipients), amount.String())
+}
+
+func RefundBountyCommentBody(amount sdk.Coins) string {
+	return fmt.Sprintf("bounty of %v was refunded to the contributors", amount.String())
+}
+
 func DeleteBountyCommentBody(creator string) string {
 	return fmt.Sprintf("@%v deleted bounty", creator)
 }
@@ -89,3 +147,141 @@ func LinkPullRequestCommentBody(creator string, iid uint64) string {
 func UnlinkPullRequestCommentBody(creator string, iid uint64) string {
 	return fmt.Sprintf("@%v unlinked pull request #%v", creator, iid)
 }
+
+func commentHiddenReasonString(reason types.CommentHiddenReason) string {
+	switch reason {
+	case types.CommentHiddenReasonSpam:
+		return "spam"
+	case types.CommentHiddenReasonAbuse:
+		return "abuse"
+	case types.CommentHiddenReasonOffTopic:
+		return "off-topic"
+	case types.CommentHiddenReasonOutdated:
+		return "outdated"
+	case types.CommentHiddenReasonResolved:
+		return "resolved"
+	}
+	return reason.String()
+}
+
+func HideCommentCommentBody(creator string, commentIid uint64, reason types.CommentHiddenReason) string {
+	return fmt.Sprintf("@%v hid comment #%v as %v", creator, commentIid, commentHiddenReasonString(reason))
+}
+
+func UnhideCommentCommentBody(creator string, commentIid uint64) string {
+	return fmt.Sprintf("@%v unhid comment #%v", creator, commentIid)
+}
+
+func SetPullRequestDraftCommentBody(creator string, draft bool) string {
+	if draft {
+		return fmt.Sprintf("@%v marked this pull request as draft", creator)
+	}
+	return fmt.Sprintf("@%v marked this pull request as ready for review", creator)
+}
+
+func EnableAutoMergeCommentBody(creator string) string {
+	return fmt.Sprintf("@%v enabled auto-merge", creator)
+}
+
+func DisableAutoMergeCommentBody(creator string) string {
+	return fmt.Sprintf("@%v disabled auto-merge", creator)
+}
+
+func CancelAutoMergeCommentBody(pusher string) string {
+	return fmt.Sprintf("auto-merge was disabled because @%v pushed new commits", pusher)
+}
+
+func MilestoneCommentBody(creator string, oldTitle string, newTitle string) string {
+	if oldTitle == "" {
+		return fmt.Sprintf("@%v added this to the %v milestone", creator, newTitle)
+	}
+	if newTitle == "" {
+		return fmt.Sprintf("@%v removed this from the %v milestone", creator, oldTitle)
+	}
+	return fmt.Sprintf("@%v modified the milestone from %v to %v", creator, oldTitle, newTitle)
+}
+
+func issueLinkTypeString(linkType types.IssueLinkType) string {
+	switch linkType {
+	case types.IssueLinkTypeBlocks:
+		return "blocking"
+	case types.IssueLinkTypeBlockedBy:
+		return "blocked by"
+	case types.IssueLinkTypeDuplicateOf:
+		return "a duplicate of"
+	case types.IssueLinkTypeDuplicatedBy:
+		return "duplicated by"
+	}
+	return "related to"
+}
+
+func LinkIssueToIssueCommentBody(creator string, linkType types.IssueLinkType, reference string) string {
+	return fmt.Sprintf("@%v marked this as %v %v", creator, issueLinkTypeString(linkType), reference)
+}
+
+func UnlinkIssueFromIssueCommentBody(creator string, reference string) string {
+	return fmt.Sprintf("@%v removed the link to %v", creator, reference)
+}
+
+func CloseIssueAsDuplicateCommentBody(creator string, reference string, reason string) string {
+	if reason == "" {
+		return fmt.Sprintf("@%v closed this as a duplicate of %v", creator, reference)
+	}
+	return fmt.Sprintf("@%v closed this as a duplicate of %v: %v", creator, reference, reason)
+}
+
+func TransferIssueCommentBody(creator string, reference string) string {
+	return fmt.Sprintf("@%v transferred this issue from %v", creator, reference)
+}
+
+func TransferIssueUnlinkCommentBody(creator string, iid uint64, reference string) string {
+	return fmt.Sprintf("@%v unlinked issue #%v which was transferred to %v", creator, iid, reference)
+}
+
+func IssueWeightCommentBody(creator string, weight uint64) string {
+	if weight == 0 {
+		return fmt.Sprintf("@%v removed the weight", creator)
+	}
+	return fmt.Sprintf("@%v set the weight to %v", creator, weight)
+}
+
+func IssueDueDateCommentBody(creator string, dueDate int64) string {
+	if dueDate == 0 {
+		return fmt.Sprintf("@%v removed the due date", creator)
+	}
+	return fmt.Sprintf("@%v set the due date to %v", creator, time.Unix(dueDate, 0).UTC().Format("2006-01-02"))
+}
+
+func lockReasonString(reason types.LockReason) string {
+	switch reason {
+	case types.LockReasonOffTopic:
+		return "off-topic"
+	case types.LockReasonTooHeated:
+		return "too heated"
+	case types.LockReasonResolved:
+		return "resolved"
+	case types.LockReasonSpam:
+		return "spam"
+	}
+	return reason.String()
+}
+
+func LockConversationCommentBody(creator string, reason types.LockReason) string {
+	if reason == types.LockReasonNone {
+		return fmt.Sprintf("@%v locked and limited conversation to collaborators", creator)
+	}
+	return fmt.Sprintf("@%v locked as %v and limited conversation to collaborators", creator, lockReasonString(reason))
+}
+
+func UnlockConversationCommentBody(creator string) string {
+	return fmt.Sprintf("@%v unlocked this conversation", creator)
+}
+
+func CommentReplyExists(c []uint64, val uint64) (int, bool) {
+	for i, v := range c {
+		if v == val {
+			return i, true
+		}
+	}
+	return 0, false
+}
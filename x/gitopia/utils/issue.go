@@ -1,5 +1,7 @@
 package utils
 
+import "github.com/gitopia/gitopia/x/gitopia/types"
+
 func IssueCommentExists(c []uint64, val uint64) (int, bool) {
 	for i, v := range c {
 		if v == val {
@@ -8,3 +10,12 @@ func IssueCommentExists(c []uint64, val uint64) (int, bool) {
 	}
 	return 0, false
 }
+
+func IssueLinkExists(links []types.IssueLink, repositoryId uint64, iid uint64) (int, bool) {
+	for i, link := range links {
+		if link.RepositoryId == repositoryId && link.Iid == iid {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	gTypes "github.com/gitopia/gitopia/x/gitopia/types"
+	"github.com/gitopia/gitopia/x/gitopia/utils"
 	"github.com/gitopia/gitopia/x/rewards/types"
 )
 
@@ -108,7 +109,10 @@ func(k Keeper) getTasks(ctx sdk.Context, addr string) ([]types.Task, error){
 						if f {
 							for _, bountyId := range issue.Bounties {
 								bounty, f := k.gitopiaKeeper.GetBounty(ctx, bountyId)
-								if f && bounty.RewardedTo == pr.Creator {
+								if !f {
+									continue
+								}
+								if _, exists := utils.AssigneeExists(bounty.RewardedTo, pr.Creator); exists {
 									prMergedWithBountyVerified = true
 								}
 							}